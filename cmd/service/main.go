@@ -1,12 +1,14 @@
 package main
 
 import (
+	"context"
 	"fmt"
 	"net"
 
 	_ "github.com/lib/pq" // PostgreSQL driver
 	"google.golang.org/grpc"
 
+	kafkalib "github.com/s21platform/kafka-lib"
 	logger_lib "github.com/s21platform/logger-lib"
 
 	"github.com/s21platform/chat-service/internal/client/user"
@@ -26,12 +28,21 @@ func main() {
 
 	userClient := client.NewService(cfg)
 
+	notificationProducer := kafkalib.NewProducer(kafkalib.DefaultProducerConfig(cfg.Kafka.Host, cfg.Kafka.Port, cfg.Kafka.NotificationTopic))
+	defer notificationProducer.Close()
+
 	chatService := service.New(dbRepo, userClient)
+	go chatService.RunNotificationSender(context.WithValue(context.Background(), config.KeyLogger, logger), notificationProducer)
+
 	server := grpc.NewServer(
 		grpc.ChainUnaryInterceptor(
 			infra.AuthInterceptor,
 			infra.Logger(logger),
 		),
+		grpc.ChainStreamInterceptor(
+			infra.AuthStreamInterceptor,
+			infra.StreamLogger(logger),
+		),
 	)
 
 	chat.RegisterChatServiceServer(server, chatService)
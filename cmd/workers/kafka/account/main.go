@@ -0,0 +1,60 @@
+package main
+
+import (
+	"context"
+	"fmt"
+
+	_ "github.com/lib/pq"
+
+	kafkalib "github.com/s21platform/kafka-lib"
+	logger_lib "github.com/s21platform/logger-lib"
+	"github.com/s21platform/metrics-lib/pkg"
+
+	"github.com/s21platform/chat-service/internal/config"
+	"github.com/s21platform/chat-service/internal/databus/account"
+	"github.com/s21platform/chat-service/internal/repository/postgres"
+)
+
+const closedAccountConsumerGroupID = "chat-closed-account-cleaner"
+
+func main() {
+	cfg := config.MustLoad()
+	logger := logger_lib.New(cfg.Logger.Host, cfg.Logger.Port, cfg.Service.Name, cfg.Platform.Env)
+
+	dbRepo := postgres.New(cfg)
+	defer dbRepo.Close()
+
+	metrics, err := pkg.NewMetrics(cfg.Metrics.Host, cfg.Metrics.Port, cfg.Service.Name, cfg.Platform.Env)
+	if err != nil {
+		logger.Error(fmt.Sprintf("failed to connect graphite: %v", err))
+	}
+
+	ctx := context.WithValue(context.Background(), config.KeyMetrics, metrics)
+	ctx = context.WithValue(ctx, config.KeyLogger, logger)
+
+	deletedConsumer, err := kafkalib.NewConsumer(kafkalib.DefaultConsumerConfig(
+		cfg.Kafka.Host,
+		cfg.Kafka.Port,
+		cfg.Kafka.UserDeletedTopic,
+		closedAccountConsumerGroupID,
+	), metrics)
+	if err != nil {
+		logger.Error(fmt.Sprintf("failed to create user deleted consumer: %v", err))
+	}
+
+	blockedConsumer, err := kafkalib.NewConsumer(kafkalib.DefaultConsumerConfig(
+		cfg.Kafka.Host,
+		cfg.Kafka.Port,
+		cfg.Kafka.UserBlockedTopic,
+		closedAccountConsumerGroupID,
+	), metrics)
+	if err != nil {
+		logger.Error(fmt.Sprintf("failed to create user blocked consumer: %v", err))
+	}
+
+	accountHandler := account.New(dbRepo, cfg.ClosedAccount)
+	deletedConsumer.RegisterHandler(ctx, accountHandler.UserDeletedHandler)
+	blockedConsumer.RegisterHandler(ctx, accountHandler.UserBlockedHandler)
+
+	<-ctx.Done()
+}
@@ -0,0 +1,37 @@
+package main
+
+import (
+	"context"
+	"fmt"
+
+	_ "github.com/lib/pq"
+
+	logger_lib "github.com/s21platform/logger-lib"
+	"github.com/s21platform/metrics-lib/pkg"
+
+	"github.com/s21platform/chat-service/internal/config"
+	"github.com/s21platform/chat-service/internal/outbox"
+	"github.com/s21platform/chat-service/internal/repository/postgres"
+)
+
+func main() {
+	cfg := config.MustLoad()
+	logger := logger_lib.New(cfg.Logger.Host, cfg.Logger.Port, cfg.Service.Name, cfg.Platform.Env)
+
+	dbRepo := postgres.New(cfg)
+	defer dbRepo.Close()
+
+	metrics, err := pkg.NewMetrics(cfg.Metrics.Host, cfg.Metrics.Port, cfg.Service.Name, cfg.Platform.Env)
+	if err != nil {
+		logger.Error(fmt.Sprintf("failed to connect graphite: %v", err))
+	}
+
+	ctx := context.WithValue(context.Background(), config.KeyMetrics, metrics)
+	ctx = context.WithValue(ctx, config.KeyLogger, logger)
+
+	producer := outbox.NewKafkaProducer(cfg.Kafka.Host, cfg.Kafka.Port, cfg.Kafka.ChatEventsTopic)
+	defer producer.Close()
+
+	relay := outbox.New(dbRepo, producer)
+	relay.Run(ctx)
+}
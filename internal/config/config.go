@@ -7,13 +7,14 @@ import (
 )
 
 type Config struct {
-	Service     Service
-	Postgres    Postgres
-	Metrics     Metrics
-	Logger      Logger
-	Platform    Platform
-	UserService UserService
-	Kafka       Kafka
+	Service       Service
+	Postgres      Postgres
+	Metrics       Metrics
+	Logger        Logger
+	Platform      Platform
+	UserService   UserService
+	Kafka         Kafka
+	ClosedAccount ClosedAccount
 }
 
 type Service struct {
@@ -49,10 +50,20 @@ type UserService struct {
 }
 
 type Kafka struct {
-	Host        string `env:"KAFKA_HOST"`
-	Port        string `env:"KAFKA_PORT"`
-	UserTopic   string `env:"USER_SET_NEW_NICKNAME"`
-	AvatarTopic string `env:"AVATAR_SET_NEW_USER"`
+	Host              string `env:"KAFKA_HOST"`
+	Port              string `env:"KAFKA_PORT"`
+	UserTopic         string `env:"USER_SET_NEW_NICKNAME"`
+	AvatarTopic       string `env:"AVATAR_SET_NEW_USER"`
+	ChatEventsTopic   string `env:"CHAT_DOMAIN_EVENTS"`
+	NotificationTopic string `env:"CHAT_NOTIFICATION_REQUESTED"`
+	UserDeletedTopic  string `env:"USER_DELETED"`
+	UserBlockedTopic  string `env:"USER_BLOCKED"`
+}
+
+// ClosedAccount задает, удалять ли сообщения пользователя, когда его аккаунт удален или заблокирован
+type ClosedAccount struct {
+	DeleteMessagesOnDelete bool `env:"CHAT_DELETE_MESSAGES_OF_DELETED_USER" env-default:"true"`
+	DeleteMessagesOnBlock  bool `env:"CHAT_DELETE_MESSAGES_OF_BLOCKED_USER" env-default:"false"`
 }
 
 func MustLoad() *Config {
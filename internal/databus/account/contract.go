@@ -0,0 +1,17 @@
+//go:generate mockgen -destination=mock_contract_test.go -package=${GOPACKAGE} -source=contract.go
+package account
+
+import (
+	"context"
+
+	"github.com/s21platform/chat-service/internal/model"
+)
+
+type DBRepo interface {
+	WithTx(ctx context.Context, fn func(ctx context.Context) error) error
+	AnonymizeUser(ctx context.Context, userUUID string) error
+	TransferUserOwnerships(ctx context.Context, userUUID string) error
+	RevokeUserMemberships(ctx context.Context, userUUID string) error
+	DeleteUserMessages(ctx context.Context, userUUID string) (*model.DeletedMessageList, error)
+	AddOutboxEvent(ctx context.Context, event *model.NewOutboxEvent) error
+}
@@ -0,0 +1,127 @@
+package account
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	logger_lib "github.com/s21platform/logger-lib"
+	"github.com/s21platform/metrics-lib/pkg"
+
+	"github.com/s21platform/chat-service/internal/config"
+	"github.com/s21platform/chat-service/internal/model"
+	"github.com/s21platform/chat-service/internal/outbox"
+	"github.com/s21platform/chat-service/pkg/chat"
+)
+
+// accountClosed - событие user-service об удалении или блокировке аккаунта.
+// В user-service нет общего типа для этих событий, поэтому формат описан здесь
+type accountClosed struct {
+	UserUuid string `json:"user_uuid"`
+}
+
+type Handler struct {
+	dbR    DBRepo
+	policy config.ClosedAccount
+}
+
+func New(dbR DBRepo, policy config.ClosedAccount) *Handler {
+	return &Handler{dbR: dbR, policy: policy}
+}
+
+func convertMessage(bMessage []byte, target interface{}) error {
+	err := json.Unmarshal(bMessage, target)
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+func (h *Handler) UserDeletedHandler(ctx context.Context, in []byte) error {
+	logger := logger_lib.FromContext(ctx, config.KeyLogger)
+	logger.AddFuncName("UserDeletedHandler")
+
+	return h.closeAccount(ctx, in, "user_deleted", h.policy.DeleteMessagesOnDelete)
+}
+
+func (h *Handler) UserBlockedHandler(ctx context.Context, in []byte) error {
+	logger := logger_lib.FromContext(ctx, config.KeyLogger)
+	logger.AddFuncName("UserBlockedHandler")
+
+	return h.closeAccount(ctx, in, "user_blocked", h.policy.DeleteMessagesOnBlock)
+}
+
+// closeAccount обезличивает пользователя, передает владение его чатами и исключает его из всех чатов, по политике удаляет его сообщения.
+// Все изменения и события outbox об удалении сообщений записываются одной транзакцией
+func (h *Handler) closeAccount(ctx context.Context, in []byte, metric string, deleteMessages bool) error {
+	logger := logger_lib.FromContext(ctx, config.KeyLogger)
+	m := pkg.FromContext(ctx, config.KeyMetrics)
+
+	var msg accountClosed
+	err := convertMessage(in, &msg)
+	if err != nil {
+		m.Increment(metric + ".error")
+		logger.Error(fmt.Sprintf("failed to convert message: %v", err))
+		return err
+	}
+
+	if msg.UserUuid == "" {
+		m.Increment(metric + ".error")
+		logger.Error("failed to find user uuid in message")
+		return fmt.Errorf("failed to find user uuid in message")
+	}
+
+	err = h.dbR.WithTx(ctx, func(ctx context.Context) error {
+		err := h.dbR.AnonymizeUser(ctx, msg.UserUuid)
+		if err != nil {
+			return fmt.Errorf("failed to anonymize user: %v", err)
+		}
+
+		err = h.dbR.TransferUserOwnerships(ctx, msg.UserUuid)
+		if err != nil {
+			return fmt.Errorf("failed to transfer user ownerships: %v", err)
+		}
+
+		err = h.dbR.RevokeUserMemberships(ctx, msg.UserUuid)
+		if err != nil {
+			return fmt.Errorf("failed to revoke user memberships: %v", err)
+		}
+
+		if !deleteMessages {
+			return nil
+		}
+
+		deleted, err := h.dbR.DeleteUserMessages(ctx, msg.UserUuid)
+		if err != nil {
+			return fmt.Errorf("failed to delete user messages: %v", err)
+		}
+
+		for _, message := range *deleted {
+			event, err := outbox.NewEvent(message.ChatUUID, &chat.ChatDomainEvent{Payload: &chat.ChatDomainEvent_MessageDeleted{
+				MessageDeleted: &chat.MessageDeleted{
+					MessageUuid: message.MessageUUID,
+					Mode:        model.All,
+				},
+			}})
+			if err != nil {
+				return fmt.Errorf("failed to marshal outbox event: %v", err)
+			}
+
+			err = h.dbR.AddOutboxEvent(ctx, event)
+			if err != nil {
+				return fmt.Errorf("failed to add outbox event: %v", err)
+			}
+		}
+
+		return nil
+	})
+	if err != nil {
+		m.Increment(metric + ".error")
+		logger.Error(fmt.Sprintf("failed to close account: %v", err))
+		return err
+	}
+
+	m.Increment(metric + ".success")
+
+	return nil
+}
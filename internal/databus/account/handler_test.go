@@ -0,0 +1,178 @@
+package account
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+
+	logger_lib "github.com/s21platform/logger-lib"
+	"github.com/s21platform/metrics-lib/pkg"
+
+	"github.com/s21platform/chat-service/internal/config"
+	"github.com/s21platform/chat-service/internal/model"
+)
+
+func runInTx(ctx context.Context, fn func(ctx context.Context) error) error {
+	return fn(ctx)
+}
+
+func TestHandler_UserDeletedHandler(t *testing.T) {
+	t.Parallel()
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockRepo := NewMockDBRepo(ctrl)
+	mockLogger := logger_lib.NewMockLoggerInterface(ctrl)
+	mockMetrics := pkg.NewMockMetricInterface(ctrl)
+
+	ctx := context.Background()
+	ctx = context.WithValue(ctx, config.KeyLogger, mockLogger)
+	ctx = context.WithValue(ctx, config.KeyMetrics, mockMetrics)
+
+	userUUID := uuid.New().String()
+	chatUUID := uuid.New().String()
+	in := []byte(fmt.Sprintf(`{"user_uuid":"%s"}`, userUUID))
+
+	h := New(mockRepo, config.ClosedAccount{DeleteMessagesOnDelete: true})
+	mockRepo.EXPECT().WithTx(gomock.Any(), gomock.Any()).DoAndReturn(runInTx).AnyTimes()
+	mockLogger.EXPECT().AddFuncName("UserDeletedHandler").AnyTimes()
+
+	t.Run("success", func(t *testing.T) {
+		mockRepo.EXPECT().AnonymizeUser(ctx, userUUID).Return(nil)
+		mockRepo.EXPECT().TransferUserOwnerships(ctx, userUUID).Return(nil)
+		mockRepo.EXPECT().RevokeUserMemberships(ctx, userUUID).Return(nil)
+		mockRepo.EXPECT().DeleteUserMessages(ctx, userUUID).Return(&model.DeletedMessageList{
+			{MessageUUID: uuid.New().String(), ChatUUID: chatUUID},
+			{MessageUUID: uuid.New().String(), ChatUUID: chatUUID},
+		}, nil)
+		mockRepo.EXPECT().AddOutboxEvent(ctx, gomock.Any()).DoAndReturn(func(_ context.Context, event *model.NewOutboxEvent) error {
+			assert.Equal(t, chatUUID, event.ChatUUID)
+			assert.Equal(t, model.OutboxEventMessageDeleted, event.Type)
+			return nil
+		}).Times(2)
+		mockMetrics.EXPECT().Increment("user_deleted.success")
+
+		err := h.UserDeletedHandler(ctx, in)
+
+		assert.NoError(t, err)
+	})
+
+	t.Run("outbox_error_rollback", func(t *testing.T) {
+		mockRepo.EXPECT().AnonymizeUser(ctx, userUUID).Return(nil)
+		mockRepo.EXPECT().TransferUserOwnerships(ctx, userUUID).Return(nil)
+		mockRepo.EXPECT().RevokeUserMemberships(ctx, userUUID).Return(nil)
+		mockRepo.EXPECT().DeleteUserMessages(ctx, userUUID).Return(&model.DeletedMessageList{
+			{MessageUUID: uuid.New().String(), ChatUUID: chatUUID},
+			{MessageUUID: uuid.New().String(), ChatUUID: chatUUID},
+		}, nil)
+		mockRepo.EXPECT().AddOutboxEvent(ctx, gomock.Any()).Return(fmt.Errorf("db error"))
+		mockMetrics.EXPECT().Increment("user_deleted.error")
+		mockLogger.EXPECT().Error(gomock.Any())
+
+		err := h.UserDeletedHandler(ctx, in)
+
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), "failed to add outbox event")
+	})
+
+	t.Run("TransferUserOwnerships_error", func(t *testing.T) {
+		mockRepo.EXPECT().AnonymizeUser(ctx, userUUID).Return(nil)
+		mockRepo.EXPECT().TransferUserOwnerships(ctx, userUUID).Return(fmt.Errorf("db error"))
+		mockMetrics.EXPECT().Increment("user_deleted.error")
+		mockLogger.EXPECT().Error(gomock.Any())
+
+		err := h.UserDeletedHandler(ctx, in)
+
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), "failed to transfer user ownerships")
+	})
+
+	t.Run("empty_user_uuid", func(t *testing.T) {
+		mockMetrics.EXPECT().Increment("user_deleted.error")
+		mockLogger.EXPECT().Error("failed to find user uuid in message")
+
+		err := h.UserDeletedHandler(ctx, []byte(`{}`))
+
+		assert.Error(t, err)
+	})
+
+	t.Run("broken_message", func(t *testing.T) {
+		mockMetrics.EXPECT().Increment("user_deleted.error")
+		mockLogger.EXPECT().Error(gomock.Any())
+
+		err := h.UserDeletedHandler(ctx, []byte("{broken"))
+
+		assert.Error(t, err)
+	})
+}
+
+func TestHandler_UserBlockedHandler(t *testing.T) {
+	t.Parallel()
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockRepo := NewMockDBRepo(ctrl)
+	mockLogger := logger_lib.NewMockLoggerInterface(ctrl)
+	mockMetrics := pkg.NewMockMetricInterface(ctrl)
+
+	ctx := context.Background()
+	ctx = context.WithValue(ctx, config.KeyLogger, mockLogger)
+	ctx = context.WithValue(ctx, config.KeyMetrics, mockMetrics)
+
+	userUUID := uuid.New().String()
+	in := []byte(fmt.Sprintf(`{"user_uuid":"%s"}`, userUUID))
+
+	mockRepo.EXPECT().WithTx(gomock.Any(), gomock.Any()).DoAndReturn(runInTx).AnyTimes()
+	mockLogger.EXPECT().AddFuncName("UserBlockedHandler").AnyTimes()
+
+	t.Run("messages_kept", func(t *testing.T) {
+		h := New(mockRepo, config.ClosedAccount{DeleteMessagesOnDelete: true, DeleteMessagesOnBlock: false})
+
+		mockRepo.EXPECT().AnonymizeUser(ctx, userUUID).Return(nil)
+		mockRepo.EXPECT().TransferUserOwnerships(ctx, userUUID).Return(nil)
+		mockRepo.EXPECT().RevokeUserMemberships(ctx, userUUID).Return(nil)
+		mockMetrics.EXPECT().Increment("user_blocked.success")
+
+		err := h.UserBlockedHandler(ctx, in)
+
+		assert.NoError(t, err)
+	})
+
+	t.Run("messages_deleted", func(t *testing.T) {
+		h := New(mockRepo, config.ClosedAccount{DeleteMessagesOnBlock: true})
+
+		mockRepo.EXPECT().AnonymizeUser(ctx, userUUID).Return(nil)
+		mockRepo.EXPECT().TransferUserOwnerships(ctx, userUUID).Return(nil)
+		mockRepo.EXPECT().RevokeUserMemberships(ctx, userUUID).Return(nil)
+		mockRepo.EXPECT().DeleteUserMessages(ctx, userUUID).Return(&model.DeletedMessageList{
+			{MessageUUID: uuid.New().String(), ChatUUID: uuid.New().String()},
+		}, nil)
+		mockRepo.EXPECT().AddOutboxEvent(ctx, gomock.Any()).Return(nil)
+		mockMetrics.EXPECT().Increment("user_blocked.success")
+
+		err := h.UserBlockedHandler(ctx, in)
+
+		assert.NoError(t, err)
+	})
+
+	t.Run("RevokeUserMemberships_error", func(t *testing.T) {
+		h := New(mockRepo, config.ClosedAccount{})
+
+		mockRepo.EXPECT().AnonymizeUser(ctx, userUUID).Return(nil)
+		mockRepo.EXPECT().TransferUserOwnerships(ctx, userUUID).Return(nil)
+		mockRepo.EXPECT().RevokeUserMemberships(ctx, userUUID).Return(fmt.Errorf("db error"))
+		mockMetrics.EXPECT().Increment("user_blocked.error")
+		mockLogger.EXPECT().Error(gomock.Any())
+
+		err := h.UserBlockedHandler(ctx, in)
+
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), "failed to revoke user memberships")
+	})
+}
@@ -30,3 +30,23 @@ func AuthInterceptor(
 
 	return handler(ctx, req)
 }
+
+func AuthStreamInterceptor(
+	srv interface{},
+	ss grpc.ServerStream,
+	_ *grpc.StreamServerInfo,
+	handler grpc.StreamHandler) error {
+	md, ok := metadata.FromIncomingContext(ss.Context())
+	if !ok {
+		return status.Error(codes.Unauthenticated, "no info in metadata")
+	}
+
+	userIDs, ok := md["uuid"]
+	if !ok || len(userIDs) != 1 {
+		return status.Errorf(codes.Unauthenticated, "no uuid or more than one in metadata")
+	}
+
+	ctx := context.WithValue(ss.Context(), config.KeyUUID, userIDs[0])
+
+	return handler(srv, &contextStream{ServerStream: ss, ctx: ctx})
+}
@@ -16,3 +16,10 @@ func Logger(logger *logger_lib.Logger) func(context.Context, interface{}, *grpc.
 		return handler(ctx, req)
 	}
 }
+
+func StreamLogger(logger *logger_lib.Logger) func(interface{}, grpc.ServerStream, *grpc.StreamServerInfo, grpc.StreamHandler) error {
+	return func(srv interface{}, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		ctx := context.WithValue(ss.Context(), config.KeyLogger, logger)
+		return handler(srv, &contextStream{ServerStream: ss, ctx: ctx})
+	}
+}
@@ -0,0 +1,17 @@
+package infra
+
+import (
+	"context"
+
+	"google.golang.org/grpc"
+)
+
+// contextStream подменяет контекст стрима, потому что grpc.ServerStream не позволяет изменить его напрямую
+type contextStream struct {
+	grpc.ServerStream
+	ctx context.Context
+}
+
+func (s *contextStream) Context() context.Context {
+	return s.ctx
+}
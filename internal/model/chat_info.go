@@ -14,6 +14,16 @@ type ChatInfo struct {
 	AvatarURL            string     `db:"avatar_link"`
 	LastMessageTimestamp *time.Time `db:"created_at"`
 	ChatUUID             string     `db:"uuid"`
+	ChatType             string     `db:"stream_type"`
+	UnreadCount          int64      `db:"unread_count"`
+	PinnedMessage        string     `db:"pinned_message"`
+	PinnedMessageUUID    string     `db:"pinned_message_uuid"`
+	CompanionUUID        string     `db:"companion_uuid"`
+	CompanionLastOnline  *time.Time `db:"companion_last_online"`
+	CompanionOnline      bool
+	Muted                bool       `db:"muted"`
+	MutedUntil           *time.Time `db:"muted_until"`
+	MentionsOnly         bool       `db:"mentions_only"`
 }
 
 func (c *ChatInfoList) FromDTO() []*chat_proto.Chat {
@@ -26,6 +36,16 @@ func (c *ChatInfoList) FromDTO() []*chat_proto.Chat {
 			AvatarUrl:            chat.AvatarURL,
 			LastMessageTimestamp: chat.convertTimestamp(),
 			ChatUuid:             chat.ChatUUID,
+			ChatType:             chat.ChatType,
+			UnreadCount:          chat.UnreadCount,
+			PinnedMessage:        chat.PinnedMessage,
+			PinnedMessageUuid:    chat.PinnedMessageUUID,
+			CompanionUuid:        chat.CompanionUUID,
+			CompanionOnline:      chat.CompanionOnline,
+			CompanionLastOnline:  formatOptionalTime(chat.CompanionLastOnline),
+			Muted:                chat.Muted,
+			MutedUntil:           formatOptionalTime(chat.MutedUntil),
+			MentionsOnly:         chat.MentionsOnly,
 		})
 	}
 
@@ -0,0 +1,58 @@
+package model
+
+const (
+	RoleOwner  string = "owner"
+	RoleAdmin  string = "admin"
+	RoleMember string = "member"
+)
+
+const (
+	MembershipActionAdded        string = "added"
+	MembershipActionRemoved      string = "removed"
+	MembershipActionSubscribed   string = "subscribed"
+	MembershipActionUnsubscribed string = "unsubscribed"
+	MembershipActionBanned       string = "banned"
+	MembershipActionUnbanned     string = "unbanned"
+	MembershipActionKicked       string = "kicked"
+	MembershipActionJoined       string = "joined"
+	MembershipActionLeft         string = "left"
+)
+
+type ChatMember struct {
+	StreamType string `db:"stream_type"` // тип чата
+	Role       string `db:"role"`        // роль участника в чате
+}
+
+type MemberMetadata struct {
+	Role string `json:"role,omitempty"` // роль участника в чате
+}
+
+func (m *ChatMember) CanManageMembers() bool {
+	return m.Role == RoleOwner || m.Role == RoleAdmin
+}
+
+func (m *ChatMember) CanPublish() bool {
+	return m.Role == RoleOwner || m.Role == RoleAdmin
+}
+
+func (m *ChatMember) CanModerate() bool {
+	return m.Role == RoleOwner || m.Role == RoleAdmin
+}
+
+// CanModerateMember проверяет, что модератор может забанить или выгнать участника:
+// владельца не трогает никто, администратора — только владелец
+func (m *ChatMember) CanModerateMember(target *ChatMember) bool {
+	if !m.CanModerate() || target.Role == RoleOwner {
+		return false
+	}
+
+	return target.Role != RoleAdmin || m.Role == RoleOwner
+}
+
+func (m *ChatMember) CanPin() bool {
+	if m.StreamType == StreamTypePrivate {
+		return true
+	}
+
+	return m.Role == RoleOwner || m.Role == RoleAdmin
+}
@@ -0,0 +1,41 @@
+package model
+
+import (
+	"regexp"
+	"time"
+)
+
+type ChatNotifications struct {
+	Muted        bool       `db:"muted"`         // уведомления чата выключены
+	MutedUntil   *time.Time `db:"muted_until"`   // время автоматического включения, nil для бессрочного
+	MentionsOnly bool       `db:"mentions_only"` // при выключенных уведомлениях уведомлять об упоминаниях
+}
+
+type EventRecipient struct {
+	UserUUID     string     `db:"user_uuid"`     // uuid получателя события
+	Nickname     string     `db:"nickname"`      // никнейм получателя для поиска упоминаний
+	Muted        bool       `db:"muted"`         // получатель выключил уведомления чата
+	MentionsOnly bool       `db:"mentions_only"` // получатель ждет уведомления только об упоминаниях
+	LastOnline   *time.Time `db:"last_online"`   // время последнего heartbeat получателя
+}
+
+type EventRecipientList []EventRecipient
+
+// ShouldNotify проверяет, нужно ли уведомлять получателя о сообщении с таким контентом
+func (r *EventRecipient) ShouldNotify(content string) bool {
+	if !r.Muted {
+		return true
+	}
+
+	if !r.MentionsOnly || r.Nickname == "" {
+		return false
+	}
+
+	return mentionPattern(r.Nickname).MatchString(content)
+}
+
+// mentionPattern ищет упоминание никнейма целиком: после него не должен идти символ никнейма,
+// иначе упоминание @bob уведомляло бы @bobby
+func mentionPattern(nickname string) *regexp.Regexp {
+	return regexp.MustCompile(`(?i)@` + regexp.QuoteMeta(nickname) + `([^\p{L}\p{N}_-]|$)`)
+}
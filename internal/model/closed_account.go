@@ -0,0 +1,11 @@
+package model
+
+// DeletedUserNickname показывается в чатах вместо никнейма удаленного или заблокированного пользователя
+const DeletedUserNickname = "deleted_user"
+
+type DeletedMessage struct {
+	MessageUUID string `db:"message_uuid"` // uuid удаленного сообщения
+	ChatUUID    string `db:"chat_uuid"`    // uuid чата сообщения
+}
+
+type DeletedMessageList []DeletedMessage
@@ -0,0 +1,50 @@
+package model
+
+import (
+	"time"
+
+	chat_proto "github.com/s21platform/chat-service/pkg/chat"
+)
+
+type InviteLink struct {
+	Code       string     `db:"code"`        // код приглашения
+	ChatUUID   string     `db:"chat_uuid"`   // uuid группового чата
+	CreatedBy  string     `db:"created_by"`  // uuid создателя ссылки
+	CreatedAt  time.Time  `db:"created_at"`  // время создания
+	ExpiresAt  *time.Time `db:"expires_at"`  // время истечения, nil для бессрочной ссылки
+	UsageLimit *int32     `db:"usage_limit"` // максимальное число вступлений, nil без ограничения
+	UsageCount int32      `db:"usage_count"` // число вступлений по ссылке
+}
+
+type InviteLinkList []InviteLink
+
+type InviteJoin struct {
+	LinkActive bool `db:"link_active"` // ссылка действовала в момент вступления
+	Joined     bool `db:"joined"`      // пользователь добавлен или вернулся в чат
+}
+
+func (l *InviteLink) FromDTO() *chat_proto.InviteLink {
+	link := &chat_proto.InviteLink{
+		Code:       l.Code,
+		ChatUuid:   l.ChatUUID,
+		CreatedBy:  l.CreatedBy,
+		CreatedAt:  l.CreatedAt.Format(time.RFC3339),
+		ExpiresAt:  formatOptionalTime(l.ExpiresAt),
+		UsageCount: l.UsageCount,
+	}
+	if l.UsageLimit != nil {
+		link.UsageLimit = *l.UsageLimit
+	}
+
+	return link
+}
+
+func (l *InviteLinkList) FromDTO() []*chat_proto.InviteLink {
+	result := make([]*chat_proto.InviteLink, 0, len(*l))
+
+	for _, link := range *l {
+		result = append(result, link.FromDTO())
+	}
+
+	return result
+}
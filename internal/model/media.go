@@ -0,0 +1,183 @@
+package model
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+
+	chat_proto "github.com/s21platform/chat-service/pkg/chat"
+)
+
+const (
+	maxMediaSize     int64 = 2 << 30 // 2 ГБ
+	maxMediaDuration int32 = 3 * 60 * 60 * 1000
+)
+
+type Media struct {
+	FileName   string `json:"file_name,omitempty"`   // имя файла
+	MimeType   string `json:"mime_type"`             // MIME-тип файла
+	Size       int64  `json:"size"`                  // размер файла в байтах
+	StorageKey string `json:"storage_key"`           // ключ файла в хранилище
+	Width      int32  `json:"width,omitempty"`       // ширина в пикселях
+	Height     int32  `json:"height,omitempty"`      // высота в пикселях
+	DurationMs int32  `json:"duration_ms,omitempty"` // длительность в миллисекундах
+}
+
+// Scan читает вложение из колонки media
+func (m *Media) Scan(src interface{}) error {
+	switch value := src.(type) {
+	case []byte:
+		return json.Unmarshal(value, m)
+	case string:
+		return json.Unmarshal([]byte(value), m)
+	default:
+		return fmt.Errorf("unsupported media type %T", src)
+	}
+}
+
+// MediaFromDTO возвращает тип сообщения и вложение, для сообщения без вложения тип text
+func MediaFromDTO(attachment *chat_proto.Attachment) (string, *Media) {
+	switch payload := attachment.GetPayload().(type) {
+	case *chat_proto.Attachment_Image:
+		media := mediaFromFile(payload.Image.GetFile())
+		media.Width = payload.Image.GetWidth()
+		media.Height = payload.Image.GetHeight()
+		return MessageTypeImage, media
+	case *chat_proto.Attachment_Video:
+		media := mediaFromFile(payload.Video.GetFile())
+		media.Width = payload.Video.GetWidth()
+		media.Height = payload.Video.GetHeight()
+		media.DurationMs = payload.Video.GetDurationMs()
+		return MessageTypeVideo, media
+	case *chat_proto.Attachment_File:
+		return MessageTypeFile, mediaFromFile(payload.File.GetFile())
+	case *chat_proto.Attachment_Speech:
+		media := mediaFromFile(payload.Speech.GetFile())
+		media.DurationMs = payload.Speech.GetDurationMs()
+		return MessageTypeSpeech, media
+	case *chat_proto.Attachment_Circle:
+		media := mediaFromFile(payload.Circle.GetFile())
+		media.Width = payload.Circle.GetDiameter()
+		media.Height = payload.Circle.GetDiameter()
+		media.DurationMs = payload.Circle.GetDurationMs()
+		return MessageTypeCircle, media
+	default:
+		return MessageTypeText, nil
+	}
+}
+
+// Validate проверяет, что вложение заполнено так, как требует тип сообщения
+func (m *Media) Validate(messageType string) error {
+	if m.StorageKey == "" {
+		return errors.New("storage key is required")
+	}
+	if m.MimeType == "" {
+		return errors.New("mime type is required")
+	}
+	if m.Size <= 0 || m.Size > maxMediaSize {
+		return fmt.Errorf("size must be between 1 and %d bytes", maxMediaSize)
+	}
+	if m.Width < 0 || m.Height < 0 {
+		return errors.New("dimensions must not be negative")
+	}
+	if m.DurationMs < 0 || m.DurationMs > maxMediaDuration {
+		return fmt.Errorf("duration must be between 0 and %d ms", maxMediaDuration)
+	}
+
+	switch messageType {
+	case MessageTypeImage:
+		if !strings.HasPrefix(m.MimeType, "image/") {
+			return fmt.Errorf("image mime type expected, got %s", m.MimeType)
+		}
+		if m.Width == 0 || m.Height == 0 {
+			return errors.New("image dimensions are required")
+		}
+	case MessageTypeVideo:
+		if !strings.HasPrefix(m.MimeType, "video/") {
+			return fmt.Errorf("video mime type expected, got %s", m.MimeType)
+		}
+		if m.Width == 0 || m.Height == 0 {
+			return errors.New("video dimensions are required")
+		}
+		if m.DurationMs == 0 {
+			return errors.New("video duration is required")
+		}
+	case MessageTypeFile:
+		if strings.TrimSpace(m.FileName) == "" {
+			return errors.New("file name is required")
+		}
+	case MessageTypeSpeech:
+		if !strings.HasPrefix(m.MimeType, "audio/") {
+			return fmt.Errorf("audio mime type expected, got %s", m.MimeType)
+		}
+		if m.DurationMs == 0 {
+			return errors.New("speech duration is required")
+		}
+	case MessageTypeCircle:
+		if !strings.HasPrefix(m.MimeType, "video/") {
+			return fmt.Errorf("video mime type expected, got %s", m.MimeType)
+		}
+		if m.Width == 0 {
+			return errors.New("circle diameter is required")
+		}
+		if m.DurationMs == 0 {
+			return errors.New("circle duration is required")
+		}
+	default:
+		return fmt.Errorf("unsupported message type %s", messageType)
+	}
+
+	return nil
+}
+
+func (m *Media) FromDTO(messageType string) *chat_proto.Attachment {
+	file := &chat_proto.MediaFile{
+		FileName:   m.FileName,
+		MimeType:   m.MimeType,
+		Size:       m.Size,
+		StorageKey: m.StorageKey,
+	}
+
+	switch messageType {
+	case MessageTypeImage:
+		return &chat_proto.Attachment{Payload: &chat_proto.Attachment_Image{Image: &chat_proto.ImageAttachment{
+			File:   file,
+			Width:  m.Width,
+			Height: m.Height,
+		}}}
+	case MessageTypeVideo:
+		return &chat_proto.Attachment{Payload: &chat_proto.Attachment_Video{Video: &chat_proto.VideoAttachment{
+			File:       file,
+			Width:      m.Width,
+			Height:     m.Height,
+			DurationMs: m.DurationMs,
+		}}}
+	case MessageTypeFile:
+		return &chat_proto.Attachment{Payload: &chat_proto.Attachment_File{File: &chat_proto.FileAttachment{
+			File: file,
+		}}}
+	case MessageTypeSpeech:
+		return &chat_proto.Attachment{Payload: &chat_proto.Attachment_Speech{Speech: &chat_proto.SpeechAttachment{
+			File:       file,
+			DurationMs: m.DurationMs,
+		}}}
+	case MessageTypeCircle:
+		return &chat_proto.Attachment{Payload: &chat_proto.Attachment_Circle{Circle: &chat_proto.CircleAttachment{
+			File:       file,
+			Diameter:   m.Width,
+			DurationMs: m.DurationMs,
+		}}}
+	default:
+		return nil
+	}
+}
+
+func mediaFromFile(file *chat_proto.MediaFile) *Media {
+	return &Media{
+		FileName:   file.GetFileName(),
+		MimeType:   file.GetMimeType(),
+		Size:       file.GetSize(),
+		StorageKey: file.GetStorageKey(),
+	}
+}
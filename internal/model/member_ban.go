@@ -0,0 +1,11 @@
+package model
+
+import "time"
+
+type MemberBan struct {
+	UserUUID string     `db:"user_uuid"` // uuid забаненного пользователя
+	BannedBy string     `db:"banned_by"` // uuid модератора
+	Reason   string     `db:"reason"`    // причина бана
+	BannedAt time.Time  `db:"banned_at"` // время бана
+	BanUntil *time.Time `db:"ban_until"` // время снятия бана, nil для бессрочного
+}
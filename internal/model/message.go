@@ -8,29 +8,88 @@ import (
 	chat_proto "github.com/s21platform/chat-service/pkg/chat"
 )
 
+const (
+	MessageTypeText   string = "text"
+	MessageTypeImage  string = "image"
+	MessageTypeVideo  string = "video"
+	MessageTypeFile   string = "file"
+	MessageTypeSpeech string = "speech"
+	MessageTypeCircle string = "circle"
+	MessageTypeSystem string = "system"
+)
+
+// содержимое системных сообщений; если событие относится к сообщению, parent_uuid указывает на него
+const (
+	SystemMessagePinned     string = "message_pinned"
+	SystemMessageMemberLeft string = "member_left"
+)
+
 type Message struct {
-	Uuid       uuid.UUID `db:"sender_uuid"` // uuid пользователя
-	Content    string    `db:"content"`     // само сообщение
-	SentAt     time.Time `db:"sent_at"`     // время отправки
-	UpdatedAt  time.Time `db:"updated_at"`  // время обновления
-	RootUUID   uuid.UUID `db:"root_uuid"`   // uuid корневого сообщения
-	ParentUUID uuid.UUID `db:"parent_uuid"` // uuid сообщения, на которое идет прямой ответ
+	ID         uuid.UUID    `db:"uuid"`        // uuid сообщения
+	Uuid       uuid.UUID    `db:"sender_uuid"` // uuid пользователя
+	Content    string       `db:"content"`     // само сообщение
+	SentAt     time.Time    `db:"sent_at"`     // время отправки
+	UpdatedAt  time.Time    `db:"updated_at"`  // время обновления
+	RootUUID   uuid.UUID    `db:"root_uuid"`   // uuid корневого сообщения
+	ParentUUID uuid.UUID    `db:"parent_uuid"` // uuid сообщения, на которое идет прямой ответ
+	Type       string       `db:"type"`        // тип сообщения
+	Media      *Media       `db:"media"`       // вложение, nil для текстовых сообщений
+	Edited     bool         `db:"edited"`      // сообщение было изменено
+	Reactions  ReactionList `db:"reactions"`   // реакции на сообщение
+
+	ForwardedFromMessage uuid.NullUUID `db:"forwarded_from_message_uuid"` // uuid исходного сообщения
+	ForwardedFromSender  uuid.NullUUID `db:"forwarded_from_sender_uuid"`  // uuid автора исходного сообщения
+	ForwardedFromChat    uuid.NullUUID `db:"forwarded_from_chat_uuid"`    // uuid чата исходного сообщения
 }
 
 type MessageList []Message
 
+type NewMessage struct {
+	ChatUUID   string // uuid чата
+	SenderUUID string // uuid отправителя
+	Content    string // текст сообщения
+	RootUUID   string // uuid корневого сообщения, пустая строка если это не ответ
+	ParentUUID string // uuid сообщения, на которое идет прямой ответ, пустая строка если это не ответ
+	Type       string // тип сообщения, пустая строка равна text
+	Media      *Media // вложение, nil для текстовых сообщений
+}
+
+func (m *Message) FromDTO() *chat_proto.Message {
+	var attachment *chat_proto.Attachment
+	if m.Media != nil {
+		attachment = m.Media.FromDTO(m.Type)
+	}
+
+	var forwardedFrom *chat_proto.ForwardedFrom
+	if m.ForwardedFromMessage.Valid {
+		forwardedFrom = &chat_proto.ForwardedFrom{
+			SenderUuid:  m.ForwardedFromSender.UUID.String(),
+			ChatUuid:    m.ForwardedFromChat.UUID.String(),
+			MessageUuid: m.ForwardedFromMessage.UUID.String(),
+		}
+	}
+
+	return &chat_proto.Message{
+		Uuid:          m.Uuid.String(),
+		Content:       m.Content,
+		SentAt:        m.SentAt.Format(time.RFC3339),
+		UpdatedAt:     m.UpdatedAt.Format(time.RFC3339),
+		RootUuid:      m.RootUUID.String(),
+		ParentUuid:    m.ParentUUID.String(),
+		MessageUuid:   m.ID.String(),
+		Type:          m.Type,
+		Attachment:    attachment,
+		Edited:        m.Edited,
+		Reactions:     m.Reactions.FromDTO(),
+		ForwardedFrom: forwardedFrom,
+	}
+}
+
 func (m *MessageList) FromDTO() []*chat_proto.Message {
 	result := make([]*chat_proto.Message, 0, len(*m))
 
 	for _, message := range *m {
-		result = append(result, &chat_proto.Message{
-			Uuid:       message.Uuid.String(),
-			Content:    message.Content,
-			SentAt:     message.SentAt.Format(time.RFC3339),
-			UpdatedAt:  message.UpdatedAt.Format(time.RFC3339),
-			RootUuid:   message.RootUUID.String(),
-			ParentUuid: message.ParentUUID.String(),
-		})
+		result = append(result, message.FromDTO())
 	}
 
 	return result
@@ -0,0 +1,60 @@
+package model
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"time"
+)
+
+type MessageCursor struct {
+	SentAt  time.Time `json:"sent_at"`           // время отправки последнего сообщения страницы
+	ID      string    `json:"id"`                // uuid последнего сообщения страницы
+	Forward bool      `json:"forward,omitempty"` // листание от старых сообщений к новым
+}
+
+type MessagePage struct {
+	Limit            uint64         // размер страницы
+	Forward          bool           // листание от старых сообщений к новым
+	Cursor           *MessageCursor // позиция, с которой продолжаем листание
+	AfterMessageUUID string         // uuid сообщения, после которого нужно получить новые сообщения
+}
+
+func (c *MessageCursor) Encode() string {
+	data, _ := json.Marshal(c)
+	return base64.RawURLEncoding.EncodeToString(data)
+}
+
+func DecodeMessageCursor(cursor string) (*MessageCursor, error) {
+	data, err := base64.RawURLEncoding.DecodeString(cursor)
+	if err != nil {
+		return nil, fmt.Errorf("failed to decode cursor: %v", err)
+	}
+
+	var result MessageCursor
+	err = json.Unmarshal(data, &result)
+	if err != nil {
+		return nil, fmt.Errorf("failed to unmarshal cursor: %v", err)
+	}
+
+	if result.ID == "" || result.SentAt.IsZero() {
+		return nil, fmt.Errorf("failed to decode cursor: empty position")
+	}
+
+	return &result, nil
+}
+
+func (m *MessageList) NextCursor(forward bool) string {
+	if len(*m) == 0 {
+		return ""
+	}
+
+	last := (*m)[len(*m)-1]
+	cursor := &MessageCursor{
+		SentAt:  last.SentAt,
+		ID:      last.ID.String(),
+		Forward: forward,
+	}
+
+	return cursor.Encode()
+}
@@ -4,6 +4,8 @@ import (
 	"time"
 
 	"github.com/google/uuid"
+
+	chat_proto "github.com/s21platform/chat-service/pkg/chat"
 )
 
 type EditedMessage struct {
@@ -11,3 +13,25 @@ type EditedMessage struct {
 	Content     string    `db:"content"`    // новый текст сообщения
 	UpdateAt    time.Time `db:"updated_at"` // время обновления сообщения
 }
+
+type MessageRevision struct {
+	Content    string    `db:"content"`     // текст сообщения до изменения
+	WrittenAt  time.Time `db:"written_at"`  // время, когда был написан этот текст
+	ReplacedAt time.Time `db:"replaced_at"` // время, когда текст был заменен
+}
+
+type MessageRevisionList []MessageRevision
+
+func (m *MessageRevisionList) FromDTO() []*chat_proto.MessageRevision {
+	result := make([]*chat_proto.MessageRevision, 0, len(*m))
+
+	for _, revision := range *m {
+		result = append(result, &chat_proto.MessageRevision{
+			Content:    revision.Content,
+			WrittenAt:  revision.WrittenAt.Format(time.RFC3339),
+			ReplacedAt: revision.ReplacedAt.Format(time.RFC3339),
+		})
+	}
+
+	return result
+}
@@ -0,0 +1,31 @@
+package model
+
+import (
+	"time"
+
+	chat_proto "github.com/s21platform/chat-service/pkg/chat"
+)
+
+type MessageReader struct {
+	UserUUID  string    `db:"user_uuid"`  // uuid прочитавшего пользователя
+	Nickname  string    `db:"nickname"`   // никнейм пользователя
+	AvatarURL string    `db:"avatar_url"` // аватарка пользователя
+	ReadAt    time.Time `db:"read_at"`    // время прочтения
+}
+
+type MessageReaderList []MessageReader
+
+func (m *MessageReaderList) FromDTO() []*chat_proto.MessageReader {
+	result := make([]*chat_proto.MessageReader, 0, len(*m))
+
+	for _, reader := range *m {
+		result = append(result, &chat_proto.MessageReader{
+			UserUuid:  reader.UserUUID,
+			Nickname:  reader.Nickname,
+			AvatarUrl: reader.AvatarURL,
+			ReadAt:    reader.ReadAt.Format(time.RFC3339),
+		})
+	}
+
+	return result
+}
@@ -0,0 +1,41 @@
+package model
+
+import (
+	chat_proto "github.com/s21platform/chat-service/pkg/chat"
+)
+
+type SearchResult struct {
+	Message
+	ChatUUID string `db:"chat_uuid"` // uuid чата, в котором найдено сообщение
+	Snippet  string `db:"snippet"`   // фрагмент текста с выделенными совпадениями
+}
+
+type SearchResultList []SearchResult
+
+func (s *SearchResultList) FromDTO() []*chat_proto.SearchResult {
+	result := make([]*chat_proto.SearchResult, 0, len(*s))
+
+	for _, found := range *s {
+		result = append(result, &chat_proto.SearchResult{
+			Message:  found.Message.FromDTO(),
+			ChatUuid: found.ChatUUID,
+			Snippet:  found.Snippet,
+		})
+	}
+
+	return result
+}
+
+func (s *SearchResultList) NextCursor() string {
+	if len(*s) == 0 {
+		return ""
+	}
+
+	last := (*s)[len(*s)-1]
+	cursor := &MessageCursor{
+		SentAt: last.SentAt,
+		ID:     last.ID.String(),
+	}
+
+	return cursor.Encode()
+}
@@ -0,0 +1,31 @@
+package model
+
+import (
+	"time"
+
+	chat_proto "github.com/s21platform/chat-service/pkg/chat"
+)
+
+type NotificationBatch struct {
+	RecipientUUID   string    `db:"recipient_uuid"`    // uuid получателя уведомления
+	ChatUUID        string    `db:"chat_uuid"`         // uuid чата с новыми сообщениями
+	Count           int64     `db:"messages_count"`    // количество сообщений в группе
+	LastMessageUUID string    `db:"last_message_uuid"` // uuid последнего сообщения
+	LastSenderUUID  string    `db:"last_sender_uuid"`  // uuid автора последнего сообщения
+	FirstMessageAt  time.Time `db:"first_message_at"`  // время первого сообщения в группе
+	LastMessageAt   time.Time `db:"last_message_at"`   // время последнего сообщения в группе
+}
+
+type NotificationBatchList []NotificationBatch
+
+func (b *NotificationBatch) FromDTO() *chat_proto.NotificationRequested {
+	return &chat_proto.NotificationRequested{
+		RecipientUuid:   b.RecipientUUID,
+		ChatUuid:        b.ChatUUID,
+		MessagesCount:   b.Count,
+		LastMessageUuid: b.LastMessageUUID,
+		LastSenderUuid:  b.LastSenderUUID,
+		FirstMessageAt:  b.FirstMessageAt.Format(time.RFC3339),
+		LastMessageAt:   b.LastMessageAt.Format(time.RFC3339),
+	}
+}
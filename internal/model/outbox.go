@@ -0,0 +1,24 @@
+package model
+
+// типы событий outbox, совпадают с вариантами payload в ChatDomainEvent
+const (
+	OutboxEventMessageSent    string = "message_sent"
+	OutboxEventMessageEdited  string = "message_edited"
+	OutboxEventMessageDeleted string = "message_deleted"
+	OutboxEventChatCreated    string = "chat_created"
+)
+
+type NewOutboxEvent struct {
+	ChatUUID string // uuid чата, определяет порядок доставки
+	Type     string // тип события
+	Payload  []byte // ChatDomainEvent в формате protojson
+}
+
+type OutboxEvent struct {
+	ID       int64  `db:"id"`        // порядковый номер события
+	ChatUUID string `db:"chat_uuid"` // uuid чата
+	Type     string `db:"type"`      // тип события
+	Payload  []byte `db:"payload"`   // ChatDomainEvent в формате protojson
+}
+
+type OutboxEventList []OutboxEvent
@@ -0,0 +1,29 @@
+package model
+
+import (
+	"time"
+
+	chat_proto "github.com/s21platform/chat-service/pkg/chat"
+)
+
+type PinnedMessage struct {
+	Message
+	PinnedBy string    `db:"pinned_by"` // uuid пользователя, закрепившего сообщение
+	PinnedAt time.Time `db:"pinned_at"` // время закрепления
+}
+
+type PinnedMessageList []PinnedMessage
+
+func (p *PinnedMessageList) FromDTO() []*chat_proto.PinnedMessage {
+	result := make([]*chat_proto.PinnedMessage, 0, len(*p))
+
+	for _, pinned := range *p {
+		result = append(result, &chat_proto.PinnedMessage{
+			Message:  pinned.Message.FromDTO(),
+			PinnedBy: pinned.PinnedBy,
+			PinnedAt: pinned.PinnedAt.Format(time.RFC3339),
+		})
+	}
+
+	return result
+}
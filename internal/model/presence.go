@@ -0,0 +1,42 @@
+package model
+
+import (
+	"time"
+
+	chat_proto "github.com/s21platform/chat-service/pkg/chat"
+)
+
+type UserPresence struct {
+	UserUUID   string     `db:"user_uuid"`   // uuid пользователя
+	LastOnline *time.Time `db:"last_online"` // время последней активности
+	Online     bool       // пользователь сейчас онлайн, вычисляется по last_online
+}
+
+type UserPresenceList []UserPresence
+
+func (p *UserPresenceList) FromDTO() []*chat_proto.Presence {
+	result := make([]*chat_proto.Presence, 0, len(*p))
+
+	for _, presence := range *p {
+		result = append(result, &chat_proto.Presence{
+			UserUuid:   presence.UserUUID,
+			Online:     presence.Online,
+			LastOnline: formatOptionalTime(presence.LastOnline),
+		})
+	}
+
+	return result
+}
+
+// IsOnline проверяет, что последний heartbeat пользователя был не раньше timeout назад
+func IsOnline(lastOnline *time.Time, timeout time.Duration) bool {
+	return lastOnline != nil && time.Since(*lastOnline) < timeout
+}
+
+func formatOptionalTime(t *time.Time) string {
+	if t == nil {
+		return ""
+	}
+
+	return t.Format(time.RFC3339)
+}
@@ -0,0 +1,45 @@
+package model
+
+import (
+	"encoding/json"
+	"fmt"
+
+	chat_proto "github.com/s21platform/chat-service/pkg/chat"
+)
+
+type Reaction struct {
+	Emoji       string `json:"emoji"`         // эмодзи реакции
+	Count       int64  `json:"count"`         // сколько пользователей поставили реакцию
+	ReactedByMe bool   `json:"reacted_by_me"` // реакция поставлена текущим пользователем
+}
+
+type ReactionList []Reaction
+
+// Scan читает агрегированные реакции, собранные запросом в json
+func (r *ReactionList) Scan(src interface{}) error {
+	switch value := src.(type) {
+	case nil:
+		*r = nil
+		return nil
+	case []byte:
+		return json.Unmarshal(value, r)
+	case string:
+		return json.Unmarshal([]byte(value), r)
+	default:
+		return fmt.Errorf("unsupported reactions type %T", src)
+	}
+}
+
+func (r ReactionList) FromDTO() []*chat_proto.Reaction {
+	result := make([]*chat_proto.Reaction, 0, len(r))
+
+	for _, reaction := range r {
+		result = append(result, &chat_proto.Reaction{
+			Emoji:       reaction.Emoji,
+			Count:       reaction.Count,
+			ReactedByMe: reaction.ReactedByMe,
+		})
+	}
+
+	return result
+}
@@ -0,0 +1,26 @@
+package model
+
+import "sort"
+
+const (
+	StreamTypePrivate string = "private"
+	StreamTypeGroup   string = "group"
+	StreamTypeComment string = "comment"
+	StreamTypeChannel string = "channel"
+)
+
+type StreamMetadata struct {
+	Name       string `json:"name,omitempty"`        // название чата
+	AvatarURL  string `json:"avatar_url,omitempty"`  // аватарка чата
+	EntityType string `json:"entity_type,omitempty"` // тип сущности, к которой привязана лента комментариев
+	EntityID   string `json:"entity_id,omitempty"`   // идентификатор сущности, к которой привязана лента комментариев
+	PairKey    string `json:"pair_key,omitempty"`    // неупорядоченная пара собеседников личного чата
+}
+
+// PrivatePairKey возвращает ключ личного чата, не зависящий от порядка собеседников
+func PrivatePairKey(firstUUID, secondUUID string) string {
+	pair := []string{firstUUID, secondUUID}
+	sort.Strings(pair)
+
+	return pair[0] + ":" + pair[1]
+}
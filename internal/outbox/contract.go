@@ -0,0 +1,18 @@
+//go:generate mockgen -destination=mock_contract_test.go -package=${GOPACKAGE} -source=contract.go
+package outbox
+
+import (
+	"context"
+
+	"github.com/s21platform/chat-service/internal/model"
+)
+
+type DBRepo interface {
+	WithTx(ctx context.Context, fn func(ctx context.Context) error) error
+	GetUnpublishedOutboxEvents(ctx context.Context, limit uint64) (*model.OutboxEventList, error)
+	MarkOutboxEventsPublished(ctx context.Context, ids []int64) error
+}
+
+type Producer interface {
+	ProduceMessage(ctx context.Context, message any, key any) error
+}
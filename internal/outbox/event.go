@@ -0,0 +1,40 @@
+package outbox
+
+import (
+	"time"
+
+	"github.com/s21platform/chat-service/internal/model"
+	"github.com/s21platform/chat-service/pkg/chat"
+)
+
+// NewEvent готовит доменное событие к записи в outbox в той же транзакции, что и изменение, которое оно описывает
+func NewEvent(chatUUID string, event *chat.ChatDomainEvent) (*model.NewOutboxEvent, error) {
+	event.ChatUuid = chatUUID
+	event.OccurredAt = time.Now().Format(time.RFC3339)
+
+	payload, err := marshalOptions.Marshal(event)
+	if err != nil {
+		return nil, err
+	}
+
+	return &model.NewOutboxEvent{
+		ChatUUID: chatUUID,
+		Type:     eventType(event),
+		Payload:  payload,
+	}, nil
+}
+
+func eventType(event *chat.ChatDomainEvent) string {
+	switch event.Payload.(type) {
+	case *chat.ChatDomainEvent_MessageSent:
+		return model.OutboxEventMessageSent
+	case *chat.ChatDomainEvent_MessageEdited:
+		return model.OutboxEventMessageEdited
+	case *chat.ChatDomainEvent_MessageDeleted:
+		return model.OutboxEventMessageDeleted
+	case *chat.ChatDomainEvent_ChatCreated:
+		return model.OutboxEventChatCreated
+	default:
+		return ""
+	}
+}
@@ -0,0 +1,55 @@
+package outbox
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/segmentio/kafka-go"
+)
+
+const (
+	produceTimeout = 10 * time.Second
+	// relay отправляет события по одному и ждет подтверждения, поэтому writer не должен копить пачку:
+	// с BatchTimeout kafka-go по умолчанию каждая отправка ждала бы секунду
+	produceBatchTimeout = 5 * time.Millisecond
+)
+
+// KafkaProducer отправляет события в kafka, выбирая партицию по хешу ключа.
+// Producer из kafka-lib распределяет сообщения по нагрузке и не учитывает ключ
+type KafkaProducer struct {
+	writer *kafka.Writer
+}
+
+func NewKafkaProducer(host, port, topic string) *KafkaProducer {
+	return &KafkaProducer{writer: &kafka.Writer{
+		Addr:         kafka.TCP(host + ":" + port),
+		Topic:        topic,
+		Balancer:     &kafka.Hash{},
+		RequiredAcks: kafka.RequireAll,
+		WriteTimeout: produceTimeout,
+		BatchTimeout: produceBatchTimeout,
+	}}
+}
+
+func (p *KafkaProducer) ProduceMessage(ctx context.Context, message any, key any) error {
+	bMessage, err := json.Marshal(message)
+	if err != nil {
+		return fmt.Errorf("failed to marshal message: %v", err)
+	}
+
+	err = p.writer.WriteMessages(ctx, kafka.Message{
+		Key:   []byte(fmt.Sprint(key)),
+		Value: bMessage,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to write message: %v", err)
+	}
+
+	return nil
+}
+
+func (p *KafkaProducer) Close() error {
+	return p.writer.Close()
+}
@@ -0,0 +1,125 @@
+package outbox
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"google.golang.org/protobuf/encoding/protojson"
+
+	logger_lib "github.com/s21platform/logger-lib"
+	"github.com/s21platform/metrics-lib/pkg"
+
+	"github.com/s21platform/chat-service/internal/config"
+	"github.com/s21platform/chat-service/internal/model"
+	"github.com/s21platform/chat-service/pkg/chat"
+)
+
+const (
+	pollInterval = time.Second
+	batchSize    = 100
+)
+
+var marshalOptions = protojson.MarshalOptions{UseProtoNames: true}
+
+// Relay публикует события из outbox в kafka с доставкой at-least-once:
+// событие помечается опубликованным только после успешной отправки.
+// Ключ сообщения - uuid чата, поэтому все события чата попадают в одну партицию и сохраняют порядок id,
+// а число партиций топика не ограничено. Повторы после сбоя потребители отбрасывают по id события
+type Relay struct {
+	dbR      DBRepo
+	producer Producer
+}
+
+func New(dbR DBRepo, producer Producer) *Relay {
+	return &Relay{dbR: dbR, producer: producer}
+}
+
+func (r *Relay) Run(ctx context.Context) {
+	logger := logger_lib.FromContext(ctx, config.KeyLogger)
+	logger.AddFuncName("Run")
+
+	ticker := time.NewTicker(pollInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			err := r.publishBatch(ctx)
+			if err != nil {
+				logger.Error(fmt.Sprintf("failed to publish outbox events: %v", err))
+			}
+		}
+	}
+}
+
+// publishBatch отправляет очередную пачку событий. На первой ошибке отправка останавливается,
+// чтобы следующие события того же чата не обогнали неотправленное
+func (r *Relay) publishBatch(ctx context.Context) error {
+	logger := logger_lib.FromContext(ctx, config.KeyLogger)
+	m := pkg.FromContext(ctx, config.KeyMetrics)
+
+	var produceErr error
+	err := r.dbR.WithTx(ctx, func(ctx context.Context) error {
+		events, err := r.dbR.GetUnpublishedOutboxEvents(ctx, batchSize)
+		if err != nil {
+			return fmt.Errorf("failed to get unpublished outbox events: %v", err)
+		}
+
+		published := make([]int64, 0, len(*events))
+		for _, event := range *events {
+			message, err := domainEventMessage(event)
+			if err != nil {
+				// битое событие не исправится повторной попыткой и не должно блокировать очередь
+				m.Increment("outbox_relay.skipped")
+				logger.Error(fmt.Sprintf("failed to decode outbox event %d: %v", event.ID, err))
+				published = append(published, event.ID)
+				continue
+			}
+
+			err = r.producer.ProduceMessage(ctx, message, event.ChatUUID)
+			if err != nil {
+				m.Increment("outbox_relay.error")
+				produceErr = fmt.Errorf("failed to produce outbox event %d: %v", event.ID, err)
+				break
+			}
+
+			m.Increment("outbox_relay.success")
+			published = append(published, event.ID)
+		}
+
+		err = r.dbR.MarkOutboxEventsPublished(ctx, published)
+		if err != nil {
+			return fmt.Errorf("failed to mark outbox events published: %v", err)
+		}
+
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+
+	return produceErr
+}
+
+// domainEventMessage проставляет id события, по которому потребители отбрасывают повторы.
+// Сообщение уже сериализовано в protojson, поэтому передается продюсеру как есть
+func domainEventMessage(event model.OutboxEvent) (json.RawMessage, error) {
+	var domainEvent chat.ChatDomainEvent
+	err := protojson.Unmarshal(event.Payload, &domainEvent)
+	if err != nil {
+		return nil, err
+	}
+
+	domainEvent.Id = event.ID
+
+	raw, err := marshalOptions.Marshal(&domainEvent)
+	if err != nil {
+		return nil, err
+	}
+
+	return raw, nil
+}
@@ -0,0 +1,128 @@
+package outbox
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/protobuf/encoding/protojson"
+
+	logger_lib "github.com/s21platform/logger-lib"
+	"github.com/s21platform/metrics-lib/pkg"
+
+	"github.com/s21platform/chat-service/internal/config"
+	"github.com/s21platform/chat-service/internal/model"
+	"github.com/s21platform/chat-service/pkg/chat"
+)
+
+func runInTx(ctx context.Context, fn func(ctx context.Context) error) error {
+	return fn(ctx)
+}
+
+func TestRelay_publishBatch(t *testing.T) {
+	t.Parallel()
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockRepo := NewMockDBRepo(ctrl)
+	mockProducer := NewMockProducer(ctrl)
+	mockLogger := logger_lib.NewMockLoggerInterface(ctrl)
+	mockMetrics := pkg.NewMockMetricInterface(ctrl)
+
+	ctx := context.Background()
+	ctx = context.WithValue(ctx, config.KeyLogger, mockLogger)
+	ctx = context.WithValue(ctx, config.KeyMetrics, mockMetrics)
+
+	chatUUID := uuid.New().String()
+	payload, err := protojson.Marshal(&chat.ChatDomainEvent{
+		ChatUuid: chatUUID,
+		Payload: &chat.ChatDomainEvent_MessageDeleted{MessageDeleted: &chat.MessageDeleted{
+			MessageUuid: uuid.New().String(),
+			Mode:        "all",
+		}},
+	})
+	assert.NoError(t, err)
+
+	r := New(mockRepo, mockProducer)
+	mockRepo.EXPECT().WithTx(gomock.Any(), gomock.Any()).DoAndReturn(runInTx).AnyTimes()
+
+	t.Run("success", func(t *testing.T) {
+		mockRepo.EXPECT().GetUnpublishedOutboxEvents(ctx, uint64(batchSize)).Return(&model.OutboxEventList{
+			{ID: 1, ChatUUID: chatUUID, Type: model.OutboxEventMessageDeleted, Payload: payload},
+			{ID: 2, ChatUUID: chatUUID, Type: model.OutboxEventMessageDeleted, Payload: payload},
+		}, nil)
+		mockProducer.EXPECT().ProduceMessage(ctx, gomock.Any(), chatUUID).DoAndReturn(func(_ context.Context, message any, _ any) error {
+			var event chat.ChatDomainEvent
+			assert.NoError(t, protojson.Unmarshal(message.(json.RawMessage), &event))
+			assert.Equal(t, int64(1), event.Id)
+			assert.Equal(t, chatUUID, event.ChatUuid)
+			return nil
+		})
+		mockProducer.EXPECT().ProduceMessage(ctx, gomock.Any(), chatUUID).Return(nil)
+		mockMetrics.EXPECT().Increment("outbox_relay.success").Times(2)
+		mockRepo.EXPECT().MarkOutboxEventsPublished(ctx, []int64{1, 2}).Return(nil)
+
+		err := r.publishBatch(ctx)
+
+		assert.NoError(t, err)
+	})
+
+	t.Run("produce_error", func(t *testing.T) {
+		mockRepo.EXPECT().GetUnpublishedOutboxEvents(ctx, uint64(batchSize)).Return(&model.OutboxEventList{
+			{ID: 3, ChatUUID: chatUUID, Type: model.OutboxEventMessageDeleted, Payload: payload},
+			{ID: 4, ChatUUID: chatUUID, Type: model.OutboxEventMessageDeleted, Payload: payload},
+			{ID: 5, ChatUUID: chatUUID, Type: model.OutboxEventMessageDeleted, Payload: payload},
+		}, nil)
+		mockProducer.EXPECT().ProduceMessage(ctx, gomock.Any(), chatUUID).Return(nil)
+		mockProducer.EXPECT().ProduceMessage(ctx, gomock.Any(), chatUUID).Return(fmt.Errorf("kafka error"))
+		mockMetrics.EXPECT().Increment("outbox_relay.success")
+		mockMetrics.EXPECT().Increment("outbox_relay.error")
+		mockRepo.EXPECT().MarkOutboxEventsPublished(ctx, []int64{3}).Return(nil)
+
+		err := r.publishBatch(ctx)
+
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), "failed to produce outbox event 4")
+	})
+
+	t.Run("broken_payload", func(t *testing.T) {
+		mockRepo.EXPECT().GetUnpublishedOutboxEvents(ctx, uint64(batchSize)).Return(&model.OutboxEventList{
+			{ID: 6, ChatUUID: chatUUID, Type: model.OutboxEventMessageDeleted, Payload: []byte("{broken")},
+		}, nil)
+		mockMetrics.EXPECT().Increment("outbox_relay.skipped")
+		mockLogger.EXPECT().Error(gomock.Any())
+		mockRepo.EXPECT().MarkOutboxEventsPublished(ctx, []int64{6}).Return(nil)
+
+		err := r.publishBatch(ctx)
+
+		assert.NoError(t, err)
+	})
+
+	t.Run("GetUnpublishedOutboxEvents_error", func(t *testing.T) {
+		mockRepo.EXPECT().GetUnpublishedOutboxEvents(ctx, uint64(batchSize)).Return(nil, fmt.Errorf("db error"))
+
+		err := r.publishBatch(ctx)
+
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), "failed to get unpublished outbox events")
+	})
+
+	t.Run("MarkOutboxEventsPublished_error", func(t *testing.T) {
+		mockRepo.EXPECT().GetUnpublishedOutboxEvents(ctx, uint64(batchSize)).Return(&model.OutboxEventList{
+			{ID: 7, ChatUUID: chatUUID, Type: model.OutboxEventMessageDeleted, Payload: payload},
+		}, nil)
+		mockProducer.EXPECT().ProduceMessage(ctx, gomock.Any(), chatUUID).Return(nil)
+		mockMetrics.EXPECT().Increment("outbox_relay.success")
+		mockRepo.EXPECT().MarkOutboxEventsPublished(ctx, []int64{7}).Return(fmt.Errorf("db error"))
+
+		err := r.publishBatch(ctx)
+
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), "failed to mark outbox events published")
+	})
+}
@@ -0,0 +1,103 @@
+package broker
+
+import "sync"
+
+// Broker раздает события подписчикам внутри процесса.
+// Публикация никогда не ждет читателя: подписка, буфер которой переполнен,
+// закрывается, и клиент должен переподключиться и догрузить пропущенное из истории.
+type Broker[T any] struct {
+	mu          sync.RWMutex
+	subscribers map[string]map[*Subscription[T]]struct{}
+	bufferSize  int
+}
+
+type Subscription[T any] struct {
+	key    string
+	events chan T
+}
+
+func New[T any](bufferSize int) *Broker[T] {
+	return &Broker[T]{
+		subscribers: make(map[string]map[*Subscription[T]]struct{}),
+		bufferSize:  bufferSize,
+	}
+}
+
+func (b *Broker[T]) Subscribe(key string) *Subscription[T] {
+	sub := &Subscription[T]{
+		key:    key,
+		events: make(chan T, b.bufferSize),
+	}
+
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	if b.subscribers[key] == nil {
+		b.subscribers[key] = make(map[*Subscription[T]]struct{})
+	}
+	b.subscribers[key][sub] = struct{}{}
+
+	return sub
+}
+
+func (b *Broker[T]) Unsubscribe(sub *Subscription[T]) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	b.remove(sub)
+}
+
+func (b *Broker[T]) Publish(key string, event T) {
+	var slow []*Subscription[T]
+
+	b.mu.RLock()
+	for sub := range b.subscribers[key] {
+		select {
+		case sub.events <- event:
+		default:
+			slow = append(slow, sub)
+		}
+	}
+	b.mu.RUnlock()
+
+	if len(slow) == 0 {
+		return
+	}
+
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	for _, sub := range slow {
+		b.remove(sub)
+	}
+}
+
+// HasSubscribers сообщает, есть ли хотя бы одна активная подписка, чтобы не готовить события впустую
+func (b *Broker[T]) HasSubscribers() bool {
+	b.mu.RLock()
+	defer b.mu.RUnlock()
+
+	return len(b.subscribers) > 0
+}
+
+// Events закрывается, когда подписка отменена или отключена из-за переполнения
+func (s *Subscription[T]) Events() <-chan T {
+	return s.events
+}
+
+// remove вызывается под блокировкой на запись, поэтому канал не закроется во время отправки
+func (b *Broker[T]) remove(sub *Subscription[T]) {
+	subs, ok := b.subscribers[sub.key]
+	if !ok {
+		return
+	}
+	if _, ok = subs[sub]; !ok {
+		return
+	}
+
+	delete(subs, sub)
+	if len(subs) == 0 {
+		delete(b.subscribers, sub.key)
+	}
+	close(sub.events)
+}
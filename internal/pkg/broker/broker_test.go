@@ -0,0 +1,50 @@
+package broker
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestBroker(t *testing.T) {
+	t.Parallel()
+
+	t.Run("delivers_to_key_subscribers", func(t *testing.T) {
+		b := New[string](4)
+		sub := b.Subscribe("user-1")
+		other := b.Subscribe("user-2")
+
+		b.Publish("user-1", "hello")
+
+		assert.Equal(t, "hello", <-sub.Events())
+		assert.Empty(t, other.Events())
+	})
+
+	t.Run("drops_slow_subscriber_without_blocking", func(t *testing.T) {
+		b := New[int](1)
+		slow := b.Subscribe("user-1")
+		fast := b.Subscribe("user-1")
+
+		b.Publish("user-1", 1)
+		<-fast.Events()
+		b.Publish("user-1", 2)
+
+		assert.Equal(t, 1, <-slow.Events())
+		_, ok := <-slow.Events()
+		assert.False(t, ok)
+		assert.Equal(t, 2, <-fast.Events())
+		assert.True(t, b.HasSubscribers())
+	})
+
+	t.Run("unsubscribe_closes_events", func(t *testing.T) {
+		b := New[int](1)
+		sub := b.Subscribe("user-1")
+
+		b.Unsubscribe(sub)
+		b.Unsubscribe(sub)
+
+		_, ok := <-sub.Events()
+		assert.False(t, ok)
+		assert.False(t, b.HasSubscribers())
+	})
+}
@@ -0,0 +1,136 @@
+package typing
+
+import (
+	"sync"
+	"time"
+
+	"github.com/s21platform/chat-service/internal/pkg/broker"
+)
+
+type Update struct {
+	ChatUUID string // uuid чата
+	UserUUID string // uuid печатающего пользователя
+	Typing   bool   // пользователь начал или закончил печатать
+}
+
+// Tracker хранит в памяти, кто печатает в каждом чате.
+// Состояние сбрасывается само через ttl без обновления, а частые обновления одного пользователя отбрасываются.
+type Tracker struct {
+	mu       sync.Mutex
+	ttl      time.Duration
+	throttle time.Duration
+	states   map[string]map[string]*state
+	accepted map[string]time.Time
+	swept    time.Time
+	updates  *broker.Broker[Update]
+}
+
+type state struct {
+	timer *time.Timer
+}
+
+func New(ttl, throttle time.Duration, bufferSize int) *Tracker {
+	return &Tracker{
+		ttl:      ttl,
+		throttle: throttle,
+		states:   make(map[string]map[string]*state),
+		accepted: make(map[string]time.Time),
+		updates:  broker.New[Update](bufferSize),
+	}
+}
+
+// Set обновляет состояние пользователя и возвращает false, если обновление отброшено из-за частоты
+func (t *Tracker) Set(chatUUID, userUUID string, typing bool) bool {
+	t.mu.Lock()
+
+	key := chatUUID + "/" + userUUID
+	now := time.Now()
+	if last, ok := t.accepted[key]; ok && now.Sub(last) < t.throttle {
+		t.mu.Unlock()
+		return false
+	}
+	t.accepted[key] = now
+	t.sweepAccepted(now)
+
+	current := t.states[chatUUID][userUUID]
+	if current != nil {
+		current.timer.Stop()
+		t.removeState(chatUUID, userUUID)
+	}
+
+	if typing {
+		next := &state{}
+		next.timer = time.AfterFunc(t.ttl, func() {
+			t.expire(chatUUID, userUUID, next)
+		})
+		if t.states[chatUUID] == nil {
+			t.states[chatUUID] = make(map[string]*state)
+		}
+		t.states[chatUUID][userUUID] = next
+	}
+	t.mu.Unlock()
+
+	// повторное «печатает» только продлевает состояние, остальным участникам сообщать нечего
+	if typing && current != nil {
+		return true
+	}
+	if !typing && current == nil {
+		return true
+	}
+
+	t.updates.Publish(chatUUID, Update{ChatUUID: chatUUID, UserUUID: userUUID, Typing: typing})
+
+	return true
+}
+
+// Watch подписывает на обновления чата и возвращает тех, кто печатает в момент подписки
+func (t *Tracker) Watch(chatUUID string) (*broker.Subscription[Update], []string) {
+	sub := t.updates.Subscribe(chatUUID)
+
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	typingUsers := make([]string, 0, len(t.states[chatUUID]))
+	for userUUID := range t.states[chatUUID] {
+		typingUsers = append(typingUsers, userUUID)
+	}
+
+	return sub, typingUsers
+}
+
+func (t *Tracker) Unwatch(sub *broker.Subscription[Update]) {
+	t.updates.Unsubscribe(sub)
+}
+
+func (t *Tracker) expire(chatUUID, userUUID string, expired *state) {
+	t.mu.Lock()
+	if t.states[chatUUID][userUUID] != expired {
+		t.mu.Unlock()
+		return
+	}
+	t.removeState(chatUUID, userUUID)
+	t.mu.Unlock()
+
+	t.updates.Publish(chatUUID, Update{ChatUUID: chatUUID, UserUUID: userUUID, Typing: false})
+}
+
+// sweepAccepted забывает время обновлений, которые уже не влияют на ограничение частоты
+func (t *Tracker) sweepAccepted(now time.Time) {
+	if now.Sub(t.swept) < t.ttl {
+		return
+	}
+	t.swept = now
+
+	for key, last := range t.accepted {
+		if now.Sub(last) >= t.throttle {
+			delete(t.accepted, key)
+		}
+	}
+}
+
+func (t *Tracker) removeState(chatUUID, userUUID string) {
+	delete(t.states[chatUUID], userUUID)
+	if len(t.states[chatUUID]) == 0 {
+		delete(t.states, chatUUID)
+	}
+}
@@ -0,0 +1,41 @@
+package typing
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestTracker(t *testing.T) {
+	t.Parallel()
+
+	t.Run("publishes_start_and_expiry", func(t *testing.T) {
+		tracker := New(20*time.Millisecond, time.Millisecond, 4)
+		sub, typingUsers := tracker.Watch("chat")
+		defer tracker.Unwatch(sub)
+
+		assert.Empty(t, typingUsers)
+		assert.True(t, tracker.Set("chat", "user", true))
+		assert.Equal(t, Update{ChatUUID: "chat", UserUUID: "user", Typing: true}, <-sub.Events())
+
+		select {
+		case update := <-sub.Events():
+			assert.Equal(t, Update{ChatUUID: "chat", UserUUID: "user", Typing: false}, update)
+		case <-time.After(time.Second):
+			t.Fatal("typing state did not expire")
+		}
+	})
+
+	t.Run("throttles_frequent_updates", func(t *testing.T) {
+		tracker := New(time.Minute, time.Minute, 4)
+
+		assert.True(t, tracker.Set("chat", "user", true))
+		assert.False(t, tracker.Set("chat", "user", false))
+		assert.True(t, tracker.Set("chat", "other", true))
+
+		sub, typingUsers := tracker.Watch("chat")
+		defer tracker.Unwatch(sub)
+		assert.ElementsMatch(t, []string{"user", "other"}, typingUsers)
+	})
+}
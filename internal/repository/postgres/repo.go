@@ -3,9 +3,12 @@ package postgres
 import (
 	"context"
 	"database/sql"
+	"encoding/json"
 	"errors"
 	"fmt"
 	"log"
+	"sort"
+	"strings"
 	"time"
 
 	sq "github.com/Masterminds/squirrel"
@@ -37,37 +40,113 @@ func (r *Repository) Close() {
 	_ = r.connection.Close()
 }
 
-func (r *Repository) CreatePrivateChat(ctx context.Context) (string, error) {
-	query, args, err := sq.Insert("chats").
-		Columns("created_at").
-		Values(time.Now()).
-		Suffix("RETURNING uuid").
+type txKey struct{}
+
+// executor объединяет методы, общие для подключения и транзакции
+type executor interface {
+	GetContext(ctx context.Context, dest interface{}, query string, args ...interface{}) error
+	SelectContext(ctx context.Context, dest interface{}, query string, args ...interface{}) error
+	ExecContext(ctx context.Context, query string, args ...interface{}) (sql.Result, error)
+}
+
+// WithTx выполняет fn в одной транзакции: методы репозитория, вызванные с контекстом из fn, работают внутри нее.
+// Ошибка fn откатывает транзакцию и возвращается без изменений. Вложенный вызов присоединяется к уже открытой транзакции
+func (r *Repository) WithTx(ctx context.Context, fn func(ctx context.Context) error) error {
+	if _, ok := ctx.Value(txKey{}).(*sqlx.Tx); ok {
+		return fn(ctx)
+	}
+
+	tx, err := r.connection.BeginTxx(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("failed to begin transaction: %v", err)
+	}
+
+	err = fn(context.WithValue(ctx, txKey{}, tx))
+	if err != nil {
+		_ = tx.Rollback()
+		return err
+	}
+
+	err = tx.Commit()
+	if err != nil {
+		return fmt.Errorf("failed to commit transaction: %v", err)
+	}
+
+	return nil
+}
+
+// conn возвращает транзакцию из ctx, если она открыта через WithTx, иначе общее подключение
+func (r *Repository) conn(ctx context.Context) executor {
+	if tx, ok := ctx.Value(txKey{}).(*sqlx.Tx); ok {
+		return tx
+	}
+
+	return r.connection
+}
+
+// GetOrCreatePrivateChat возвращает личный чат пары собеседников, создавая его при отсутствии.
+// Уникальный индекс по pair_key не дает создать дубликат при одновременных вызовах
+func (r *Repository) GetOrCreatePrivateChat(ctx context.Context, pairKey string) (string, bool, error) {
+	metadata, err := json.Marshal(model.StreamMetadata{PairKey: pairKey})
+	if err != nil {
+		return "", false, fmt.Errorf("failed to marshal stream metadata: %v", err)
+	}
+
+	query, args, err := sq.Insert("streams").
+		Columns("type", "metadata").
+		Values(model.StreamTypePrivate, string(metadata)).
+		Suffix("ON CONFLICT ((metadata->>'pair_key')) WHERE type = 'private' DO NOTHING RETURNING id").
 		PlaceholderFormat(sq.Dollar).
 		ToSql()
 	if err != nil {
-		return "", fmt.Errorf("failed to build sql query: %v", err)
+		return "", false, fmt.Errorf("failed to build sql query: %v", err)
 	}
 
 	var chatUUID string
-	err = r.connection.GetContext(ctx, &chatUUID, query, args...)
+	err = r.conn(ctx).GetContext(ctx, &chatUUID, query, args...)
+	if err == nil {
+		return chatUUID, true, nil
+	}
+	if !errors.Is(err, sql.ErrNoRows) {
+		return "", false, err
+	}
+
+	query, args, err = sq.Select("id").
+		From("streams").
+		Where(sq.Eq{"type": model.StreamTypePrivate}).
+		Where(sq.Expr("metadata->>'pair_key' = ?", pairKey)).
+		PlaceholderFormat(sq.Dollar).
+		ToSql()
 	if err != nil {
-		return "", err
+		return "", false, fmt.Errorf("failed to build sql query: %v", err)
 	}
 
-	return chatUUID, nil
+	err = r.conn(ctx).GetContext(ctx, &chatUUID, query, args...)
+	if err != nil {
+		return "", false, err
+	}
+
+	return chatUUID, false, nil
 }
 
 func (r *Repository) AddPrivateChatMember(ctx context.Context, chatUUID string, member *model.ChatMemberParams) error {
-	query, args, err := sq.Insert("chats_user").
-		Columns("chat_uuid", "user_uuid", "username", "avatar_link").
-		Values(chatUUID, member.UserUUID, member.Nickname, member.AvatarLink).
+	err := r.upsertUser(ctx, member)
+	if err != nil {
+		return err
+	}
+
+	// вышедший из личного чата собеседник возвращается в него, активный участник не меняется
+	query, args, err := sq.Insert("stream_members").
+		Columns("stream_id", "user_id", "metadata").
+		Values(chatUUID, member.UserUUID, "{}").
+		Suffix("ON CONFLICT (stream_id, user_id) DO UPDATE SET " + rejoinMemberSet + " WHERE stream_members.left_at IS NOT NULL").
 		PlaceholderFormat(sq.Dollar).
 		ToSql()
 	if err != nil {
 		return fmt.Errorf("failed to build sql query: %v", err)
 	}
 
-	_, err = r.connection.ExecContext(ctx, query, args...)
+	_, err = r.conn(ctx).ExecContext(ctx, query, args...)
 	if err != nil {
 		return err
 	}
@@ -78,15 +157,29 @@ func (r *Repository) AddPrivateChatMember(ctx context.Context, chatUUID string,
 func (r *Repository) GetPrivateChats(ctx context.Context, userUUID string) (*model.ChatInfoList, error) {
 	query, args, err := sq.Select(
 		"COALESCE(m.content, '') AS content",
-		"(SELECT username FROM chats_user WHERE chat_uuid = c.uuid AND user_uuid != $1) AS chat_name",
-		"(SELECT avatar_link FROM chats_user WHERE chat_uuid = c.uuid AND user_uuid != $1) AS avatar_link",
-		"(SELECT MAX(sent_at) FROM messages WHERE chat_uuid = c.uuid) AS created_at",
-		"c.uuid",
+		"u.nickname AS chat_name",
+		"u.avatar_url AS avatar_link",
+		"m.sent_at AS created_at",
+		"s.id AS uuid",
+		"s.type AS stream_type",
 	).
-		From("chats_user cu").
-		Join("chats c ON c.uuid = cu.chat_uuid").
-		LeftJoin("messages m ON c.uuid = m.chat_uuid AND m.sent_at = (SELECT MAX(sent_at) FROM messages WHERE chat_uuid = c.uuid)").
-		Where(sq.Eq{"cu.user_uuid": userUUID}).
+		Column(unreadCountColumn(userUUID)).
+		Columns(
+			"COALESCE(p.content, '') AS pinned_message",
+			"COALESCE(p.message_id::text, '') AS pinned_message_uuid",
+			"u.id AS companion_uuid",
+			"u.last_online AS companion_last_online",
+		).
+		Columns(notificationColumns("sm")...).
+		From("stream_members sm").
+		Join("streams s ON s.id = sm.stream_id").
+		Join("stream_members cm ON cm.stream_id = s.id AND cm.user_id != sm.user_id").
+		Join("users u ON u.id = cm.user_id").
+		LeftJoin(lastMessageJoin, userUUID, userUUID).
+		LeftJoin(lastPinnedMessageJoin, userUUID, userUUID).
+		Where(sq.Eq{"sm.user_id": userUUID}).
+		Where(sq.Eq{"sm.left_at": nil}).
+		Where(sq.Eq{"s.type": model.StreamTypePrivate}).
 		PlaceholderFormat(sq.Dollar).
 		ToSql()
 	if err != nil {
@@ -94,7 +187,7 @@ func (r *Repository) GetPrivateChats(ctx context.Context, userUUID string) (*mod
 	}
 
 	var chats model.ChatInfoList
-	err = r.connection.SelectContext(ctx, &chats, query, args...)
+	err = r.conn(ctx).SelectContext(ctx, &chats, query, args...)
 	if err != nil {
 		return nil, err
 	}
@@ -104,16 +197,27 @@ func (r *Repository) GetPrivateChats(ctx context.Context, userUUID string) (*mod
 
 func (r *Repository) GetGroupChats(ctx context.Context, userUUID string) (*model.ChatInfoList, error) {
 	query, args, err := sq.Select(
-		"COALESCE(gm.content, '') AS content",
-		"gc.chat_name",
-		"gc.avatar_link",
-		"COALESCE((SELECT MAX(sent_at) FROM messages WHERE chat_uuid = gc.uuid), gc.created_at) AS created_at",
-		"gc.uuid",
+		"COALESCE(m.content, '') AS content",
+		"COALESCE(s.metadata->>'name', '') AS chat_name",
+		"COALESCE(s.metadata->>'avatar_url', '') AS avatar_link",
+		"COALESCE(m.sent_at, s.created_at) AS created_at",
+		"s.id AS uuid",
+		"s.type AS stream_type",
 	).
-		From("group_chats_user gcu").
-		Join("group_chats gc ON gc.uuid = gcu.chat_uuid").
-		LeftJoin("group_messages gm ON gc.uuid = gm.chat_uuid AND gm.sent_at = (SELECT MAX(sent_at) FROM group_messages WHERE chat_uuid = gc.uuid)").
-		Where(sq.Eq{"gcu.user_uuid": userUUID}).
+		Column(unreadCountColumn(userUUID)).
+		Columns(
+			"COALESCE(p.content, '') AS pinned_message",
+			"COALESCE(p.message_id::text, '') AS pinned_message_uuid",
+		).
+		Columns(notificationColumns("sm")...).
+		From("stream_members sm").
+		Join("streams s ON s.id = sm.stream_id").
+		LeftJoin(lastMessageJoin, userUUID, userUUID).
+		LeftJoin(lastPinnedMessageJoin, userUUID, userUUID).
+		Where(sq.Eq{"sm.user_id": userUUID}).
+		Where(sq.Eq{"sm.left_at": nil}).
+		Where("NOT " + activeBanCondition("sm")).
+		Where(sq.Eq{"s.type": model.StreamTypeGroup}).
 		PlaceholderFormat(sq.Dollar).
 		ToSql()
 	if err != nil {
@@ -121,7 +225,7 @@ func (r *Repository) GetGroupChats(ctx context.Context, userUUID string) (*model
 	}
 
 	var chats model.ChatInfoList
-	err = r.connection.SelectContext(ctx, &chats, query, args...)
+	err = r.conn(ctx).SelectContext(ctx, &chats, query, args...)
 	if err != nil {
 		return nil, err
 	}
@@ -129,162 +233,452 @@ func (r *Repository) GetGroupChats(ctx context.Context, userUUID string) (*model
 	return &chats, nil
 }
 
-func (r *Repository) GetPrivateRecentMessages(ctx context.Context, chatUUID string, userUUID string) (*model.MessageList, error) {
+func (r *Repository) CreateGroupChat(ctx context.Context, creator *model.ChatMemberParams, name, avatarURL string) (string, error) {
+	return r.createStream(ctx, model.StreamTypeGroup, creator, &model.StreamMetadata{
+		Name:      name,
+		AvatarURL: avatarURL,
+	})
+}
+
+func (r *Repository) CreateChannel(ctx context.Context, creator *model.ChatMemberParams, name, avatarURL string) (string, error) {
+	return r.createStream(ctx, model.StreamTypeChannel, creator, &model.StreamMetadata{
+		Name:      name,
+		AvatarURL: avatarURL,
+	})
+}
+
+func (r *Repository) GetChannels(ctx context.Context, userUUID string) (*model.ChatInfoList, error) {
 	query, args, err := sq.Select(
-		"sender_uuid",
-		"content",
-		"sent_at",
-		"COALESCE(updated_at, sent_at) AS updated_at",
-		"root_uuid",
-		"parent_uuid",
+		"COALESCE(m.content, '') AS content",
+		"COALESCE(s.metadata->>'name', '') AS chat_name",
+		"COALESCE(s.metadata->>'avatar_url', '') AS avatar_link",
+		"COALESCE(m.sent_at, s.created_at) AS created_at",
+		"s.id AS uuid",
+		"s.type AS stream_type",
 	).
-		From("messages").
-		Where(sq.Eq{"chat_uuid": chatUUID}).
+		Column(unreadCountColumn(userUUID)).
+		Columns(
+			"COALESCE(p.content, '') AS pinned_message",
+			"COALESCE(p.message_id::text, '') AS pinned_message_uuid",
+		).
+		Columns(notificationColumns("ns")...).
+		From("streams s").
+		LeftJoin("stream_members ns ON ns.stream_id = s.id AND ns.user_id = ?", userUUID).
+		LeftJoin(lastMessageJoin, userUUID, userUUID).
+		LeftJoin(lastPinnedMessageJoin, userUUID, userUUID).
+		Where(sq.Eq{"s.type": model.StreamTypeChannel}).
 		Where(sq.Or{
-			sq.Eq{"delete_format": nil},
-			sq.And{
-				sq.Eq{"delete_format": "self"},
-				sq.NotEq{"deleted_by": userUUID},
-			},
+			sq.Expr("EXISTS (SELECT 1 FROM user_subscriptions us WHERE us.channel = s.id::text AND us.user_id = ? AND "+notBannedSubscriptionCondition+")", userUUID),
+			sq.Expr("EXISTS (SELECT 1 FROM stream_members sm WHERE sm.stream_id = s.id AND sm.user_id = ? AND sm.left_at IS NULL AND NOT "+activeBanCondition("sm")+")", userUUID),
 		}).
-		OrderBy("sent_at DESC").
-		Limit(15).
 		PlaceholderFormat(sq.Dollar).
 		ToSql()
 	if err != nil {
 		return nil, fmt.Errorf("failed to build sql query: %v", err)
 	}
 
-	var messages model.MessageList
-	err = r.connection.SelectContext(ctx, &messages, query, args...)
+	var chats model.ChatInfoList
+	err = r.conn(ctx).SelectContext(ctx, &chats, query, args...)
 	if err != nil {
 		return nil, err
 	}
 
-	return &messages, nil
+	return &chats, nil
 }
 
-func (r *Repository) GetPrivateDeletionInfo(ctx context.Context, messageID string) (*model.DeletionInfo, error) {
-	query, args, err := sq.Select(
-		"COALESCE(delete_format::text, '') AS delete_format",
-		"COALESCE(deleted_by::text, '') AS deleted_by",
-		"COALESCE(to_char(deleted_at, 'YYYY-MM-DD\"T\"HH24:MI:SSZ'), '') AS deleted_at").
-		From("messages").
-		Where(sq.Eq{"uuid": messageID}).
+func (r *Repository) SubscribeChannel(ctx context.Context, chatUUID string, subscriber *model.ChatMemberParams) error {
+	err := r.upsertUser(ctx, subscriber)
+	if err != nil {
+		return err
+	}
+
+	query, args, err := sq.Insert("user_subscriptions").
+		Columns("user_id", "channel").
+		Values(subscriber.UserUUID, chatUUID).
+		Suffix("ON CONFLICT (user_id, channel) DO NOTHING").
 		PlaceholderFormat(sq.Dollar).
 		ToSql()
 	if err != nil {
-		return nil, fmt.Errorf("failed to build sql query: %v", err)
+		return fmt.Errorf("failed to build sql query: %v", err)
 	}
 
-	var deletionInfo model.DeletionInfo
-	err = r.connection.GetContext(ctx, &deletionInfo, query, args...)
-	if err != nil && !errors.Is(err, sql.ErrNoRows) {
-		return nil, err
+	_, err = r.conn(ctx).ExecContext(ctx, query, args...)
+	if err != nil {
+		return err
 	}
 
-	return &deletionInfo, nil
+	return nil
 }
 
-func (r *Repository) EditPrivateMessage(ctx context.Context, messageUUID string, newContent string) (*model.EditedMessage, error) {
-	query, args, err := sq.Update("messages").
-		Set("content", newContent).
-		Set("updated_at", sq.Expr("CURRENT_TIMESTAMP")).
-		Where(sq.Eq{"uuid": messageUUID}).
-		Suffix("RETURNING uuid, content, updated_at").
+func (r *Repository) UnsubscribeChannel(ctx context.Context, chatUUID, userUUID string) error {
+	query, args, err := sq.Delete("user_subscriptions").
+		Where(sq.Eq{"user_id": userUUID}).
+		Where(sq.Eq{"channel": chatUUID}).
+		PlaceholderFormat(sq.Dollar).
+		ToSql()
+	if err != nil {
+		return fmt.Errorf("failed to build sql query: %v", err)
+	}
+
+	_, err = r.conn(ctx).ExecContext(ctx, query, args...)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (r *Repository) GetStreamType(ctx context.Context, chatUUID string) (string, error) {
+	query, args, err := sq.Select("type").
+		From("streams").
+		Where(sq.Eq{"id": chatUUID}).
+		PlaceholderFormat(sq.Dollar).
+		ToSql()
+	if err != nil {
+		return "", fmt.Errorf("failed to build sql query: %v", err)
+	}
+
+	var streamType string
+	err = r.conn(ctx).GetContext(ctx, &streamType, query, args...)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return "", nil
+		}
+		return "", err
+	}
+
+	return streamType, nil
+}
+
+func (r *Repository) AddChatMember(ctx context.Context, chatUUID string, member *model.ChatMemberParams, role, invitedBy string) error {
+	err := r.upsertUser(ctx, member)
+	if err != nil {
+		return err
+	}
+
+	metadata, err := json.Marshal(model.MemberMetadata{Role: role})
+	if err != nil {
+		return fmt.Errorf("failed to marshal member metadata: %v", err)
+	}
+
+	query, args, err := sq.Insert("stream_members").
+		Columns("stream_id", "user_id", "metadata", "invited_by").
+		Values(chatUUID, member.UserUUID, string(metadata), nullableUUID(invitedBy)).
+		// роль и время вступления активного участника не меняются
+		Suffix("ON CONFLICT (stream_id, user_id) DO UPDATE SET " + rejoinMemberSet + " WHERE stream_members.left_at IS NOT NULL").
+		PlaceholderFormat(sq.Dollar).
+		ToSql()
+	if err != nil {
+		return fmt.Errorf("failed to build sql query: %v", err)
+	}
+
+	_, err = r.conn(ctx).ExecContext(ctx, query, args...)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (r *Repository) RemoveChatMember(ctx context.Context, chatUUID, userUUID string) error {
+	query, args, err := sq.Update("stream_members").
+		Set("left_at", sq.Expr("CURRENT_TIMESTAMP")).
+		Where(sq.Eq{"stream_id": chatUUID}).
+		Where(sq.Eq{"user_id": userUUID}).
+		Where(sq.Eq{"left_at": nil}).
+		PlaceholderFormat(sq.Dollar).
+		ToSql()
+	if err != nil {
+		return fmt.Errorf("failed to build sql query: %v", err)
+	}
+
+	_, err = r.conn(ctx).ExecContext(ctx, query, args...)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+const inviteLinkColumns = "code, stream_id AS chat_uuid, created_by, created_at, expires_at, usage_limit, usage_count"
+
+// activeInviteLinkCondition отбирает ссылки, которые не отозваны, не истекли и не исчерпали лимит вступлений
+const activeInviteLinkCondition = "revoked_at IS NULL AND (expires_at IS NULL OR expires_at > CURRENT_TIMESTAMP) " +
+	"AND (usage_limit IS NULL OR usage_count < usage_limit)"
+
+func (r *Repository) CreateInviteLink(ctx context.Context, chatUUID, createdBy string, expiresIn time.Duration, usageLimit int32) (*model.InviteLink, error) {
+	var expiresAt interface{}
+	if expiresIn > 0 {
+		expiresAt = sq.Expr("CURRENT_TIMESTAMP + make_interval(secs => ?)", int64(expiresIn/time.Second))
+	}
+
+	var limit interface{}
+	if usageLimit > 0 {
+		limit = usageLimit
+	}
+
+	query, args, err := sq.Insert("invite_links").
+		Columns("stream_id", "created_by", "expires_at", "usage_limit").
+		Values(chatUUID, createdBy, expiresAt, limit).
+		Suffix("RETURNING " + inviteLinkColumns).
 		PlaceholderFormat(sq.Dollar).
 		ToSql()
 	if err != nil {
 		return nil, fmt.Errorf("failed to build sql query: %v", err)
 	}
 
-	var editedPrivateMessage model.EditedMessage
-	err = r.connection.GetContext(ctx, &editedPrivateMessage, query, args...)
+	var link model.InviteLink
+	err = r.conn(ctx).GetContext(ctx, &link, query, args...)
 	if err != nil {
 		return nil, err
 	}
 
-	return &editedPrivateMessage, nil
+	return &link, nil
 }
 
-func (r *Repository) DeletePrivateMessage(ctx context.Context, userUUID, messageID, mode string) (bool, error) {
-	query, args, err := sq.Update("messages").
-		Set("deleted_by", userUUID).
-		Set("delete_format", mode).
-		Set("deleted_at", sq.Expr("CURRENT_TIMESTAMP")).
-		Where(sq.Eq{"uuid": messageID}).
+func (r *Repository) RevokeInviteLink(ctx context.Context, chatUUID, code string) (bool, error) {
+	query, args, err := sq.Update("invite_links").
+		Set("revoked_at", sq.Expr("CURRENT_TIMESTAMP")).
+		Where(sq.Eq{"stream_id": chatUUID}).
+		Where(sq.Eq{"code": code}).
+		Where(sq.Eq{"revoked_at": nil}).
 		PlaceholderFormat(sq.Dollar).
 		ToSql()
 	if err != nil {
 		return false, fmt.Errorf("failed to build sql query: %v", err)
 	}
 
-	_, err = r.connection.ExecContext(ctx, query, args...)
+	result, err := r.conn(ctx).ExecContext(ctx, query, args...)
 	if err != nil {
 		return false, err
 	}
 
-	return true, nil
+	revoked, err := result.RowsAffected()
+	if err != nil {
+		return false, fmt.Errorf("failed to get affected rows: %v", err)
+	}
+
+	return revoked > 0, nil
 }
 
-func (r *Repository) IsChatMember(ctx context.Context, chatUUID, userUUID string) (bool, error) {
-	query, args, err := sq.
-		Select("COUNT(*) > 0").
-		From("chats_user").
-		Where(sq.And{
-			sq.Eq{"chat_uuid": chatUUID},
-			sq.Eq{"user_uuid": userUUID},
-		}).
+func (r *Repository) GetInviteLinks(ctx context.Context, chatUUID string) (*model.InviteLinkList, error) {
+	query, args, err := sq.Select(inviteLinkColumns).
+		From("invite_links").
+		Where(sq.Eq{"stream_id": chatUUID}).
+		Where(activeInviteLinkCondition).
+		OrderBy("created_at DESC").
+		PlaceholderFormat(sq.Dollar).
+		ToSql()
+	if err != nil {
+		return nil, fmt.Errorf("failed to build sql query: %v", err)
+	}
+
+	var links model.InviteLinkList
+	err = r.conn(ctx).SelectContext(ctx, &links, query, args...)
+	if err != nil {
+		return nil, err
+	}
+
+	return &links, nil
+}
+
+func (r *Repository) GetActiveInviteLink(ctx context.Context, code string) (*model.InviteLink, error) {
+	query, args, err := sq.Select(inviteLinkColumns).
+		From("invite_links").
+		Where(sq.Eq{"code": code}).
+		Where(activeInviteLinkCondition).
+		PlaceholderFormat(sq.Dollar).
+		ToSql()
+	if err != nil {
+		return nil, fmt.Errorf("failed to build sql query: %v", err)
+	}
+
+	var link model.InviteLink
+	err = r.conn(ctx).GetContext(ctx, &link, query, args...)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	return &link, nil
+}
+
+// JoinByInvite добавляет участника и списывает использование ссылки одним запросом.
+// Ссылка блокируется до конца запроса, поэтому лимит не превышается при одновременных вступлениях,
+// а использование списывается, только если участник действительно добавлен или вернулся
+func (r *Repository) JoinByInvite(ctx context.Context, code string, member *model.ChatMemberParams) (*model.InviteJoin, error) {
+	err := r.upsertUser(ctx, member)
+	if err != nil {
+		return nil, err
+	}
+
+	metadata, err := json.Marshal(model.MemberMetadata{Role: model.RoleMember})
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal member metadata: %v", err)
+	}
+
+	joinQuery, joinArgs, err := sq.Insert("stream_members").
+		Columns("stream_id", "user_id", "metadata", "invited_by").
+		Select(sq.Select("link.stream_id").
+			Column(sq.Expr("CAST(? AS uuid)", member.UserUUID)).
+			Column(sq.Expr("CAST(? AS jsonb)", string(metadata))).
+			Column("link.created_by").
+			From("link")).
+		Suffix("ON CONFLICT (stream_id, user_id) DO UPDATE SET " + rejoinMemberSet + " WHERE stream_members.left_at IS NOT NULL " +
+			"RETURNING stream_id").
+		ToSql()
+	if err != nil {
+		return nil, fmt.Errorf("failed to build sql query: %v", err)
+	}
+
+	query, args, err := sq.Select("EXISTS (SELECT 1 FROM link) AS link_active", "EXISTS (SELECT 1 FROM joined) AS joined").
+		Prefix("WITH link AS (SELECT stream_id, created_by FROM invite_links WHERE code = ? AND "+
+			activeInviteLinkCondition+" FOR UPDATE),", code).
+		Prefix("joined AS ("+joinQuery+"),", joinArgs...).
+		Prefix("used AS (UPDATE invite_links SET usage_count = usage_count + 1 WHERE code = ? AND EXISTS (SELECT 1 FROM joined))", code).
+		PlaceholderFormat(sq.Dollar).
+		ToSql()
+	if err != nil {
+		return nil, fmt.Errorf("failed to build sql query: %v", err)
+	}
+
+	var join model.InviteJoin
+	err = r.conn(ctx).GetContext(ctx, &join, query, args...)
+	if err != nil {
+		return nil, err
+	}
+
+	return &join, nil
+}
+
+// BanMember банит пользователя в чате, сохраняя его участие, чтобы по истечении срока доступ вернулся сам.
+// Для подписчика канала без записи в stream_members создается запись, которая хранит только бан.
+func (r *Repository) BanMember(ctx context.Context, chatUUID, userUUID, bannedBy, reason string, duration time.Duration) (*model.MemberBan, error) {
+	var banUntil interface{}
+	if duration > 0 {
+		banUntil = sq.Expr("CURRENT_TIMESTAMP + make_interval(secs => ?)", int64(duration/time.Second))
+	}
+
+	metadata, err := json.Marshal(model.MemberMetadata{Role: model.RoleMember})
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal member metadata: %v", err)
+	}
+
+	query, args, err := sq.Insert("stream_members").
+		Columns("stream_id", "user_id", "metadata", "banned_at", "banned_by", "ban_reason", "ban_until", "left_at").
+		Values(chatUUID, userUUID, string(metadata), sq.Expr("CURRENT_TIMESTAMP"), bannedBy, reason, banUntil, sq.Expr("CURRENT_TIMESTAMP")).
+		Suffix("ON CONFLICT (stream_id, user_id) DO UPDATE SET banned_at = EXCLUDED.banned_at, banned_by = EXCLUDED.banned_by, " +
+			"ban_reason = EXCLUDED.ban_reason, ban_until = EXCLUDED.ban_until " +
+			"RETURNING user_id AS user_uuid, banned_by, COALESCE(ban_reason, '') AS reason, banned_at, ban_until").
+		PlaceholderFormat(sq.Dollar).
+		ToSql()
+	if err != nil {
+		return nil, fmt.Errorf("failed to build sql query: %v", err)
+	}
+
+	var ban model.MemberBan
+	err = r.conn(ctx).GetContext(ctx, &ban, query, args...)
+	if err != nil {
+		return nil, err
+	}
+
+	return &ban, nil
+}
+
+func (r *Repository) SetChatNotifications(ctx context.Context, chatUUID, userUUID string, settings *model.ChatNotifications) (*model.ChatNotifications, error) {
+	metadata, err := json.Marshal(model.MemberMetadata{Role: model.RoleMember})
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal member metadata: %v", err)
+	}
+
+	// подписчик канала получает строку stream_members только для хранения настроек, как при бане
+	query, args, err := sq.Insert("stream_members").
+		Columns("stream_id", "user_id", "metadata", "notify", "muted_until", "mentions_only", "left_at").
+		Values(chatUUID, userUUID, string(metadata), !settings.Muted, settings.MutedUntil, settings.MentionsOnly, sq.Expr("CURRENT_TIMESTAMP")).
+		Suffix("ON CONFLICT (stream_id, user_id) DO UPDATE SET notify = EXCLUDED.notify, muted_until = EXCLUDED.muted_until, " +
+			"mentions_only = EXCLUDED.mentions_only RETURNING " + strings.Join(notificationColumns("stream_members"), ", ")).
+		PlaceholderFormat(sq.Dollar).
+		ToSql()
+	if err != nil {
+		return nil, fmt.Errorf("failed to build sql query: %v", err)
+	}
+
+	var notifications model.ChatNotifications
+	err = r.conn(ctx).GetContext(ctx, &notifications, query, args...)
+	if err != nil {
+		return nil, err
+	}
+
+	return &notifications, nil
+}
+
+func (r *Repository) UnbanMember(ctx context.Context, chatUUID, userUUID string) (bool, error) {
+	query, args, err := sq.Update("stream_members").
+		Set("banned_at", nil).
+		Set("banned_by", nil).
+		Set("ban_reason", nil).
+		Set("ban_until", nil).
+		Where(sq.Eq{"stream_id": chatUUID}).
+		Where(sq.Eq{"user_id": userUUID}).
+		Where(activeBanCondition("stream_members")).
 		PlaceholderFormat(sq.Dollar).
 		ToSql()
 	if err != nil {
 		return false, fmt.Errorf("failed to build sql query: %v", err)
 	}
 
-	var isMember bool
-	err = r.connection.GetContext(ctx, &isMember, query, args...)
+	result, err := r.conn(ctx).ExecContext(ctx, query, args...)
 	if err != nil {
 		return false, err
 	}
 
-	return isMember, nil
+	unbanned, err := result.RowsAffected()
+	if err != nil {
+		return false, fmt.Errorf("failed to get affected rows: %v", err)
+	}
+
+	return unbanned > 0, nil
 }
 
-func (r *Repository) IsMessageOwner(ctx context.Context, chatUUID, messageUUID, userUUID string) (bool, error) {
+func (r *Repository) IsMemberBanned(ctx context.Context, chatUUID, userUUID string) (bool, error) {
 	query, args, err := sq.
 		Select("COUNT(*) > 0").
-		From("messages").
-		Where(sq.And{
-			sq.Eq{"uuid": messageUUID},
-			sq.Eq{"chat_uuid": chatUUID},
-			sq.Eq{"sender_uuid": userUUID},
-		}).
+		From("stream_members").
+		Where(sq.Eq{"stream_id": chatUUID}).
+		Where(sq.Eq{"user_id": userUUID}).
+		Where(activeBanCondition("stream_members")).
 		PlaceholderFormat(sq.Dollar).
 		ToSql()
 	if err != nil {
 		return false, fmt.Errorf("failed to build sql query: %v", err)
 	}
 
-	var isOwner bool
-	err = r.connection.GetContext(ctx, &isOwner, query, args...)
+	var isBanned bool
+	err = r.conn(ctx).GetContext(ctx, &isBanned, query, args...)
 	if err != nil {
 		return false, err
 	}
 
-	return isOwner, nil
+	return isBanned, nil
 }
 
-func (r *Repository) UpdateUserNickname(ctx context.Context, userUUID, newNickname string) error {
-	query, args, err := sq.Update("chats_user").
-		Set("username", newNickname).
-		Where(sq.Eq{"user_uuid": userUUID}).
+// KickMember исключает участника и отменяет подписку на канал одним запросом; вернуться можно обычным способом
+func (r *Repository) KickMember(ctx context.Context, chatUUID, userUUID string) error {
+	query, args, err := sq.Update("stream_members").
+		Prefix("WITH unsubscribed AS (DELETE FROM user_subscriptions WHERE user_id = ? AND channel = ?)", userUUID, chatUUID).
+		Set("left_at", sq.Expr("CURRENT_TIMESTAMP")).
+		Where(sq.Eq{"stream_id": chatUUID}).
+		Where(sq.Eq{"user_id": userUUID}).
+		Where(sq.Eq{"left_at": nil}).
 		PlaceholderFormat(sq.Dollar).
 		ToSql()
 	if err != nil {
 		return fmt.Errorf("failed to build sql query: %v", err)
 	}
 
-	_, err = r.connection.ExecContext(ctx, query, args...)
+	_, err = r.conn(ctx).ExecContext(ctx, query, args...)
 	if err != nil {
 		return err
 	}
@@ -292,20 +686,1294 @@ func (r *Repository) UpdateUserNickname(ctx context.Context, userUUID, newNickna
 	return nil
 }
 
-func (r *Repository) UpdateUserAvatar(ctx context.Context, userUUID, avatarLink string) error {
-	query, args, err := sq.Update("chats_user").
-		Set("avatar_link", avatarLink).
-		Where(sq.Eq{"user_uuid": userUUID}).
+func (r *Repository) GetChatMember(ctx context.Context, chatUUID, userUUID string) (*model.ChatMember, error) {
+	query, args, err := sq.Select(
+		"s.type AS stream_type",
+		"COALESCE(sm.metadata->>'role', '') AS role",
+	).
+		From("stream_members sm").
+		Join("streams s ON s.id = sm.stream_id").
+		Where(sq.Eq{"sm.stream_id": chatUUID}).
+		Where(sq.Eq{"sm.user_id": userUUID}).
+		Where(sq.Eq{"sm.left_at": nil}).
+		Where("NOT " + activeBanCondition("sm")).
 		PlaceholderFormat(sq.Dollar).
 		ToSql()
 	if err != nil {
-		return fmt.Errorf("failed to build sql query: %v", err)
+		return nil, fmt.Errorf("failed to build sql query: %v", err)
 	}
 
-	_, err = r.connection.ExecContext(ctx, query, args...)
+	var member model.ChatMember
+	err = r.conn(ctx).GetContext(ctx, &member, query, args...)
 	if err != nil {
-		return err
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	return &member, nil
+}
+
+func (r *Repository) GetOrCreateCommentStream(ctx context.Context, creator *model.ChatMemberParams, entityType, entityID string) (string, bool, error) {
+	err := r.upsertUser(ctx, creator)
+	if err != nil {
+		return "", false, err
+	}
+
+	metadata, err := json.Marshal(model.StreamMetadata{
+		EntityType: entityType,
+		EntityID:   entityID,
+	})
+	if err != nil {
+		return "", false, fmt.Errorf("failed to marshal stream metadata: %v", err)
+	}
+
+	query, args, err := sq.Insert("streams").
+		Columns("type", "metadata", "created_by").
+		Values(model.StreamTypeComment, string(metadata), creator.UserUUID).
+		Suffix("ON CONFLICT ((metadata->>'entity_type'), (metadata->>'entity_id')) WHERE type = 'comment' DO NOTHING RETURNING id").
+		PlaceholderFormat(sq.Dollar).
+		ToSql()
+	if err != nil {
+		return "", false, fmt.Errorf("failed to build sql query: %v", err)
+	}
+
+	var chatUUID string
+	err = r.conn(ctx).GetContext(ctx, &chatUUID, query, args...)
+	if err == nil {
+		return chatUUID, true, nil
+	}
+	if !errors.Is(err, sql.ErrNoRows) {
+		return "", false, err
+	}
+
+	query, args, err = sq.Select("id").
+		From("streams").
+		Where(sq.Eq{"type": model.StreamTypeComment}).
+		Where(sq.Expr("metadata->>'entity_type' = ?", entityType)).
+		Where(sq.Expr("metadata->>'entity_id' = ?", entityID)).
+		PlaceholderFormat(sq.Dollar).
+		ToSql()
+	if err != nil {
+		return "", false, fmt.Errorf("failed to build sql query: %v", err)
+	}
+
+	err = r.conn(ctx).GetContext(ctx, &chatUUID, query, args...)
+	if err != nil {
+		return "", false, err
+	}
+
+	return chatUUID, false, nil
+}
+
+func (r *Repository) EnsureChatMember(ctx context.Context, chatUUID string, member *model.ChatMemberParams, role string) error {
+	err := r.upsertUser(ctx, member)
+	if err != nil {
+		return err
+	}
+
+	metadata, err := json.Marshal(model.MemberMetadata{Role: role})
+	if err != nil {
+		return fmt.Errorf("failed to marshal member metadata: %v", err)
+	}
+
+	query, args, err := sq.Insert("stream_members").
+		Columns("stream_id", "user_id", "metadata").
+		Values(chatUUID, member.UserUUID, string(metadata)).
+		Suffix("ON CONFLICT (stream_id, user_id) DO NOTHING").
+		PlaceholderFormat(sq.Dollar).
+		ToSql()
+	if err != nil {
+		return fmt.Errorf("failed to build sql query: %v", err)
+	}
+
+	_, err = r.conn(ctx).ExecContext(ctx, query, args...)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (r *Repository) GetPrivateRecentMessages(ctx context.Context, chatUUID string, userUUID string, page *model.MessagePage) (*model.MessageList, error) {
+	builder := sq.Select(
+		"id AS uuid",
+		"sender_id AS sender_uuid",
+		"content",
+		"sent_at",
+		"COALESCE(updated_at, sent_at) AS updated_at",
+		"root_id AS root_uuid",
+		"parent_id AS parent_uuid",
+		"COALESCE(type, 'text') AS type",
+		"media",
+		"updated_at IS NOT NULL AS edited",
+		"forwarded_from_message_id AS forwarded_from_message_uuid",
+		"forwarded_from_sender_id AS forwarded_from_sender_uuid",
+		"forwarded_from_stream_id AS forwarded_from_chat_uuid",
+	).
+		Column(reactionsColumn(userUUID)).
+		From("messages").
+		Where(sq.Eq{"stream_id": chatUUID}).
+		Where(visibleHistoryCondition("messages", userUUID)).
+		Where(sq.Or{
+			sq.Eq{"delete_format": nil},
+			sq.And{
+				sq.Eq{"delete_format": "self"},
+				sq.NotEq{"deleted_by": userUUID},
+			},
+		})
+
+	switch {
+	case page.Cursor != nil && page.Forward:
+		builder = builder.Where("(sent_at, id) > (?, ?)", page.Cursor.SentAt, page.Cursor.ID)
+	case page.Cursor != nil:
+		builder = builder.Where("(sent_at, id) < (?, ?)", page.Cursor.SentAt, page.Cursor.ID)
+	case page.AfterMessageUUID != "":
+		builder = builder.Where("(sent_at, id) > (SELECT sent_at, id FROM messages WHERE id = ? AND stream_id = ?)", page.AfterMessageUUID, chatUUID)
+	}
+
+	if page.Forward {
+		builder = builder.OrderBy("sent_at ASC", "id ASC")
+	} else {
+		builder = builder.OrderBy("sent_at DESC", "id DESC")
+	}
+
+	query, args, err := builder.
+		Limit(page.Limit).
+		PlaceholderFormat(sq.Dollar).
+		ToSql()
+	if err != nil {
+		return nil, fmt.Errorf("failed to build sql query: %v", err)
+	}
+
+	var messages model.MessageList
+	err = r.conn(ctx).SelectContext(ctx, &messages, query, args...)
+	if err != nil {
+		return nil, err
+	}
+
+	return &messages, nil
+}
+
+func (r *Repository) SendPrivateMessage(ctx context.Context, message *model.NewMessage) (*model.Message, error) {
+	messageType := message.Type
+	if messageType == "" {
+		messageType = model.MessageTypeText
+	}
+
+	var media interface{}
+	if message.Media != nil {
+		mediaJSON, err := json.Marshal(message.Media)
+		if err != nil {
+			return nil, fmt.Errorf("failed to marshal media: %v", err)
+		}
+		media = string(mediaJSON)
+	}
+
+	query, args, err := sq.Insert("messages").
+		Columns("stream_id", "sender_id", "type", "content", "media", "root_id", "parent_id").
+		Values(message.ChatUUID, message.SenderUUID, messageType, message.Content, media, nullableUUID(message.RootUUID), nullableUUID(message.ParentUUID)).
+		Suffix("RETURNING id AS uuid, sender_id AS sender_uuid, content, sent_at, COALESCE(updated_at, sent_at) AS updated_at, root_id AS root_uuid, parent_id AS parent_uuid, type, media").
+		PlaceholderFormat(sq.Dollar).
+		ToSql()
+	if err != nil {
+		return nil, fmt.Errorf("failed to build sql query: %v", err)
+	}
+
+	var sentMessage model.Message
+	err = r.conn(ctx).GetContext(ctx, &sentMessage, query, args...)
+	if err != nil {
+		return nil, err
+	}
+
+	return &sentMessage, nil
+}
+
+func (r *Repository) ForwardMessages(ctx context.Context, sourceChatUUID, targetChatUUID, userUUID string, messageUUIDs []string) (*model.MessageList, error) {
+	// копии получают возрастающее время отправки, чтобы сохранить исходный порядок при постраничном чтении
+	source := sq.Select().
+		Column(sq.Expr("CAST(? AS uuid)", targetChatUUID)).
+		Column(sq.Expr("CAST(? AS uuid)", userUUID)).
+		Columns(
+			"type",
+			"content",
+			"media",
+			"CURRENT_TIMESTAMP + ROW_NUMBER() OVER (ORDER BY sent_at, id) * INTERVAL '1 microsecond'",
+			"id",
+			"COALESCE(forwarded_from_sender_id, sender_id)",
+			"COALESCE(forwarded_from_stream_id, stream_id)",
+		).
+		From("messages").
+		Where(sq.Eq{"stream_id": sourceChatUUID}).
+		Where(sq.Eq{"id": messageUUIDs}).
+		Where("type IS DISTINCT FROM ?", model.MessageTypeSystem).
+		Where(visibleHistoryCondition("messages", userUUID)).
+		Where(sq.Or{
+			sq.Eq{"delete_format": nil},
+			sq.And{
+				sq.Eq{"delete_format": "self"},
+				sq.NotEq{"deleted_by": userUUID},
+			},
+		})
+
+	query, args, err := sq.Insert("messages").
+		Columns(
+			"stream_id",
+			"sender_id",
+			"type",
+			"content",
+			"media",
+			"sent_at",
+			"forwarded_from_message_id",
+			"forwarded_from_sender_id",
+			"forwarded_from_stream_id",
+		).
+		Select(source).
+		Suffix(`RETURNING id AS uuid, sender_id AS sender_uuid, content, sent_at, COALESCE(updated_at, sent_at) AS updated_at,
+			type, media, forwarded_from_message_id AS forwarded_from_message_uuid,
+			forwarded_from_sender_id AS forwarded_from_sender_uuid, forwarded_from_stream_id AS forwarded_from_chat_uuid`).
+		PlaceholderFormat(sq.Dollar).
+		ToSql()
+	if err != nil {
+		return nil, fmt.Errorf("failed to build sql query: %v", err)
+	}
+
+	var messages model.MessageList
+	err = r.conn(ctx).SelectContext(ctx, &messages, query, args...)
+	if err != nil {
+		return nil, err
+	}
+
+	sort.Slice(messages, func(i, j int) bool {
+		return messages[i].SentAt.Before(messages[j].SentAt)
+	})
+
+	return &messages, nil
+}
+
+func (r *Repository) SearchMessages(ctx context.Context, userUUID, searchQuery, chatUUID string, page *model.MessagePage) (*model.SearchResultList, error) {
+	builder := sq.Select(
+		"id AS uuid",
+		"sender_id AS sender_uuid",
+		"content",
+		"sent_at",
+		"COALESCE(updated_at, sent_at) AS updated_at",
+		"root_id AS root_uuid",
+		"parent_id AS parent_uuid",
+		"COALESCE(type, 'text') AS type",
+		"media",
+		"updated_at IS NOT NULL AS edited",
+		"forwarded_from_message_id AS forwarded_from_message_uuid",
+		"forwarded_from_sender_id AS forwarded_from_sender_uuid",
+		"forwarded_from_stream_id AS forwarded_from_chat_uuid",
+		"stream_id AS chat_uuid",
+	).
+		Column(reactionsColumn(userUUID)).
+		Column(sq.Expr("ts_headline('russian', COALESCE(content, ''), websearch_to_tsquery('russian', ?), 'StartSel=<b>, StopSel=</b>, MaxFragments=2, MaxWords=20, MinWords=5') AS snippet", searchQuery)).
+		From("messages").
+		Where("search_vector @@ websearch_to_tsquery('russian', ?)", searchQuery).
+		Where("type IS DISTINCT FROM ?", model.MessageTypeSystem).
+		Where(visibleHistoryCondition("messages", userUUID)).
+		Where(sq.Or{
+			sq.Eq{"delete_format": nil},
+			sq.And{
+				sq.Eq{"delete_format": "self"},
+				sq.NotEq{"deleted_by": userUUID},
+			},
+		})
+
+	if chatUUID != "" {
+		builder = builder.Where(sq.Eq{"stream_id": chatUUID})
+	} else {
+		builder = builder.Where(`stream_id IN (
+			SELECT sm.stream_id FROM stream_members sm WHERE sm.user_id = ? AND sm.left_at IS NULL AND NOT `+activeBanCondition("sm")+`
+			UNION
+			SELECT s.id FROM streams s JOIN user_subscriptions us ON us.channel = s.id::text
+			WHERE us.user_id = ? AND s.type = 'channel' AND `+notBannedSubscriptionCondition+`
+		)`, userUUID, userUUID)
+	}
+
+	if page.Cursor != nil {
+		builder = builder.Where("(sent_at, id) < (?, ?)", page.Cursor.SentAt, page.Cursor.ID)
+	}
+
+	query, args, err := builder.
+		OrderBy("sent_at DESC", "id DESC").
+		Limit(page.Limit).
+		PlaceholderFormat(sq.Dollar).
+		ToSql()
+	if err != nil {
+		return nil, fmt.Errorf("failed to build sql query: %v", err)
+	}
+
+	var results model.SearchResultList
+	err = r.conn(ctx).SelectContext(ctx, &results, query, args...)
+	if err != nil {
+		return nil, err
+	}
+
+	return &results, nil
+}
+
+// GetPrivateDeletionInfo возвращает состояние удаления сообщения чата, видимого пользователю; nil - сообщение не найдено
+func (r *Repository) GetPrivateDeletionInfo(ctx context.Context, chatUUID, userUUID, messageID string) (*model.DeletionInfo, error) {
+	query, args, err := sq.Select(
+		"COALESCE(delete_format::text, '') AS delete_format",
+		"COALESCE(deleted_by::text, '') AS deleted_by",
+		"COALESCE(to_char(deleted_at, 'YYYY-MM-DD\"T\"HH24:MI:SSZ'), '') AS deleted_at").
+		From("messages").
+		Where(sq.Eq{"id": messageID}).
+		Where(sq.Eq{"stream_id": chatUUID}).
+		Where(visibleHistoryCondition("messages", userUUID)).
+		PlaceholderFormat(sq.Dollar).
+		ToSql()
+	if err != nil {
+		return nil, fmt.Errorf("failed to build sql query: %v", err)
+	}
+
+	var deletionInfo model.DeletionInfo
+	err = r.conn(ctx).GetContext(ctx, &deletionInfo, query, args...)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	return &deletionInfo, nil
+}
+
+func (r *Repository) EditPrivateMessage(ctx context.Context, messageUUID string, newContent string) (*model.EditedMessage, error) {
+	// предыдущая версия сохраняется тем же запросом, чтобы правка не могла пройти без ревизии
+	query, args, err := sq.Update("messages").
+		Prefix(`WITH revision AS (
+			INSERT INTO message_revisions (message_id, content, written_at)
+			SELECT id, content, COALESCE(updated_at, sent_at) FROM messages WHERE id = ?
+		)`, messageUUID).
+		Set("content", newContent).
+		Set("updated_at", sq.Expr("CURRENT_TIMESTAMP")).
+		Where(sq.Eq{"id": messageUUID}).
+		Suffix("RETURNING id AS uuid, content, updated_at").
+		PlaceholderFormat(sq.Dollar).
+		ToSql()
+	if err != nil {
+		return nil, fmt.Errorf("failed to build sql query: %v", err)
+	}
+
+	var editedPrivateMessage model.EditedMessage
+	err = r.conn(ctx).GetContext(ctx, &editedPrivateMessage, query, args...)
+	if err != nil {
+		return nil, err
+	}
+
+	return &editedPrivateMessage, nil
+}
+
+func (r *Repository) GetMessageRevisions(ctx context.Context, chatUUID, messageUUID, userUUID string) (*model.MessageRevisionList, error) {
+	query, args, err := sq.Select(
+		"COALESCE(mr.content, '') AS content",
+		"mr.written_at",
+		"mr.replaced_at",
+	).
+		From("message_revisions mr").
+		Join("messages m ON m.id = mr.message_id").
+		Where(sq.Eq{"m.id": messageUUID}).
+		Where(sq.Eq{"m.stream_id": chatUUID}).
+		Where(visibleHistoryCondition("m", userUUID)).
+		OrderBy("mr.replaced_at ASC").
+		PlaceholderFormat(sq.Dollar).
+		ToSql()
+	if err != nil {
+		return nil, fmt.Errorf("failed to build sql query: %v", err)
+	}
+
+	var revisions model.MessageRevisionList
+	err = r.conn(ctx).SelectContext(ctx, &revisions, query, args...)
+	if err != nil {
+		return nil, err
+	}
+
+	return &revisions, nil
+}
+
+// DeletePrivateMessage удаляет видимое пользователю сообщение чата; false - сообщение не найдено
+func (r *Repository) DeletePrivateMessage(ctx context.Context, chatUUID, userUUID, messageID, mode string) (bool, error) {
+	query, args, err := sq.Update("messages").
+		Set("deleted_by", userUUID).
+		Set("delete_format", mode).
+		Set("deleted_at", sq.Expr("CURRENT_TIMESTAMP")).
+		Where(sq.Eq{"id": messageID}).
+		Where(sq.Eq{"stream_id": chatUUID}).
+		Where(visibleHistoryCondition("messages", userUUID)).
+		PlaceholderFormat(sq.Dollar).
+		ToSql()
+	if err != nil {
+		return false, fmt.Errorf("failed to build sql query: %v", err)
+	}
+
+	result, err := r.conn(ctx).ExecContext(ctx, query, args...)
+	if err != nil {
+		return false, err
+	}
+
+	deleted, err := result.RowsAffected()
+	if err != nil {
+		return false, fmt.Errorf("failed to get affected rows: %v", err)
+	}
+
+	return deleted > 0, nil
+}
+
+func (r *Repository) IsChatMember(ctx context.Context, chatUUID, userUUID string) (bool, error) {
+	query, args, err := sq.
+		Select("COUNT(*) > 0").
+		From("stream_members").
+		Where(sq.And{
+			sq.Eq{"stream_id": chatUUID},
+			sq.Eq{"user_id": userUUID},
+			sq.Eq{"left_at": nil},
+			sq.Expr("NOT " + activeBanCondition("stream_members")),
+		}).
+		PlaceholderFormat(sq.Dollar).
+		ToSql()
+	if err != nil {
+		return false, fmt.Errorf("failed to build sql query: %v", err)
+	}
+
+	var isMember bool
+	err = r.conn(ctx).GetContext(ctx, &isMember, query, args...)
+	if err != nil {
+		return false, err
+	}
+
+	return isMember, nil
+}
+
+// IsFormerChatMember проверяет, что пользователь состоял в чате и вышел из него или был исключен
+func (r *Repository) IsFormerChatMember(ctx context.Context, chatUUID, userUUID string) (bool, error) {
+	query, args, err := sq.
+		Select("COUNT(*) > 0").
+		From("stream_members").
+		Where(sq.And{
+			sq.Eq{"stream_id": chatUUID},
+			sq.Eq{"user_id": userUUID},
+			sq.NotEq{"left_at": nil},
+		}).
+		PlaceholderFormat(sq.Dollar).
+		ToSql()
+	if err != nil {
+		return false, fmt.Errorf("failed to build sql query: %v", err)
+	}
+
+	var isFormer bool
+	err = r.conn(ctx).GetContext(ctx, &isFormer, query, args...)
+	if err != nil {
+		return false, err
+	}
+
+	return isFormer, nil
+}
+
+func (r *Repository) IsMessageOwner(ctx context.Context, chatUUID, messageUUID, userUUID string) (bool, error) {
+	query, args, err := sq.
+		Select("COUNT(*) > 0").
+		From("messages").
+		Where(sq.And{
+			sq.Eq{"id": messageUUID},
+			sq.Eq{"stream_id": chatUUID},
+			sq.Eq{"sender_id": userUUID},
+			visibleHistoryCondition("messages", userUUID),
+		}).
+		PlaceholderFormat(sq.Dollar).
+		ToSql()
+	if err != nil {
+		return false, fmt.Errorf("failed to build sql query: %v", err)
+	}
+
+	var isOwner bool
+	err = r.conn(ctx).GetContext(ctx, &isOwner, query, args...)
+	if err != nil {
+		return false, err
+	}
+
+	return isOwner, nil
+}
+
+// MessageExists проверяет, что сообщение не удалено для всех и видно пользователю
+func (r *Repository) MessageExists(ctx context.Context, chatUUID, messageUUID, userUUID string) (bool, error) {
+	query, args, err := sq.
+		Select("COUNT(*) > 0").
+		From("messages").
+		Where(sq.Eq{"id": messageUUID}).
+		Where(sq.Eq{"stream_id": chatUUID}).
+		Where(visibleHistoryCondition("messages", userUUID)).
+		Where(sq.Or{
+			sq.Eq{"delete_format": nil},
+			sq.NotEq{"delete_format": "all"},
+		}).
+		PlaceholderFormat(sq.Dollar).
+		ToSql()
+	if err != nil {
+		return false, fmt.Errorf("failed to build sql query: %v", err)
+	}
+
+	var exists bool
+	err = r.conn(ctx).GetContext(ctx, &exists, query, args...)
+	if err != nil {
+		return false, err
+	}
+
+	return exists, nil
+}
+
+func (r *Repository) AddReaction(ctx context.Context, messageUUID, userUUID, emoji string) error {
+	query, args, err := sq.Insert("message_reactions").
+		Columns("message_id", "user_id", "emoji").
+		Values(messageUUID, userUUID, emoji).
+		Suffix("ON CONFLICT (message_id, user_id, emoji) DO NOTHING").
+		PlaceholderFormat(sq.Dollar).
+		ToSql()
+	if err != nil {
+		return fmt.Errorf("failed to build sql query: %v", err)
+	}
+
+	_, err = r.conn(ctx).ExecContext(ctx, query, args...)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (r *Repository) RemoveReaction(ctx context.Context, messageUUID, userUUID, emoji string) error {
+	query, args, err := sq.Delete("message_reactions").
+		Where(sq.Eq{"message_id": messageUUID}).
+		Where(sq.Eq{"user_id": userUUID}).
+		Where(sq.Eq{"emoji": emoji}).
+		PlaceholderFormat(sq.Dollar).
+		ToSql()
+	if err != nil {
+		return fmt.Errorf("failed to build sql query: %v", err)
+	}
+
+	_, err = r.conn(ctx).ExecContext(ctx, query, args...)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (r *Repository) GetMessageReactions(ctx context.Context, messageUUID, userUUID string) (model.ReactionList, error) {
+	query, args, err := sq.Select().
+		Column(reactionsColumn(userUUID)).
+		From("messages").
+		Where(sq.Eq{"messages.id": messageUUID}).
+		PlaceholderFormat(sq.Dollar).
+		ToSql()
+	if err != nil {
+		return nil, fmt.Errorf("failed to build sql query: %v", err)
+	}
+
+	var reactions model.ReactionList
+	err = r.conn(ctx).GetContext(ctx, &reactions, query, args...)
+	if err != nil {
+		return nil, err
+	}
+
+	return reactions, nil
+}
+
+func (r *Repository) PinMessage(ctx context.Context, chatUUID, messageUUID, userUUID string) (bool, error) {
+	query, args, err := sq.Insert("pinned_messages").
+		Columns("stream_id", "message_id", "pinned_by").
+		Values(chatUUID, messageUUID, userUUID).
+		Suffix("ON CONFLICT (stream_id, message_id) DO NOTHING").
+		PlaceholderFormat(sq.Dollar).
+		ToSql()
+	if err != nil {
+		return false, fmt.Errorf("failed to build sql query: %v", err)
+	}
+
+	result, err := r.conn(ctx).ExecContext(ctx, query, args...)
+	if err != nil {
+		return false, err
+	}
+
+	pinned, err := result.RowsAffected()
+	if err != nil {
+		return false, fmt.Errorf("failed to get affected rows: %v", err)
+	}
+
+	return pinned > 0, nil
+}
+
+func (r *Repository) UnpinMessage(ctx context.Context, chatUUID, messageUUID string) (bool, error) {
+	query, args, err := sq.Delete("pinned_messages").
+		Where(sq.Eq{"stream_id": chatUUID}).
+		Where(sq.Eq{"message_id": messageUUID}).
+		PlaceholderFormat(sq.Dollar).
+		ToSql()
+	if err != nil {
+		return false, fmt.Errorf("failed to build sql query: %v", err)
+	}
+
+	result, err := r.conn(ctx).ExecContext(ctx, query, args...)
+	if err != nil {
+		return false, err
+	}
+
+	unpinned, err := result.RowsAffected()
+	if err != nil {
+		return false, fmt.Errorf("failed to get affected rows: %v", err)
+	}
+
+	return unpinned > 0, nil
+}
+
+func (r *Repository) GetPinnedMessages(ctx context.Context, chatUUID, userUUID string) (*model.PinnedMessageList, error) {
+	query, args, err := sq.Select(
+		"messages.id AS uuid",
+		"messages.sender_id AS sender_uuid",
+		"COALESCE(messages.content, '') AS content",
+		"messages.sent_at",
+		"COALESCE(messages.updated_at, messages.sent_at) AS updated_at",
+		"messages.root_id AS root_uuid",
+		"messages.parent_id AS parent_uuid",
+		"COALESCE(messages.type, 'text') AS type",
+		"messages.media",
+		"messages.updated_at IS NOT NULL AS edited",
+		"messages.forwarded_from_message_id AS forwarded_from_message_uuid",
+		"messages.forwarded_from_sender_id AS forwarded_from_sender_uuid",
+		"messages.forwarded_from_stream_id AS forwarded_from_chat_uuid",
+		"pm.pinned_by",
+		"pm.pinned_at",
+	).
+		Column(reactionsColumn(userUUID)).
+		From("pinned_messages pm").
+		Join("messages ON messages.id = pm.message_id").
+		Where(sq.Eq{"pm.stream_id": chatUUID}).
+		Where(visibleHistoryCondition("messages", userUUID)).
+		Where(sq.Or{
+			sq.Eq{"messages.delete_format": nil},
+			sq.And{
+				sq.Eq{"messages.delete_format": "self"},
+				sq.NotEq{"messages.deleted_by": userUUID},
+			},
+		}).
+		OrderBy("pm.pinned_at DESC").
+		PlaceholderFormat(sq.Dollar).
+		ToSql()
+	if err != nil {
+		return nil, fmt.Errorf("failed to build sql query: %v", err)
+	}
+
+	var pinned model.PinnedMessageList
+	err = r.conn(ctx).SelectContext(ctx, &pinned, query, args...)
+	if err != nil {
+		return nil, err
+	}
+
+	return &pinned, nil
+}
+
+func (r *Repository) GetChatRecipients(ctx context.Context, chatUUID string) (*model.EventRecipientList, error) {
+	query, args, err := sq.Select("u.id AS user_uuid", "u.nickname", "u.last_online").
+		Columns(notificationColumns("sm")...).
+		From("stream_members sm").
+		Join("users u ON u.id = sm.user_id").
+		Where(sq.Eq{"sm.stream_id": chatUUID}).
+		Where(sq.Eq{"sm.left_at": nil}).
+		Where("NOT "+activeBanCondition("sm")).
+		Suffix(`UNION SELECT u.id, u.nickname, u.last_online, `+strings.Join(notificationColumns("ns"), ", ")+`
+			FROM user_subscriptions us
+			JOIN users u ON u.id = us.user_id
+			LEFT JOIN stream_members ns ON ns.stream_id::text = us.channel AND ns.user_id = us.user_id
+			WHERE us.channel = ? AND `+notBannedSubscriptionCondition, chatUUID).
+		PlaceholderFormat(sq.Dollar).
+		ToSql()
+	if err != nil {
+		return nil, fmt.Errorf("failed to build sql query: %v", err)
+	}
+
+	var recipients model.EventRecipientList
+	err = r.conn(ctx).SelectContext(ctx, &recipients, query, args...)
+	if err != nil {
+		return nil, err
+	}
+
+	return &recipients, nil
+}
+
+func (r *Repository) HasChatAccess(ctx context.Context, chatUUID, userUUID string) (bool, error) {
+	query, args, err := sq.
+		Select("COUNT(*) > 0").
+		From("streams s").
+		Where(sq.Eq{"s.id": chatUUID}).
+		Where(sq.Or{
+			sq.Expr("EXISTS (SELECT 1 FROM stream_members sm WHERE sm.stream_id = s.id AND sm.user_id = ? AND sm.left_at IS NULL AND NOT "+activeBanCondition("sm")+")", userUUID),
+			sq.And{
+				sq.Eq{"s.type": model.StreamTypeChannel},
+				sq.Expr("EXISTS (SELECT 1 FROM user_subscriptions us WHERE us.channel = s.id::text AND us.user_id = ? AND "+notBannedSubscriptionCondition+")", userUUID),
+			},
+		}).
+		PlaceholderFormat(sq.Dollar).
+		ToSql()
+	if err != nil {
+		return false, fmt.Errorf("failed to build sql query: %v", err)
+	}
+
+	var hasAccess bool
+	err = r.conn(ctx).GetContext(ctx, &hasAccess, query, args...)
+	if err != nil {
+		return false, err
+	}
+
+	return hasAccess, nil
+}
+
+func (r *Repository) MarkMessagesRead(ctx context.Context, chatUUID, userUUID, upToMessageUUID string) (int64, error) {
+	query, args, err := markMessagesReadQuery(chatUUID, userUUID, upToMessageUUID)
+	if err != nil {
+		return 0, fmt.Errorf("failed to build sql query: %v", err)
+	}
+
+	result, err := r.conn(ctx).ExecContext(ctx, query, args...)
+	if err != nil {
+		return 0, err
+	}
+
+	marked, err := result.RowsAffected()
+	if err != nil {
+		return 0, fmt.Errorf("failed to get affected rows: %v", err)
+	}
+
+	return marked, nil
+}
+
+// markMessagesReadQuery отмечает прочитанными те же сообщения, которые считает unreadCountColumn,
+// иначе непрочитанные сообщения, удаленные для себя другим участником, никогда не обнулятся
+func markMessagesReadQuery(chatUUID, userUUID, upToMessageUUID string) (string, []interface{}, error) {
+	messages := sq.Select("id").
+		Column(sq.Expr("CAST(? AS uuid)", userUUID)).
+		From("messages").
+		Where(sq.Eq{"stream_id": chatUUID}).
+		Where(sq.NotEq{"sender_id": userUUID}).
+		Where(notDeletedForUserCondition("messages"), userUUID).
+		Where(visibleHistoryCondition("messages", userUUID)).
+		Where("(sent_at, id) <= (SELECT sent_at, id FROM messages WHERE id = ? AND stream_id = ?)", upToMessageUUID, chatUUID)
+
+	return sq.Insert("message_reads").
+		Columns("message_id", "user_id").
+		Select(messages).
+		Suffix("ON CONFLICT (message_id, user_id) DO NOTHING").
+		PlaceholderFormat(sq.Dollar).
+		ToSql()
+}
+
+func (r *Repository) GetMessageReaders(ctx context.Context, chatUUID, messageUUID, userUUID string) (*model.MessageReaderList, error) {
+	query, args, err := sq.Select(
+		"u.id AS user_uuid",
+		"u.nickname",
+		"u.avatar_url",
+		"mr.read_at",
+	).
+		From("message_reads mr").
+		Join("messages m ON m.id = mr.message_id").
+		Join("users u ON u.id = mr.user_id").
+		Where(sq.Eq{"m.id": messageUUID}).
+		Where(sq.Eq{"m.stream_id": chatUUID}).
+		Where(visibleHistoryCondition("m", userUUID)).
+		OrderBy("mr.read_at ASC").
+		PlaceholderFormat(sq.Dollar).
+		ToSql()
+	if err != nil {
+		return nil, fmt.Errorf("failed to build sql query: %v", err)
+	}
+
+	var readers model.MessageReaderList
+	err = r.conn(ctx).SelectContext(ctx, &readers, query, args...)
+	if err != nil {
+		return nil, err
+	}
+
+	return &readers, nil
+}
+
+func (r *Repository) UpdateUserNickname(ctx context.Context, userUUID, newNickname string) error {
+	query, args, err := sq.Update("users").
+		Set("nickname", newNickname).
+		Where(sq.Eq{"id": userUUID}).
+		PlaceholderFormat(sq.Dollar).
+		ToSql()
+	if err != nil {
+		return fmt.Errorf("failed to build sql query: %v", err)
+	}
+
+	_, err = r.conn(ctx).ExecContext(ctx, query, args...)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (r *Repository) UpdateUserAvatar(ctx context.Context, userUUID, avatarLink string) error {
+	query, args, err := sq.Update("users").
+		Set("avatar_url", avatarLink).
+		Where(sq.Eq{"id": userUUID}).
+		PlaceholderFormat(sq.Dollar).
+		ToSql()
+	if err != nil {
+		return fmt.Errorf("failed to build sql query: %v", err)
+	}
+
+	_, err = r.conn(ctx).ExecContext(ctx, query, args...)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (r *Repository) GetUsersLastOnline(ctx context.Context, userUUIDs []string) (*model.UserPresenceList, error) {
+	query, args, err := sq.Select("id AS user_uuid", "last_online").
+		From("users").
+		Where(sq.Eq{"id": userUUIDs}).
+		PlaceholderFormat(sq.Dollar).
+		ToSql()
+	if err != nil {
+		return nil, fmt.Errorf("failed to build sql query: %v", err)
+	}
+
+	var presences model.UserPresenceList
+	err = r.conn(ctx).SelectContext(ctx, &presences, query, args...)
+	if err != nil {
+		return nil, err
+	}
+
+	return &presences, nil
+}
+
+// UpdateUsersLastOnline сохраняет время последней активности, не сдвигая его назад,
+// если другой экземпляр сервиса уже записал более позднее время
+func (r *Repository) UpdateUsersLastOnline(ctx context.Context, lastOnline map[string]time.Time) error {
+	if len(lastOnline) == 0 {
+		return nil
+	}
+
+	userUUIDs := make([]string, 0, len(lastOnline))
+	lastOnlineCase := sq.Case("id")
+	for userUUID, seenAt := range lastOnline {
+		userUUIDs = append(userUUIDs, userUUID)
+		lastOnlineCase = lastOnlineCase.When(sq.Expr("?::uuid", userUUID), sq.Expr("?::timestamp", seenAt.UTC()))
+	}
+
+	query, args, err := sq.Update("users").
+		Set("last_online", sq.Expr("GREATEST(last_online, ?)", lastOnlineCase)).
+		Where(sq.Eq{"id": userUUIDs}).
+		PlaceholderFormat(sq.Dollar).
+		ToSql()
+	if err != nil {
+		return fmt.Errorf("failed to build sql query: %v", err)
+	}
+
+	_, err = r.conn(ctx).ExecContext(ctx, query, args...)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// AddNotificationRequests добавляет сообщение в группы уведомлений получателей.
+// Группа хранится в базе до отправки, поэтому переживает перезапуск сервиса
+func (r *Repository) AddNotificationRequests(ctx context.Context, chatUUID, messageUUID, senderUUID string, recipientUUIDs []string) error {
+	if len(recipientUUIDs) == 0 {
+		return nil
+	}
+
+	insert := sq.Insert("notification_batches").
+		Columns("recipient_id", "stream_id", "last_message_id", "last_sender_id")
+	for _, recipientUUID := range recipientUUIDs {
+		insert = insert.Values(recipientUUID, chatUUID, messageUUID, senderUUID)
+	}
+
+	query, args, err := insert.
+		Suffix("ON CONFLICT (recipient_id, stream_id) DO UPDATE SET " +
+			"messages_count = notification_batches.messages_count + 1, last_message_id = EXCLUDED.last_message_id, " +
+			"last_sender_id = EXCLUDED.last_sender_id, last_message_at = EXCLUDED.last_message_at").
+		PlaceholderFormat(sq.Dollar).
+		ToSql()
+	if err != nil {
+		return fmt.Errorf("failed to build sql query: %v", err)
+	}
+
+	_, err = r.conn(ctx).ExecContext(ctx, query, args...)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// GetReadyNotificationBatches блокирует до конца транзакции группы, окно которых истекло.
+// Заблокированные группы пропускаются, поэтому несколько экземпляров сервиса отправляют разные группы
+func (r *Repository) GetReadyNotificationBatches(ctx context.Context, window time.Duration, limit uint64) (*model.NotificationBatchList, error) {
+	query, args, err := sq.Select(
+		"recipient_id AS recipient_uuid",
+		"stream_id AS chat_uuid",
+		"messages_count",
+		"last_message_id AS last_message_uuid",
+		"last_sender_id AS last_sender_uuid",
+		"first_message_at",
+		"last_message_at",
+	).
+		From("notification_batches").
+		Where("first_message_at <= CURRENT_TIMESTAMP - make_interval(secs => ?)", int64(window/time.Second)).
+		OrderBy("first_message_at").
+		Limit(limit).
+		Suffix("FOR UPDATE SKIP LOCKED").
+		PlaceholderFormat(sq.Dollar).
+		ToSql()
+	if err != nil {
+		return nil, fmt.Errorf("failed to build sql query: %v", err)
+	}
+
+	var batches model.NotificationBatchList
+	err = r.conn(ctx).SelectContext(ctx, &batches, query, args...)
+	if err != nil {
+		return nil, err
+	}
+
+	return &batches, nil
+}
+
+func (r *Repository) DeleteNotificationBatches(ctx context.Context, batches model.NotificationBatchList) error {
+	if len(batches) == 0 {
+		return nil
+	}
+
+	keys := make(sq.Or, 0, len(batches))
+	for _, batch := range batches {
+		keys = append(keys, sq.Eq{"recipient_id": batch.RecipientUUID, "stream_id": batch.ChatUUID})
+	}
+
+	query, args, err := sq.Delete("notification_batches").
+		Where(keys).
+		PlaceholderFormat(sq.Dollar).
+		ToSql()
+	if err != nil {
+		return fmt.Errorf("failed to build sql query: %v", err)
+	}
+
+	_, err = r.conn(ctx).ExecContext(ctx, query, args...)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// AnonymizeUser убирает никнейм и аватарку пользователя из всех чатов
+func (r *Repository) AnonymizeUser(ctx context.Context, userUUID string) error {
+	query, args, err := sq.Update("users").
+		Set("nickname", model.DeletedUserNickname).
+		Set("avatar_url", "").
+		Where(sq.Eq{"id": userUUID}).
+		PlaceholderFormat(sq.Dollar).
+		ToSql()
+	if err != nil {
+		return fmt.Errorf("failed to build sql query: %v", err)
+	}
+
+	_, err = r.conn(ctx).ExecContext(ctx, query, args...)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// TransferUserOwnerships передает владение группами и каналами пользователя, чтобы они не остались без владельца.
+// Наследник - самый давний администратор, без администраторов - самый давний участник.
+// Чат без других активных участников остается без владельца: управлять в нем некем
+func (r *Repository) TransferUserOwnerships(ctx context.Context, userUUID string) error {
+	heirs := sq.Select().
+		Options("DISTINCT ON (h.stream_id)").
+		Column("h.id").
+		From("stream_members o").
+		Join("streams s ON s.id = o.stream_id").
+		Join("stream_members h ON h.stream_id = o.stream_id AND h.user_id <> o.user_id AND h.left_at IS NULL").
+		Where(sq.Eq{"o.user_id": userUUID}).
+		Where(sq.Eq{"o.left_at": nil}).
+		Where(sq.Expr("o.metadata->>'role' = ?", model.RoleOwner)).
+		Where(sq.Eq{"s.type": []string{model.StreamTypeGroup, model.StreamTypeChannel}}).
+		Where("NOT "+activeBanCondition("h")).
+		OrderByClause("h.stream_id, (h.metadata->>'role' = ?) DESC, h.joined_at, h.id", model.RoleAdmin)
+
+	query, args, err := sq.Update("stream_members sm").
+		Set("metadata", sq.Expr("COALESCE(sm.metadata, '{}'::jsonb) || jsonb_build_object('role', ?::text)", model.RoleOwner)).
+		FromSelect(heirs, "heir").
+		Where("sm.id = heir.id").
+		PlaceholderFormat(sq.Dollar).
+		ToSql()
+	if err != nil {
+		return fmt.Errorf("failed to build sql query: %v", err)
+	}
+
+	_, err = r.conn(ctx).ExecContext(ctx, query, args...)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// RevokeUserMemberships исключает пользователя из всех чатов и отменяет его подписки на каналы одним запросом
+func (r *Repository) RevokeUserMemberships(ctx context.Context, userUUID string) error {
+	query, args, err := sq.Update("stream_members").
+		Prefix("WITH unsubscribed AS (DELETE FROM user_subscriptions WHERE user_id = ?)", userUUID).
+		Set("left_at", sq.Expr("CURRENT_TIMESTAMP")).
+		Where(sq.Eq{"user_id": userUUID}).
+		Where(sq.Eq{"left_at": nil}).
+		PlaceholderFormat(sq.Dollar).
+		ToSql()
+	if err != nil {
+		return fmt.Errorf("failed to build sql query: %v", err)
+	}
+
+	_, err = r.conn(ctx).ExecContext(ctx, query, args...)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// DeleteUserMessages удаляет для всех сообщения пользователя, системные сообщения о его действиях остаются
+func (r *Repository) DeleteUserMessages(ctx context.Context, userUUID string) (*model.DeletedMessageList, error) {
+	query, args, err := sq.Update("messages").
+		Set("deleted_by", sq.Expr("sender_id")).
+		Set("delete_format", model.All).
+		Set("deleted_at", sq.Expr("CURRENT_TIMESTAMP")).
+		Where(sq.Eq{"sender_id": userUUID}).
+		Where(sq.Eq{"deleted_at": nil}).
+		Where(sq.Expr("type IS DISTINCT FROM ?", model.MessageTypeSystem)).
+		Suffix("RETURNING id AS message_uuid, stream_id AS chat_uuid").
+		PlaceholderFormat(sq.Dollar).
+		ToSql()
+	if err != nil {
+		return nil, fmt.Errorf("failed to build sql query: %v", err)
+	}
+
+	var deleted model.DeletedMessageList
+	err = r.conn(ctx).SelectContext(ctx, &deleted, query, args...)
+	if err != nil {
+		return nil, err
+	}
+
+	return &deleted, nil
+}
+
+func (r *Repository) AddOutboxEvent(ctx context.Context, event *model.NewOutboxEvent) error {
+	query, args, err := sq.Insert("outbox").
+		Columns("stream_id", "event_type", "payload").
+		Values(event.ChatUUID, event.Type, string(event.Payload)).
+		PlaceholderFormat(sq.Dollar).
+		ToSql()
+	if err != nil {
+		return fmt.Errorf("failed to build sql query: %v", err)
+	}
+
+	_, err = r.conn(ctx).ExecContext(ctx, query, args...)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// GetUnpublishedOutboxEvents блокирует до конца транзакции самые старые неопубликованные события.
+// Другой экземпляр релея ждет снятия блокировки, поэтому события публикуются строго в порядке id
+func (r *Repository) GetUnpublishedOutboxEvents(ctx context.Context, limit uint64) (*model.OutboxEventList, error) {
+	query, args, err := sq.Select("id", "stream_id AS chat_uuid", "event_type AS type", "payload").
+		From("outbox").
+		Where(sq.Eq{"published_at": nil}).
+		OrderBy("id").
+		Limit(limit).
+		Suffix("FOR UPDATE").
+		PlaceholderFormat(sq.Dollar).
+		ToSql()
+	if err != nil {
+		return nil, fmt.Errorf("failed to build sql query: %v", err)
+	}
+
+	var events model.OutboxEventList
+	err = r.conn(ctx).SelectContext(ctx, &events, query, args...)
+	if err != nil {
+		return nil, err
+	}
+
+	return &events, nil
+}
+
+func (r *Repository) MarkOutboxEventsPublished(ctx context.Context, ids []int64) error {
+	if len(ids) == 0 {
+		return nil
+	}
+
+	query, args, err := sq.Update("outbox").
+		Set("published_at", sq.Expr("CURRENT_TIMESTAMP")).
+		Where(sq.Eq{"id": ids}).
+		PlaceholderFormat(sq.Dollar).
+		ToSql()
+	if err != nil {
+		return fmt.Errorf("failed to build sql query: %v", err)
+	}
+
+	_, err = r.conn(ctx).ExecContext(ctx, query, args...)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (r *Repository) upsertUser(ctx context.Context, member *model.ChatMemberParams) error {
+	query, args, err := sq.Insert("users").
+		Columns("id", "nickname", "avatar_url").
+		Values(member.UserUUID, member.Nickname, member.AvatarLink).
+		Suffix("ON CONFLICT (id) DO UPDATE SET nickname = EXCLUDED.nickname, avatar_url = EXCLUDED.avatar_url").
+		PlaceholderFormat(sq.Dollar).
+		ToSql()
+	if err != nil {
+		return fmt.Errorf("failed to build sql query: %v", err)
+	}
+
+	_, err = r.conn(ctx).ExecContext(ctx, query, args...)
+	if err != nil {
+		return err
 	}
 
 	return nil
 }
+
+func (r *Repository) createStream(ctx context.Context, streamType string, creator *model.ChatMemberParams, metadata *model.StreamMetadata) (string, error) {
+	err := r.upsertUser(ctx, creator)
+	if err != nil {
+		return "", err
+	}
+
+	rawMetadata, err := json.Marshal(metadata)
+	if err != nil {
+		return "", fmt.Errorf("failed to marshal stream metadata: %v", err)
+	}
+
+	query, args, err := sq.Insert("streams").
+		Columns("type", "metadata", "created_by").
+		Values(streamType, string(rawMetadata), creator.UserUUID).
+		Suffix("RETURNING id").
+		PlaceholderFormat(sq.Dollar).
+		ToSql()
+	if err != nil {
+		return "", fmt.Errorf("failed to build sql query: %v", err)
+	}
+
+	var chatUUID string
+	err = r.conn(ctx).GetContext(ctx, &chatUUID, query, args...)
+	if err != nil {
+		return "", err
+	}
+
+	return chatUUID, nil
+}
+
+// lastPinnedMessageJoin присоединяет последнее закрепленное и видимое пользователю сообщение чата, как GetPinnedMessages.
+// Ожидает uuid пользователя дважды
+var lastPinnedMessageJoin = `LATERAL (SELECT pm.message_id, pmm.content FROM pinned_messages pm
+	JOIN messages pmm ON pmm.id = pm.message_id
+	WHERE pm.stream_id = s.id AND NOT ` + hiddenHistoryCondition("pmm") + `
+		AND ` + notDeletedForUserCondition("pmm") + `
+	ORDER BY pm.pinned_at DESC LIMIT 1) p ON TRUE`
+
+// lastMessageJoin выбирает последнее видимое пользователю сообщение чата s: удаленные для всех
+// и удаленные пользователем для себя сообщения пропускаются. Ожидает uuid пользователя дважды
+var lastMessageJoin = `LATERAL (SELECT content, sent_at FROM messages
+	WHERE stream_id = s.id AND NOT ` + hiddenHistoryCondition("messages") + `
+		AND ` + notDeletedForUserCondition("messages") + `
+	ORDER BY sent_at DESC LIMIT 1) m ON TRUE`
+
+// rejoinMemberSet возвращает участника в чат; вышедший ранее участник не видит историю до возвращения.
+// У строк-заглушек для бана и настроек подписчика left_at совпадает с joined_at, такие строки историю не скрывают
+const rejoinMemberSet = "metadata = EXCLUDED.metadata, invited_by = EXCLUDED.invited_by, joined_at = CURRENT_TIMESTAMP, left_at = NULL, " +
+	"history_from = CASE WHEN stream_members.left_at > stream_members.joined_at THEN CURRENT_TIMESTAMP ELSE stream_members.history_from END"
+
+// hiddenHistoryCondition проверяет, что сообщение alias отправлено до возвращения пользователя в чат; ожидает uuid пользователя
+func hiddenHistoryCondition(alias string) string {
+	return fmt.Sprintf(`EXISTS (SELECT 1 FROM stream_members hm
+		WHERE hm.stream_id = %[1]s.stream_id AND hm.user_id = ? AND hm.history_from > %[1]s.sent_at)`, alias)
+}
+
+// notDeletedForUserCondition проверяет, что сообщение alias не удалено для всех и не удалено пользователем для себя;
+// ожидает uuid пользователя. Удаление для себя другим участником сообщение не скрывает
+func notDeletedForUserCondition(alias string) string {
+	return fmt.Sprintf("(%[1]s.delete_format IS NULL OR (%[1]s.delete_format = 'self' AND %[1]s.deleted_by != ?))", alias)
+}
+
+func visibleHistoryCondition(alias, userUUID string) sq.Sqlizer {
+	return sq.Expr("NOT "+hiddenHistoryCondition(alias), userUUID)
+}
+
+// activeBanCondition проверяет, что участник чата забанен сейчас; бан с истекшим ban_until снимается сам
+func activeBanCondition(alias string) string {
+	return fmt.Sprintf("(%[1]s.banned_at IS NOT NULL AND (%[1]s.ban_until IS NULL OR %[1]s.ban_until > CURRENT_TIMESTAMP))", alias)
+}
+
+// activeMuteCondition проверяет, что участник выключил уведомления чата; истекший muted_until включает их сам
+func activeMuteCondition(alias string) string {
+	return fmt.Sprintf("(%[1]s.notify IS FALSE AND (%[1]s.muted_until IS NULL OR %[1]s.muted_until > CURRENT_TIMESTAMP))", alias)
+}
+
+// notificationColumns возвращает действующие настройки уведомлений из строки stream_members;
+// без строки, например у подписчика канала, уведомления включены
+func notificationColumns(alias string) []string {
+	muted := activeMuteCondition(alias)
+
+	return []string{
+		muted + " AS muted",
+		fmt.Sprintf("CASE WHEN %s THEN %s.muted_until END AS muted_until", muted, alias),
+		fmt.Sprintf("(%s AND %s.mentions_only) AS mentions_only", muted, alias),
+	}
+}
+
+// notBannedSubscriptionCondition исключает подписки us пользователей, забаненных в канале
+var notBannedSubscriptionCondition = `NOT EXISTS (SELECT 1 FROM stream_members b
+	WHERE b.stream_id::text = us.channel AND b.user_id = us.user_id AND ` + activeBanCondition("b") + `)`
+
+// unreadCountColumn считает сообщения чата от других участников, которые пользователь ещё не прочитал
+func unreadCountColumn(userUUID string) sq.Sqlizer {
+	return sq.Expr(`(SELECT COUNT(*) FROM messages um
+		WHERE um.stream_id = s.id
+		AND um.sender_id != ?
+		AND `+notDeletedForUserCondition("um")+`
+		AND NOT EXISTS (SELECT 1 FROM message_reads mr WHERE mr.message_id = um.id AND mr.user_id = ?)
+		AND NOT `+hiddenHistoryCondition("um")+`) AS unread_count`, userUUID, userUUID, userUUID, userUUID)
+}
+
+// reactionsColumn собирает реакции сообщения из messages в json с количеством и отметкой о реакции пользователя
+func reactionsColumn(userUUID string) sq.Sqlizer {
+	return sq.Expr(`(SELECT COALESCE(json_agg(json_build_object('emoji', r.emoji, 'count', r.count, 'reacted_by_me', r.mine) ORDER BY r.first_at), '[]')
+		FROM (SELECT emoji, COUNT(*) AS count, bool_or(user_id = ?) AS mine, MIN(created_at) AS first_at
+			FROM message_reactions WHERE message_id = messages.id GROUP BY emoji) r) AS reactions`, userUUID)
+}
+
+// nullableUUID превращает пустой uuid в NULL для необязательных колонок
+func nullableUUID(value string) interface{} {
+	if value == "" {
+		return nil
+	}
+
+	return value
+}
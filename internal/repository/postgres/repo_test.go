@@ -0,0 +1,33 @@
+package postgres
+
+import (
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestMarkMessagesReadQuery(t *testing.T) {
+	t.Parallel()
+
+	chatUUID := uuid.New().String()
+	userUUID := uuid.New().String()
+	upToMessageUUID := uuid.New().String()
+
+	t.Run("deleted_for_self_by_other_member", func(t *testing.T) {
+		query, args, err := markMessagesReadQuery(chatUUID, userUUID, upToMessageUUID)
+
+		assert.NoError(t, err)
+		// сообщение, удаленное для себя другим участником, считается в unread_count и должно отмечаться прочитанным
+		assert.Contains(t, query, "(messages.delete_format IS NULL OR (messages.delete_format = 'self' AND messages.deleted_by != $4))")
+		assert.NotContains(t, query, "AND delete_format IS NULL")
+		assert.Equal(t, []interface{}{userUUID, chatUUID, userUUID, userUUID, userUUID, upToMessageUUID, chatUUID}, args)
+	})
+
+	t.Run("same_condition_as_unread_count", func(t *testing.T) {
+		unread, _, err := unreadCountColumn(userUUID).ToSql()
+
+		assert.NoError(t, err)
+		assert.Contains(t, unread, notDeletedForUserCondition("um"))
+	})
+}
@@ -3,23 +3,72 @@ package service
 
 import (
 	"context"
+	"time"
 
 	"github.com/s21platform/chat-service/internal/model"
 )
 
 type DBRepo interface {
-	CreatePrivateChat(ctx context.Context) (string, error)
+	WithTx(ctx context.Context, fn func(ctx context.Context) error) error
+	GetOrCreatePrivateChat(ctx context.Context, pairKey string) (string, bool, error)
 	AddPrivateChatMember(ctx context.Context, chatUUID string, member *model.ChatMemberParams) error
 	GetPrivateChats(ctx context.Context, userUUID string) (*model.ChatInfoList, error)
 	GetGroupChats(ctx context.Context, userUUID string) (*model.ChatInfoList, error)
-	GetPrivateRecentMessages(ctx context.Context, chatUUID string, userUUID string) (*model.MessageList, error)
-	DeletePrivateMessage(ctx context.Context, userUUID, messageID, mode string) (bool, error)
-	GetPrivateDeletionInfo(ctx context.Context, messageID string) (*model.DeletionInfo, error)
+	CreateGroupChat(ctx context.Context, creator *model.ChatMemberParams, name, avatarURL string) (string, error)
+	AddChatMember(ctx context.Context, chatUUID string, member *model.ChatMemberParams, role, invitedBy string) error
+	RemoveChatMember(ctx context.Context, chatUUID, userUUID string) error
+	GetChatMember(ctx context.Context, chatUUID, userUUID string) (*model.ChatMember, error)
+	BanMember(ctx context.Context, chatUUID, userUUID, bannedBy, reason string, duration time.Duration) (*model.MemberBan, error)
+	UnbanMember(ctx context.Context, chatUUID, userUUID string) (bool, error)
+	IsMemberBanned(ctx context.Context, chatUUID, userUUID string) (bool, error)
+	KickMember(ctx context.Context, chatUUID, userUUID string) error
+	SetChatNotifications(ctx context.Context, chatUUID, userUUID string, settings *model.ChatNotifications) (*model.ChatNotifications, error)
+	CreateInviteLink(ctx context.Context, chatUUID, createdBy string, expiresIn time.Duration, usageLimit int32) (*model.InviteLink, error)
+	RevokeInviteLink(ctx context.Context, chatUUID, code string) (bool, error)
+	GetInviteLinks(ctx context.Context, chatUUID string) (*model.InviteLinkList, error)
+	GetActiveInviteLink(ctx context.Context, code string) (*model.InviteLink, error)
+	JoinByInvite(ctx context.Context, code string, member *model.ChatMemberParams) (*model.InviteJoin, error)
+	CreateChannel(ctx context.Context, creator *model.ChatMemberParams, name, avatarURL string) (string, error)
+	GetChannels(ctx context.Context, userUUID string) (*model.ChatInfoList, error)
+	SubscribeChannel(ctx context.Context, chatUUID string, subscriber *model.ChatMemberParams) error
+	UnsubscribeChannel(ctx context.Context, chatUUID, userUUID string) error
+	GetStreamType(ctx context.Context, chatUUID string) (string, error)
+	GetOrCreateCommentStream(ctx context.Context, creator *model.ChatMemberParams, entityType, entityID string) (string, bool, error)
+	EnsureChatMember(ctx context.Context, chatUUID string, member *model.ChatMemberParams, role string) error
+	GetPrivateRecentMessages(ctx context.Context, chatUUID string, userUUID string, page *model.MessagePage) (*model.MessageList, error)
+	SendPrivateMessage(ctx context.Context, message *model.NewMessage) (*model.Message, error)
+	ForwardMessages(ctx context.Context, sourceChatUUID, targetChatUUID, userUUID string, messageUUIDs []string) (*model.MessageList, error)
+	SearchMessages(ctx context.Context, userUUID, searchQuery, chatUUID string, page *model.MessagePage) (*model.SearchResultList, error)
+	DeletePrivateMessage(ctx context.Context, chatUUID, userUUID, messageID, mode string) (bool, error)
+	GetPrivateDeletionInfo(ctx context.Context, chatUUID, userUUID, messageID string) (*model.DeletionInfo, error)
 	EditPrivateMessage(ctx context.Context, messageUUID string, newContent string) (*model.EditedMessage, error)
+	GetMessageRevisions(ctx context.Context, chatUUID, messageUUID, userUUID string) (*model.MessageRevisionList, error)
 	IsChatMember(ctx context.Context, chatUUID, userUUID string) (bool, error)
+	IsFormerChatMember(ctx context.Context, chatUUID, userUUID string) (bool, error)
 	IsMessageOwner(ctx context.Context, chatUUID, messageUUID, userUUID string) (bool, error)
+	MessageExists(ctx context.Context, chatUUID, messageUUID, userUUID string) (bool, error)
+	AddReaction(ctx context.Context, messageUUID, userUUID, emoji string) error
+	RemoveReaction(ctx context.Context, messageUUID, userUUID, emoji string) error
+	GetMessageReactions(ctx context.Context, messageUUID, userUUID string) (model.ReactionList, error)
+	PinMessage(ctx context.Context, chatUUID, messageUUID, userUUID string) (bool, error)
+	UnpinMessage(ctx context.Context, chatUUID, messageUUID string) (bool, error)
+	GetPinnedMessages(ctx context.Context, chatUUID, userUUID string) (*model.PinnedMessageList, error)
+	GetChatRecipients(ctx context.Context, chatUUID string) (*model.EventRecipientList, error)
+	HasChatAccess(ctx context.Context, chatUUID, userUUID string) (bool, error)
+	MarkMessagesRead(ctx context.Context, chatUUID, userUUID, upToMessageUUID string) (int64, error)
+	GetMessageReaders(ctx context.Context, chatUUID, messageUUID, userUUID string) (*model.MessageReaderList, error)
+	GetUsersLastOnline(ctx context.Context, userUUIDs []string) (*model.UserPresenceList, error)
+	UpdateUsersLastOnline(ctx context.Context, lastOnline map[string]time.Time) error
+	AddNotificationRequests(ctx context.Context, chatUUID, messageUUID, senderUUID string, recipientUUIDs []string) error
+	GetReadyNotificationBatches(ctx context.Context, window time.Duration, limit uint64) (*model.NotificationBatchList, error)
+	DeleteNotificationBatches(ctx context.Context, batches model.NotificationBatchList) error
+	AddOutboxEvent(ctx context.Context, event *model.NewOutboxEvent) error
 }
 
 type UserClient interface {
 	GetUserInfoByUUID(ctx context.Context, userUUID string) (*model.ChatMemberParams, error)
 }
+
+type NotificationProducer interface {
+	ProduceMessage(ctx context.Context, message any, key any) error
+}
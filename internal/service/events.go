@@ -0,0 +1,121 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/emptypb"
+
+	logger_lib "github.com/s21platform/logger-lib"
+
+	"github.com/s21platform/chat-service/internal/config"
+	"github.com/s21platform/chat-service/internal/model"
+	"github.com/s21platform/chat-service/pkg/chat"
+)
+
+// размер буфера событий одного подписчика, при переполнении подписка отключается
+const eventsBufferSize = 256
+
+func (s *Server) SubscribeEvents(_ *emptypb.Empty, stream grpc.ServerStreamingServer[chat.Event]) error {
+	ctx := stream.Context()
+	logger := logger_lib.FromContext(ctx, config.KeyLogger)
+	logger.AddFuncName("SubscribeEvents")
+
+	userUUID, ok := ctx.Value(config.KeyUUID).(string)
+	if !ok {
+		logger.Error("failed to find uuid")
+		return status.Error(codes.Internal, "failed to find uuid")
+	}
+
+	sub := s.events.Subscribe(userUUID)
+	defer s.events.Unsubscribe(sub)
+
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case event, ok := <-sub.Events():
+			if !ok {
+				logger.Error("failed to deliver events: subscriber is too slow")
+				return status.Error(codes.Unavailable, "failed to deliver events: subscriber is too slow")
+			}
+
+			if err := stream.Send(event); err != nil {
+				logger.Error(fmt.Sprintf("failed to send event: %v", err))
+				return status.Errorf(codes.Internal, "failed to send event: %v", err)
+			}
+		}
+	}
+}
+
+// publishChatEvent рассылает событие всем участникам и подписчикам чата, а также extraRecipients.
+// Новое сообщение помечается notify для получателей, чьи настройки уведомлений это допускают,
+// extraRecipients и автор сообщения получают событие без уведомления.
+// Тем из уведомляемых, кто не в сети, новое сообщение ставится в очередь уведомлений.
+// Ошибки только логируются: доставка событий не должна ломать уже выполненную запись.
+func (s *Server) publishChatEvent(ctx context.Context, chatUUID string, event *chat.Event, extraRecipients ...string) {
+	message := event.GetMessageSent()
+	if message == nil && !s.events.HasSubscribers() {
+		return
+	}
+
+	recipients, err := s.repository.GetChatRecipients(ctx, chatUUID)
+	if err != nil {
+		logger := logger_lib.FromContext(ctx, config.KeyLogger)
+		logger.Error(fmt.Sprintf("failed to get event recipients: %v", err))
+		return
+	}
+
+	if message == nil {
+		userUUIDs := make([]string, 0, len(*recipients)+len(extraRecipients))
+		for _, recipient := range *recipients {
+			userUUIDs = append(userUUIDs, recipient.UserUUID)
+		}
+		s.publishUserEvent(append(userUUIDs, extraRecipients...), chatUUID, event)
+		return
+	}
+
+	notifyEvent := proto.Clone(event).(*chat.Event)
+	notifyEvent.Notify = true
+
+	var silent, notified []string
+	var notifiedRecipients []model.EventRecipient
+	for _, recipient := range *recipients {
+		if recipient.UserUUID != message.Uuid && recipient.ShouldNotify(message.Content) {
+			notified = append(notified, recipient.UserUUID)
+			notifiedRecipients = append(notifiedRecipients, recipient)
+		} else {
+			silent = append(silent, recipient.UserUUID)
+		}
+	}
+
+	s.queueOfflineNotifications(ctx, chatUUID, message, notifiedRecipients)
+
+	s.publishUserEvent(notified, chatUUID, notifyEvent)
+	s.publishUserEvent(append(silent, extraRecipients...), chatUUID, event)
+}
+
+func (s *Server) publishUserEvent(userUUIDs []string, chatUUID string, event *chat.Event) {
+	event.ChatUuid = chatUUID
+	event.OccurredAt = time.Now().Format(time.RFC3339)
+
+	for _, userUUID := range userUUIDs {
+		s.events.Publish(userUUID, event)
+	}
+}
+
+func messageSentEvent(message *chat.Message) *chat.Event {
+	return &chat.Event{Payload: &chat.Event_MessageSent{MessageSent: message}}
+}
+
+func membershipChangedEvent(userUUID, action string) *chat.Event {
+	return &chat.Event{Payload: &chat.Event_MembershipChanged{MembershipChanged: &chat.MembershipChanged{
+		UserUuid: userUUID,
+		Action:   action,
+	}}}
+}
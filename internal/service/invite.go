@@ -0,0 +1,203 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	logger_lib "github.com/s21platform/logger-lib"
+
+	"github.com/s21platform/chat-service/internal/config"
+	"github.com/s21platform/chat-service/internal/model"
+	"github.com/s21platform/chat-service/pkg/chat"
+)
+
+const (
+	maxInviteLinkLifetime = 365 * 24 * time.Hour
+	maxInviteCodeLength   = 64
+)
+
+func (s *Server) CreateInviteLink(ctx context.Context, in *chat.CreateInviteLinkIn) (*chat.CreateInviteLinkOut, error) {
+	logger := logger_lib.FromContext(ctx, config.KeyLogger)
+	logger.AddFuncName("CreateInviteLink")
+
+	userUUID, ok := ctx.Value(config.KeyUUID).(string)
+	if !ok {
+		logger.Error("failed to find uuid")
+		return nil, status.Error(codes.Internal, "failed to find uuid")
+	}
+
+	expiresIn := time.Duration(in.ExpiresInSeconds) * time.Second
+	if in.ExpiresInSeconds < 0 || expiresIn > maxInviteLinkLifetime {
+		logger.Error("failed to create invite link: invalid expiry")
+		return nil, status.Errorf(codes.InvalidArgument, "failed to create invite link: expiry must be between 0 and %d seconds", int64(maxInviteLinkLifetime/time.Second))
+	}
+
+	if in.UsageLimit < 0 {
+		logger.Error("failed to create invite link: negative usage limit")
+		return nil, status.Error(codes.InvalidArgument, "failed to create invite link: usage limit must not be negative")
+	}
+
+	_, err := s.checkGroupManager(ctx, in.ChatUuid, userUUID)
+	if err != nil {
+		logger.Error(status.Convert(err).Message())
+		return nil, err
+	}
+
+	link, err := s.repository.CreateInviteLink(ctx, in.ChatUuid, userUUID, expiresIn, in.UsageLimit)
+	if err != nil {
+		logger.Error(fmt.Sprintf("failed to create invite link: %v", err))
+		return nil, status.Errorf(codes.Internal, "failed to create invite link: %v", err)
+	}
+
+	return &chat.CreateInviteLinkOut{
+		Link: link.FromDTO(),
+	}, nil
+}
+
+func (s *Server) RevokeInviteLink(ctx context.Context, in *chat.RevokeInviteLinkIn) (*chat.RevokeInviteLinkOut, error) {
+	logger := logger_lib.FromContext(ctx, config.KeyLogger)
+	logger.AddFuncName("RevokeInviteLink")
+
+	userUUID, ok := ctx.Value(config.KeyUUID).(string)
+	if !ok {
+		logger.Error("failed to find uuid")
+		return nil, status.Error(codes.Internal, "failed to find uuid")
+	}
+
+	_, err := s.checkGroupManager(ctx, in.ChatUuid, userUUID)
+	if err != nil {
+		logger.Error(status.Convert(err).Message())
+		return nil, err
+	}
+
+	revoked, err := s.repository.RevokeInviteLink(ctx, in.ChatUuid, in.Code)
+	if err != nil {
+		logger.Error(fmt.Sprintf("failed to revoke invite link: %v", err))
+		return nil, status.Errorf(codes.Internal, "failed to revoke invite link: %v", err)
+	}
+
+	if !revoked {
+		logger.Error("failed to find invite link")
+		return nil, status.Error(codes.NotFound, "failed to find invite link")
+	}
+
+	return &chat.RevokeInviteLinkOut{
+		RevokeStatus: true,
+	}, nil
+}
+
+func (s *Server) ListInviteLinks(ctx context.Context, in *chat.ListInviteLinksIn) (*chat.ListInviteLinksOut, error) {
+	logger := logger_lib.FromContext(ctx, config.KeyLogger)
+	logger.AddFuncName("ListInviteLinks")
+
+	userUUID, ok := ctx.Value(config.KeyUUID).(string)
+	if !ok {
+		logger.Error("failed to find uuid")
+		return nil, status.Error(codes.Internal, "failed to find uuid")
+	}
+
+	_, err := s.checkGroupManager(ctx, in.ChatUuid, userUUID)
+	if err != nil {
+		logger.Error(status.Convert(err).Message())
+		return nil, err
+	}
+
+	links, err := s.repository.GetInviteLinks(ctx, in.ChatUuid)
+	if err != nil {
+		logger.Error(fmt.Sprintf("failed to get invite links: %v", err))
+		return nil, status.Errorf(codes.Internal, "failed to get invite links: %v", err)
+	}
+
+	return &chat.ListInviteLinksOut{
+		Links: links.FromDTO(),
+	}, nil
+}
+
+func (s *Server) JoinByInvite(ctx context.Context, in *chat.JoinByInviteIn) (*chat.JoinByInviteOut, error) {
+	logger := logger_lib.FromContext(ctx, config.KeyLogger)
+	logger.AddFuncName("JoinByInvite")
+
+	userUUID, ok := ctx.Value(config.KeyUUID).(string)
+	if !ok {
+		logger.Error("failed to find uuid")
+		return nil, status.Error(codes.Internal, "failed to find uuid")
+	}
+
+	code := strings.TrimSpace(in.Code)
+	if code == "" || len(code) > maxInviteCodeLength {
+		logger.Error("failed to join by invite: invalid code")
+		return nil, status.Error(codes.InvalidArgument, "failed to join by invite: invalid code")
+	}
+
+	link, err := s.repository.GetActiveInviteLink(ctx, code)
+	if err != nil {
+		logger.Error(fmt.Sprintf("failed to get invite link: %v", err))
+		return nil, status.Errorf(codes.Internal, "failed to get invite link: %v", err)
+	}
+
+	if link == nil {
+		logger.Error("failed to find active invite link")
+		return nil, status.Error(codes.NotFound, "failed to find active invite link")
+	}
+
+	// повторный переход по ссылке не тратит её использование
+	member, err := s.repository.GetChatMember(ctx, link.ChatUUID, userUUID)
+	if err != nil {
+		logger.Error(fmt.Sprintf("failed to get chat member: %v", err))
+		return nil, status.Errorf(codes.Internal, "failed to get chat member: %v", err)
+	}
+
+	if member != nil {
+		return &chat.JoinByInviteOut{
+			ChatUuid: link.ChatUUID,
+		}, nil
+	}
+
+	isBanned, err := s.repository.IsMemberBanned(ctx, link.ChatUUID, userUUID)
+	if err != nil {
+		logger.Error(fmt.Sprintf("failed to check member ban: %v", err))
+		return nil, status.Errorf(codes.Internal, "failed to check member ban: %v", err)
+	}
+
+	if isBanned {
+		logger.Error("failed to user is banned in chat")
+		return nil, status.Error(codes.PermissionDenied, "failed to user is banned in chat")
+	}
+
+	memberParams, err := s.getMemberParams(ctx, userUUID)
+	if err != nil {
+		logger.Error(fmt.Sprintf("failed to get member info: %v", err))
+		return nil, status.Errorf(codes.Internal, "failed to get member info: %v", err)
+	}
+
+	join, err := s.repository.JoinByInvite(ctx, code, memberParams)
+	if err != nil {
+		logger.Error(fmt.Sprintf("failed to join by invite: %v", err))
+		return nil, status.Errorf(codes.Internal, "failed to join by invite: %v", err)
+	}
+
+	// ссылку могли отозвать или исчерпать между проверкой и вступлением
+	if !join.LinkActive {
+		logger.Error("failed to find active invite link")
+		return nil, status.Error(codes.NotFound, "failed to find active invite link")
+	}
+
+	// пользователь успел вступить параллельным запросом
+	if !join.Joined {
+		return &chat.JoinByInviteOut{
+			ChatUuid: link.ChatUUID,
+		}, nil
+	}
+
+	s.publishChatEvent(ctx, link.ChatUUID, membershipChangedEvent(userUUID, model.MembershipActionJoined))
+
+	return &chat.JoinByInviteOut{
+		ChatUuid: link.ChatUUID,
+		Joined:   true,
+	}, nil
+}
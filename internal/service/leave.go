@@ -0,0 +1,81 @@
+package service
+
+import (
+	"context"
+	"fmt"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	logger_lib "github.com/s21platform/logger-lib"
+
+	"github.com/s21platform/chat-service/internal/config"
+	"github.com/s21platform/chat-service/internal/model"
+	"github.com/s21platform/chat-service/pkg/chat"
+)
+
+// LeaveChat выводит пользователя из личного или группового чата: чат пропадает из GetChats, отправка сообщений закрывается.
+// При повторном добавлении участник видит только сообщения, отправленные после возвращения.
+func (s *Server) LeaveChat(ctx context.Context, in *chat.LeaveChatIn) (*chat.LeaveChatOut, error) {
+	logger := logger_lib.FromContext(ctx, config.KeyLogger)
+	logger.AddFuncName("LeaveChat")
+
+	userUUID, ok := ctx.Value(config.KeyUUID).(string)
+	if !ok {
+		logger.Error("failed to find uuid")
+		return nil, status.Error(codes.Internal, "failed to find uuid")
+	}
+
+	member, err := s.repository.GetChatMember(ctx, in.ChatUuid, userUUID)
+	if err != nil {
+		logger.Error(fmt.Sprintf("failed to get chat member: %v", err))
+		return nil, status.Errorf(codes.Internal, "failed to get chat member: %v", err)
+	}
+
+	if member == nil {
+		logger.Error("failed to user is not chat member")
+		return nil, status.Error(codes.PermissionDenied, "failed to user is not chat member")
+	}
+
+	if member.StreamType != model.StreamTypePrivate && member.StreamType != model.StreamTypeGroup {
+		logger.Error(fmt.Sprintf("failed to leave chat of type %s", member.StreamType))
+		return nil, status.Errorf(codes.FailedPrecondition, "failed to leave chat of type %s", member.StreamType)
+	}
+
+	// без владельца в группе некому управлять участниками
+	if member.Role == model.RoleOwner {
+		logger.Error("failed to owner can not leave group chat")
+		return nil, status.Error(codes.FailedPrecondition, "failed to owner can not leave group chat")
+	}
+
+	var notice *model.Message
+	err = s.inTx(ctx, func(ctx context.Context) error {
+		err := s.repository.RemoveChatMember(ctx, in.ChatUuid, userUUID)
+		if err != nil {
+			return status.Errorf(codes.Internal, "failed to leave chat: %v", err)
+		}
+
+		notice, err = s.repository.SendPrivateMessage(ctx, &model.NewMessage{
+			ChatUUID:   in.ChatUuid,
+			SenderUUID: userUUID,
+			Content:    model.SystemMessageMemberLeft,
+			Type:       model.MessageTypeSystem,
+		})
+		if err != nil {
+			return status.Errorf(codes.Internal, "failed to send leave system message: %v", err)
+		}
+
+		return s.addOutboxEvent(ctx, in.ChatUuid, messageSentDomainEvent(notice.FromDTO()))
+	})
+	if err != nil {
+		logger.Error(status.Convert(err).Message())
+		return nil, err
+	}
+
+	s.publishChatEvent(ctx, in.ChatUuid, messageSentEvent(notice.FromDTO()))
+	s.publishChatEvent(ctx, in.ChatUuid, membershipChangedEvent(userUUID, model.MembershipActionLeft), userUUID)
+
+	return &chat.LeaveChatOut{
+		LeaveStatus: true,
+	}, nil
+}
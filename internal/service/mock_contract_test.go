@@ -7,6 +7,7 @@ package service
 import (
 	context "context"
 	reflect "reflect"
+	time "time"
 
 	gomock "github.com/golang/mock/gomock"
 	model "github.com/s21platform/chat-service/internal/model"
@@ -35,6 +36,48 @@ func (m *MockDBRepo) EXPECT() *MockDBRepoMockRecorder {
 	return m.recorder
 }
 
+// AddChatMember mocks base method.
+func (m *MockDBRepo) AddChatMember(ctx context.Context, chatUUID string, member *model.ChatMemberParams, role, invitedBy string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AddChatMember", ctx, chatUUID, member, role, invitedBy)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// AddChatMember indicates an expected call of AddChatMember.
+func (mr *MockDBRepoMockRecorder) AddChatMember(ctx, chatUUID, member, role, invitedBy interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddChatMember", reflect.TypeOf((*MockDBRepo)(nil).AddChatMember), ctx, chatUUID, member, role, invitedBy)
+}
+
+// AddNotificationRequests mocks base method.
+func (m *MockDBRepo) AddNotificationRequests(ctx context.Context, chatUUID, messageUUID, senderUUID string, recipientUUIDs []string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AddNotificationRequests", ctx, chatUUID, messageUUID, senderUUID, recipientUUIDs)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// AddNotificationRequests indicates an expected call of AddNotificationRequests.
+func (mr *MockDBRepoMockRecorder) AddNotificationRequests(ctx, chatUUID, messageUUID, senderUUID, recipientUUIDs interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddNotificationRequests", reflect.TypeOf((*MockDBRepo)(nil).AddNotificationRequests), ctx, chatUUID, messageUUID, senderUUID, recipientUUIDs)
+}
+
+// AddOutboxEvent mocks base method.
+func (m *MockDBRepo) AddOutboxEvent(ctx context.Context, event *model.NewOutboxEvent) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AddOutboxEvent", ctx, event)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// AddOutboxEvent indicates an expected call of AddOutboxEvent.
+func (mr *MockDBRepoMockRecorder) AddOutboxEvent(ctx, event interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddOutboxEvent", reflect.TypeOf((*MockDBRepo)(nil).AddOutboxEvent), ctx, event)
+}
+
 // AddPrivateChatMember mocks base method.
 func (m *MockDBRepo) AddPrivateChatMember(ctx context.Context, chatUUID string, member *model.ChatMemberParams) error {
 	m.ctrl.T.Helper()
@@ -49,34 +92,107 @@ func (mr *MockDBRepoMockRecorder) AddPrivateChatMember(ctx, chatUUID, member int
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddPrivateChatMember", reflect.TypeOf((*MockDBRepo)(nil).AddPrivateChatMember), ctx, chatUUID, member)
 }
 
-// CreatePrivateChat mocks base method.
-func (m *MockDBRepo) CreatePrivateChat(ctx context.Context) (string, error) {
+// AddReaction mocks base method.
+func (m *MockDBRepo) AddReaction(ctx context.Context, messageUUID, userUUID, emoji string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AddReaction", ctx, messageUUID, userUUID, emoji)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// AddReaction indicates an expected call of AddReaction.
+func (mr *MockDBRepoMockRecorder) AddReaction(ctx, messageUUID, userUUID, emoji interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddReaction", reflect.TypeOf((*MockDBRepo)(nil).AddReaction), ctx, messageUUID, userUUID, emoji)
+}
+
+// BanMember mocks base method.
+func (m *MockDBRepo) BanMember(ctx context.Context, chatUUID, userUUID, bannedBy, reason string, duration time.Duration) (*model.MemberBan, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "BanMember", ctx, chatUUID, userUUID, bannedBy, reason, duration)
+	ret0, _ := ret[0].(*model.MemberBan)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// BanMember indicates an expected call of BanMember.
+func (mr *MockDBRepoMockRecorder) BanMember(ctx, chatUUID, userUUID, bannedBy, reason, duration interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "BanMember", reflect.TypeOf((*MockDBRepo)(nil).BanMember), ctx, chatUUID, userUUID, bannedBy, reason, duration)
+}
+
+// CreateChannel mocks base method.
+func (m *MockDBRepo) CreateChannel(ctx context.Context, creator *model.ChatMemberParams, name, avatarURL string) (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateChannel", ctx, creator, name, avatarURL)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateChannel indicates an expected call of CreateChannel.
+func (mr *MockDBRepoMockRecorder) CreateChannel(ctx, creator, name, avatarURL interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateChannel", reflect.TypeOf((*MockDBRepo)(nil).CreateChannel), ctx, creator, name, avatarURL)
+}
+
+// CreateGroupChat mocks base method.
+func (m *MockDBRepo) CreateGroupChat(ctx context.Context, creator *model.ChatMemberParams, name, avatarURL string) (string, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "CreatePrivateChat", ctx)
+	ret := m.ctrl.Call(m, "CreateGroupChat", ctx, creator, name, avatarURL)
 	ret0, _ := ret[0].(string)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// CreatePrivateChat indicates an expected call of CreatePrivateChat.
-func (mr *MockDBRepoMockRecorder) CreatePrivateChat(ctx interface{}) *gomock.Call {
+// CreateGroupChat indicates an expected call of CreateGroupChat.
+func (mr *MockDBRepoMockRecorder) CreateGroupChat(ctx, creator, name, avatarURL interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateGroupChat", reflect.TypeOf((*MockDBRepo)(nil).CreateGroupChat), ctx, creator, name, avatarURL)
+}
+
+// CreateInviteLink mocks base method.
+func (m *MockDBRepo) CreateInviteLink(ctx context.Context, chatUUID, createdBy string, expiresIn time.Duration, usageLimit int32) (*model.InviteLink, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateInviteLink", ctx, chatUUID, createdBy, expiresIn, usageLimit)
+	ret0, _ := ret[0].(*model.InviteLink)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateInviteLink indicates an expected call of CreateInviteLink.
+func (mr *MockDBRepoMockRecorder) CreateInviteLink(ctx, chatUUID, createdBy, expiresIn, usageLimit interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateInviteLink", reflect.TypeOf((*MockDBRepo)(nil).CreateInviteLink), ctx, chatUUID, createdBy, expiresIn, usageLimit)
+}
+
+// DeleteNotificationBatches mocks base method.
+func (m *MockDBRepo) DeleteNotificationBatches(ctx context.Context, batches model.NotificationBatchList) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteNotificationBatches", ctx, batches)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteNotificationBatches indicates an expected call of DeleteNotificationBatches.
+func (mr *MockDBRepoMockRecorder) DeleteNotificationBatches(ctx, batches interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreatePrivateChat", reflect.TypeOf((*MockDBRepo)(nil).CreatePrivateChat), ctx)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteNotificationBatches", reflect.TypeOf((*MockDBRepo)(nil).DeleteNotificationBatches), ctx, batches)
 }
 
 // DeletePrivateMessage mocks base method.
-func (m *MockDBRepo) DeletePrivateMessage(ctx context.Context, userUUID, messageID, mode string) (bool, error) {
+func (m *MockDBRepo) DeletePrivateMessage(ctx context.Context, chatUUID, userUUID, messageID, mode string) (bool, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "DeletePrivateMessage", ctx, userUUID, messageID, mode)
+	ret := m.ctrl.Call(m, "DeletePrivateMessage", ctx, chatUUID, userUUID, messageID, mode)
 	ret0, _ := ret[0].(bool)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // DeletePrivateMessage indicates an expected call of DeletePrivateMessage.
-func (mr *MockDBRepoMockRecorder) DeletePrivateMessage(ctx, userUUID, messageID, mode interface{}) *gomock.Call {
+func (mr *MockDBRepoMockRecorder) DeletePrivateMessage(ctx, chatUUID, userUUID, messageID, mode interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeletePrivateMessage", reflect.TypeOf((*MockDBRepo)(nil).DeletePrivateMessage), ctx, userUUID, messageID, mode)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeletePrivateMessage", reflect.TypeOf((*MockDBRepo)(nil).DeletePrivateMessage), ctx, chatUUID, userUUID, messageID, mode)
 }
 
 // EditPrivateMessage mocks base method.
@@ -94,6 +210,95 @@ func (mr *MockDBRepoMockRecorder) EditPrivateMessage(ctx, messageUUID, newConten
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "EditPrivateMessage", reflect.TypeOf((*MockDBRepo)(nil).EditPrivateMessage), ctx, messageUUID, newContent)
 }
 
+// EnsureChatMember mocks base method.
+func (m *MockDBRepo) EnsureChatMember(ctx context.Context, chatUUID string, member *model.ChatMemberParams, role string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "EnsureChatMember", ctx, chatUUID, member, role)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// EnsureChatMember indicates an expected call of EnsureChatMember.
+func (mr *MockDBRepoMockRecorder) EnsureChatMember(ctx, chatUUID, member, role interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "EnsureChatMember", reflect.TypeOf((*MockDBRepo)(nil).EnsureChatMember), ctx, chatUUID, member, role)
+}
+
+// ForwardMessages mocks base method.
+func (m *MockDBRepo) ForwardMessages(ctx context.Context, sourceChatUUID, targetChatUUID, userUUID string, messageUUIDs []string) (*model.MessageList, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ForwardMessages", ctx, sourceChatUUID, targetChatUUID, userUUID, messageUUIDs)
+	ret0, _ := ret[0].(*model.MessageList)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ForwardMessages indicates an expected call of ForwardMessages.
+func (mr *MockDBRepoMockRecorder) ForwardMessages(ctx, sourceChatUUID, targetChatUUID, userUUID, messageUUIDs interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ForwardMessages", reflect.TypeOf((*MockDBRepo)(nil).ForwardMessages), ctx, sourceChatUUID, targetChatUUID, userUUID, messageUUIDs)
+}
+
+// GetActiveInviteLink mocks base method.
+func (m *MockDBRepo) GetActiveInviteLink(ctx context.Context, code string) (*model.InviteLink, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetActiveInviteLink", ctx, code)
+	ret0, _ := ret[0].(*model.InviteLink)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetActiveInviteLink indicates an expected call of GetActiveInviteLink.
+func (mr *MockDBRepoMockRecorder) GetActiveInviteLink(ctx, code interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetActiveInviteLink", reflect.TypeOf((*MockDBRepo)(nil).GetActiveInviteLink), ctx, code)
+}
+
+// GetChannels mocks base method.
+func (m *MockDBRepo) GetChannels(ctx context.Context, userUUID string) (*model.ChatInfoList, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetChannels", ctx, userUUID)
+	ret0, _ := ret[0].(*model.ChatInfoList)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetChannels indicates an expected call of GetChannels.
+func (mr *MockDBRepoMockRecorder) GetChannels(ctx, userUUID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetChannels", reflect.TypeOf((*MockDBRepo)(nil).GetChannels), ctx, userUUID)
+}
+
+// GetChatMember mocks base method.
+func (m *MockDBRepo) GetChatMember(ctx context.Context, chatUUID, userUUID string) (*model.ChatMember, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetChatMember", ctx, chatUUID, userUUID)
+	ret0, _ := ret[0].(*model.ChatMember)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetChatMember indicates an expected call of GetChatMember.
+func (mr *MockDBRepoMockRecorder) GetChatMember(ctx, chatUUID, userUUID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetChatMember", reflect.TypeOf((*MockDBRepo)(nil).GetChatMember), ctx, chatUUID, userUUID)
+}
+
+// GetChatRecipients mocks base method.
+func (m *MockDBRepo) GetChatRecipients(ctx context.Context, chatUUID string) (*model.EventRecipientList, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetChatRecipients", ctx, chatUUID)
+	ret0, _ := ret[0].(*model.EventRecipientList)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetChatRecipients indicates an expected call of GetChatRecipients.
+func (mr *MockDBRepoMockRecorder) GetChatRecipients(ctx, chatUUID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetChatRecipients", reflect.TypeOf((*MockDBRepo)(nil).GetChatRecipients), ctx, chatUUID)
+}
+
 // GetGroupChats mocks base method.
 func (m *MockDBRepo) GetGroupChats(ctx context.Context, userUUID string) (*model.ChatInfoList, error) {
 	m.ctrl.T.Helper()
@@ -109,6 +314,113 @@ func (mr *MockDBRepoMockRecorder) GetGroupChats(ctx, userUUID interface{}) *gomo
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetGroupChats", reflect.TypeOf((*MockDBRepo)(nil).GetGroupChats), ctx, userUUID)
 }
 
+// GetInviteLinks mocks base method.
+func (m *MockDBRepo) GetInviteLinks(ctx context.Context, chatUUID string) (*model.InviteLinkList, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetInviteLinks", ctx, chatUUID)
+	ret0, _ := ret[0].(*model.InviteLinkList)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetInviteLinks indicates an expected call of GetInviteLinks.
+func (mr *MockDBRepoMockRecorder) GetInviteLinks(ctx, chatUUID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetInviteLinks", reflect.TypeOf((*MockDBRepo)(nil).GetInviteLinks), ctx, chatUUID)
+}
+
+// GetMessageReactions mocks base method.
+func (m *MockDBRepo) GetMessageReactions(ctx context.Context, messageUUID, userUUID string) (model.ReactionList, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetMessageReactions", ctx, messageUUID, userUUID)
+	ret0, _ := ret[0].(model.ReactionList)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetMessageReactions indicates an expected call of GetMessageReactions.
+func (mr *MockDBRepoMockRecorder) GetMessageReactions(ctx, messageUUID, userUUID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetMessageReactions", reflect.TypeOf((*MockDBRepo)(nil).GetMessageReactions), ctx, messageUUID, userUUID)
+}
+
+// GetMessageReaders mocks base method.
+func (m *MockDBRepo) GetMessageReaders(ctx context.Context, chatUUID, messageUUID, userUUID string) (*model.MessageReaderList, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetMessageReaders", ctx, chatUUID, messageUUID, userUUID)
+	ret0, _ := ret[0].(*model.MessageReaderList)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetMessageReaders indicates an expected call of GetMessageReaders.
+func (mr *MockDBRepoMockRecorder) GetMessageReaders(ctx, chatUUID, messageUUID, userUUID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetMessageReaders", reflect.TypeOf((*MockDBRepo)(nil).GetMessageReaders), ctx, chatUUID, messageUUID, userUUID)
+}
+
+// GetMessageRevisions mocks base method.
+func (m *MockDBRepo) GetMessageRevisions(ctx context.Context, chatUUID, messageUUID, userUUID string) (*model.MessageRevisionList, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetMessageRevisions", ctx, chatUUID, messageUUID, userUUID)
+	ret0, _ := ret[0].(*model.MessageRevisionList)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetMessageRevisions indicates an expected call of GetMessageRevisions.
+func (mr *MockDBRepoMockRecorder) GetMessageRevisions(ctx, chatUUID, messageUUID, userUUID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetMessageRevisions", reflect.TypeOf((*MockDBRepo)(nil).GetMessageRevisions), ctx, chatUUID, messageUUID, userUUID)
+}
+
+// GetOrCreateCommentStream mocks base method.
+func (m *MockDBRepo) GetOrCreateCommentStream(ctx context.Context, creator *model.ChatMemberParams, entityType, entityID string) (string, bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetOrCreateCommentStream", ctx, creator, entityType, entityID)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(bool)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// GetOrCreateCommentStream indicates an expected call of GetOrCreateCommentStream.
+func (mr *MockDBRepoMockRecorder) GetOrCreateCommentStream(ctx, creator, entityType, entityID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetOrCreateCommentStream", reflect.TypeOf((*MockDBRepo)(nil).GetOrCreateCommentStream), ctx, creator, entityType, entityID)
+}
+
+// GetOrCreatePrivateChat mocks base method.
+func (m *MockDBRepo) GetOrCreatePrivateChat(ctx context.Context, pairKey string) (string, bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetOrCreatePrivateChat", ctx, pairKey)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(bool)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// GetOrCreatePrivateChat indicates an expected call of GetOrCreatePrivateChat.
+func (mr *MockDBRepoMockRecorder) GetOrCreatePrivateChat(ctx, pairKey interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetOrCreatePrivateChat", reflect.TypeOf((*MockDBRepo)(nil).GetOrCreatePrivateChat), ctx, pairKey)
+}
+
+// GetPinnedMessages mocks base method.
+func (m *MockDBRepo) GetPinnedMessages(ctx context.Context, chatUUID, userUUID string) (*model.PinnedMessageList, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetPinnedMessages", ctx, chatUUID, userUUID)
+	ret0, _ := ret[0].(*model.PinnedMessageList)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetPinnedMessages indicates an expected call of GetPinnedMessages.
+func (mr *MockDBRepoMockRecorder) GetPinnedMessages(ctx, chatUUID, userUUID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPinnedMessages", reflect.TypeOf((*MockDBRepo)(nil).GetPinnedMessages), ctx, chatUUID, userUUID)
+}
+
 // GetPrivateChats mocks base method.
 func (m *MockDBRepo) GetPrivateChats(ctx context.Context, userUUID string) (*model.ChatInfoList, error) {
 	m.ctrl.T.Helper()
@@ -125,33 +437,93 @@ func (mr *MockDBRepoMockRecorder) GetPrivateChats(ctx, userUUID interface{}) *go
 }
 
 // GetPrivateDeletionInfo mocks base method.
-func (m *MockDBRepo) GetPrivateDeletionInfo(ctx context.Context, messageID string) (*model.DeletionInfo, error) {
+func (m *MockDBRepo) GetPrivateDeletionInfo(ctx context.Context, chatUUID, userUUID, messageID string) (*model.DeletionInfo, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetPrivateDeletionInfo", ctx, messageID)
+	ret := m.ctrl.Call(m, "GetPrivateDeletionInfo", ctx, chatUUID, userUUID, messageID)
 	ret0, _ := ret[0].(*model.DeletionInfo)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // GetPrivateDeletionInfo indicates an expected call of GetPrivateDeletionInfo.
-func (mr *MockDBRepoMockRecorder) GetPrivateDeletionInfo(ctx, messageID interface{}) *gomock.Call {
+func (mr *MockDBRepoMockRecorder) GetPrivateDeletionInfo(ctx, chatUUID, userUUID, messageID interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPrivateDeletionInfo", reflect.TypeOf((*MockDBRepo)(nil).GetPrivateDeletionInfo), ctx, messageID)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPrivateDeletionInfo", reflect.TypeOf((*MockDBRepo)(nil).GetPrivateDeletionInfo), ctx, chatUUID, userUUID, messageID)
 }
 
 // GetPrivateRecentMessages mocks base method.
-func (m *MockDBRepo) GetPrivateRecentMessages(ctx context.Context, chatUUID, userUUID string) (*model.MessageList, error) {
+func (m *MockDBRepo) GetPrivateRecentMessages(ctx context.Context, chatUUID, userUUID string, page *model.MessagePage) (*model.MessageList, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetPrivateRecentMessages", ctx, chatUUID, userUUID)
+	ret := m.ctrl.Call(m, "GetPrivateRecentMessages", ctx, chatUUID, userUUID, page)
 	ret0, _ := ret[0].(*model.MessageList)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // GetPrivateRecentMessages indicates an expected call of GetPrivateRecentMessages.
-func (mr *MockDBRepoMockRecorder) GetPrivateRecentMessages(ctx, chatUUID, userUUID interface{}) *gomock.Call {
+func (mr *MockDBRepoMockRecorder) GetPrivateRecentMessages(ctx, chatUUID, userUUID, page interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPrivateRecentMessages", reflect.TypeOf((*MockDBRepo)(nil).GetPrivateRecentMessages), ctx, chatUUID, userUUID, page)
+}
+
+// GetReadyNotificationBatches mocks base method.
+func (m *MockDBRepo) GetReadyNotificationBatches(ctx context.Context, window time.Duration, limit uint64) (*model.NotificationBatchList, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetReadyNotificationBatches", ctx, window, limit)
+	ret0, _ := ret[0].(*model.NotificationBatchList)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetReadyNotificationBatches indicates an expected call of GetReadyNotificationBatches.
+func (mr *MockDBRepoMockRecorder) GetReadyNotificationBatches(ctx, window, limit interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetReadyNotificationBatches", reflect.TypeOf((*MockDBRepo)(nil).GetReadyNotificationBatches), ctx, window, limit)
+}
+
+// GetStreamType mocks base method.
+func (m *MockDBRepo) GetStreamType(ctx context.Context, chatUUID string) (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetStreamType", ctx, chatUUID)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetStreamType indicates an expected call of GetStreamType.
+func (mr *MockDBRepoMockRecorder) GetStreamType(ctx, chatUUID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetStreamType", reflect.TypeOf((*MockDBRepo)(nil).GetStreamType), ctx, chatUUID)
+}
+
+// GetUsersLastOnline mocks base method.
+func (m *MockDBRepo) GetUsersLastOnline(ctx context.Context, userUUIDs []string) (*model.UserPresenceList, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetUsersLastOnline", ctx, userUUIDs)
+	ret0, _ := ret[0].(*model.UserPresenceList)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetUsersLastOnline indicates an expected call of GetUsersLastOnline.
+func (mr *MockDBRepoMockRecorder) GetUsersLastOnline(ctx, userUUIDs interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUsersLastOnline", reflect.TypeOf((*MockDBRepo)(nil).GetUsersLastOnline), ctx, userUUIDs)
+}
+
+// HasChatAccess mocks base method.
+func (m *MockDBRepo) HasChatAccess(ctx context.Context, chatUUID, userUUID string) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "HasChatAccess", ctx, chatUUID, userUUID)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// HasChatAccess indicates an expected call of HasChatAccess.
+func (mr *MockDBRepoMockRecorder) HasChatAccess(ctx, chatUUID, userUUID interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPrivateRecentMessages", reflect.TypeOf((*MockDBRepo)(nil).GetPrivateRecentMessages), ctx, chatUUID, userUUID)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "HasChatAccess", reflect.TypeOf((*MockDBRepo)(nil).HasChatAccess), ctx, chatUUID, userUUID)
 }
 
 // IsChatMember mocks base method.
@@ -169,6 +541,36 @@ func (mr *MockDBRepoMockRecorder) IsChatMember(ctx, chatUUID, userUUID interface
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "IsChatMember", reflect.TypeOf((*MockDBRepo)(nil).IsChatMember), ctx, chatUUID, userUUID)
 }
 
+// IsFormerChatMember mocks base method.
+func (m *MockDBRepo) IsFormerChatMember(ctx context.Context, chatUUID, userUUID string) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "IsFormerChatMember", ctx, chatUUID, userUUID)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// IsFormerChatMember indicates an expected call of IsFormerChatMember.
+func (mr *MockDBRepoMockRecorder) IsFormerChatMember(ctx, chatUUID, userUUID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "IsFormerChatMember", reflect.TypeOf((*MockDBRepo)(nil).IsFormerChatMember), ctx, chatUUID, userUUID)
+}
+
+// IsMemberBanned mocks base method.
+func (m *MockDBRepo) IsMemberBanned(ctx context.Context, chatUUID, userUUID string) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "IsMemberBanned", ctx, chatUUID, userUUID)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// IsMemberBanned indicates an expected call of IsMemberBanned.
+func (mr *MockDBRepoMockRecorder) IsMemberBanned(ctx, chatUUID, userUUID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "IsMemberBanned", reflect.TypeOf((*MockDBRepo)(nil).IsMemberBanned), ctx, chatUUID, userUUID)
+}
+
 // IsMessageOwner mocks base method.
 func (m *MockDBRepo) IsMessageOwner(ctx context.Context, chatUUID, messageUUID, userUUID string) (bool, error) {
 	m.ctrl.T.Helper()
@@ -184,6 +586,254 @@ func (mr *MockDBRepoMockRecorder) IsMessageOwner(ctx, chatUUID, messageUUID, use
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "IsMessageOwner", reflect.TypeOf((*MockDBRepo)(nil).IsMessageOwner), ctx, chatUUID, messageUUID, userUUID)
 }
 
+// JoinByInvite mocks base method.
+func (m *MockDBRepo) JoinByInvite(ctx context.Context, code string, member *model.ChatMemberParams) (*model.InviteJoin, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "JoinByInvite", ctx, code, member)
+	ret0, _ := ret[0].(*model.InviteJoin)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// JoinByInvite indicates an expected call of JoinByInvite.
+func (mr *MockDBRepoMockRecorder) JoinByInvite(ctx, code, member interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "JoinByInvite", reflect.TypeOf((*MockDBRepo)(nil).JoinByInvite), ctx, code, member)
+}
+
+// KickMember mocks base method.
+func (m *MockDBRepo) KickMember(ctx context.Context, chatUUID, userUUID string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "KickMember", ctx, chatUUID, userUUID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// KickMember indicates an expected call of KickMember.
+func (mr *MockDBRepoMockRecorder) KickMember(ctx, chatUUID, userUUID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "KickMember", reflect.TypeOf((*MockDBRepo)(nil).KickMember), ctx, chatUUID, userUUID)
+}
+
+// MarkMessagesRead mocks base method.
+func (m *MockDBRepo) MarkMessagesRead(ctx context.Context, chatUUID, userUUID, upToMessageUUID string) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "MarkMessagesRead", ctx, chatUUID, userUUID, upToMessageUUID)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// MarkMessagesRead indicates an expected call of MarkMessagesRead.
+func (mr *MockDBRepoMockRecorder) MarkMessagesRead(ctx, chatUUID, userUUID, upToMessageUUID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MarkMessagesRead", reflect.TypeOf((*MockDBRepo)(nil).MarkMessagesRead), ctx, chatUUID, userUUID, upToMessageUUID)
+}
+
+// MessageExists mocks base method.
+func (m *MockDBRepo) MessageExists(ctx context.Context, chatUUID, messageUUID, userUUID string) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "MessageExists", ctx, chatUUID, messageUUID, userUUID)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// MessageExists indicates an expected call of MessageExists.
+func (mr *MockDBRepoMockRecorder) MessageExists(ctx, chatUUID, messageUUID, userUUID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MessageExists", reflect.TypeOf((*MockDBRepo)(nil).MessageExists), ctx, chatUUID, messageUUID, userUUID)
+}
+
+// PinMessage mocks base method.
+func (m *MockDBRepo) PinMessage(ctx context.Context, chatUUID, messageUUID, userUUID string) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PinMessage", ctx, chatUUID, messageUUID, userUUID)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// PinMessage indicates an expected call of PinMessage.
+func (mr *MockDBRepoMockRecorder) PinMessage(ctx, chatUUID, messageUUID, userUUID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PinMessage", reflect.TypeOf((*MockDBRepo)(nil).PinMessage), ctx, chatUUID, messageUUID, userUUID)
+}
+
+// RemoveChatMember mocks base method.
+func (m *MockDBRepo) RemoveChatMember(ctx context.Context, chatUUID, userUUID string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RemoveChatMember", ctx, chatUUID, userUUID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RemoveChatMember indicates an expected call of RemoveChatMember.
+func (mr *MockDBRepoMockRecorder) RemoveChatMember(ctx, chatUUID, userUUID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RemoveChatMember", reflect.TypeOf((*MockDBRepo)(nil).RemoveChatMember), ctx, chatUUID, userUUID)
+}
+
+// RemoveReaction mocks base method.
+func (m *MockDBRepo) RemoveReaction(ctx context.Context, messageUUID, userUUID, emoji string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RemoveReaction", ctx, messageUUID, userUUID, emoji)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RemoveReaction indicates an expected call of RemoveReaction.
+func (mr *MockDBRepoMockRecorder) RemoveReaction(ctx, messageUUID, userUUID, emoji interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RemoveReaction", reflect.TypeOf((*MockDBRepo)(nil).RemoveReaction), ctx, messageUUID, userUUID, emoji)
+}
+
+// RevokeInviteLink mocks base method.
+func (m *MockDBRepo) RevokeInviteLink(ctx context.Context, chatUUID, code string) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RevokeInviteLink", ctx, chatUUID, code)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RevokeInviteLink indicates an expected call of RevokeInviteLink.
+func (mr *MockDBRepoMockRecorder) RevokeInviteLink(ctx, chatUUID, code interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RevokeInviteLink", reflect.TypeOf((*MockDBRepo)(nil).RevokeInviteLink), ctx, chatUUID, code)
+}
+
+// SearchMessages mocks base method.
+func (m *MockDBRepo) SearchMessages(ctx context.Context, userUUID, searchQuery, chatUUID string, page *model.MessagePage) (*model.SearchResultList, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SearchMessages", ctx, userUUID, searchQuery, chatUUID, page)
+	ret0, _ := ret[0].(*model.SearchResultList)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SearchMessages indicates an expected call of SearchMessages.
+func (mr *MockDBRepoMockRecorder) SearchMessages(ctx, userUUID, searchQuery, chatUUID, page interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SearchMessages", reflect.TypeOf((*MockDBRepo)(nil).SearchMessages), ctx, userUUID, searchQuery, chatUUID, page)
+}
+
+// SendPrivateMessage mocks base method.
+func (m *MockDBRepo) SendPrivateMessage(ctx context.Context, message *model.NewMessage) (*model.Message, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SendPrivateMessage", ctx, message)
+	ret0, _ := ret[0].(*model.Message)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SendPrivateMessage indicates an expected call of SendPrivateMessage.
+func (mr *MockDBRepoMockRecorder) SendPrivateMessage(ctx, message interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SendPrivateMessage", reflect.TypeOf((*MockDBRepo)(nil).SendPrivateMessage), ctx, message)
+}
+
+// SetChatNotifications mocks base method.
+func (m *MockDBRepo) SetChatNotifications(ctx context.Context, chatUUID, userUUID string, settings *model.ChatNotifications) (*model.ChatNotifications, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetChatNotifications", ctx, chatUUID, userUUID, settings)
+	ret0, _ := ret[0].(*model.ChatNotifications)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SetChatNotifications indicates an expected call of SetChatNotifications.
+func (mr *MockDBRepoMockRecorder) SetChatNotifications(ctx, chatUUID, userUUID, settings interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetChatNotifications", reflect.TypeOf((*MockDBRepo)(nil).SetChatNotifications), ctx, chatUUID, userUUID, settings)
+}
+
+// SubscribeChannel mocks base method.
+func (m *MockDBRepo) SubscribeChannel(ctx context.Context, chatUUID string, subscriber *model.ChatMemberParams) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SubscribeChannel", ctx, chatUUID, subscriber)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SubscribeChannel indicates an expected call of SubscribeChannel.
+func (mr *MockDBRepoMockRecorder) SubscribeChannel(ctx, chatUUID, subscriber interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SubscribeChannel", reflect.TypeOf((*MockDBRepo)(nil).SubscribeChannel), ctx, chatUUID, subscriber)
+}
+
+// UnbanMember mocks base method.
+func (m *MockDBRepo) UnbanMember(ctx context.Context, chatUUID, userUUID string) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UnbanMember", ctx, chatUUID, userUUID)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UnbanMember indicates an expected call of UnbanMember.
+func (mr *MockDBRepoMockRecorder) UnbanMember(ctx, chatUUID, userUUID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UnbanMember", reflect.TypeOf((*MockDBRepo)(nil).UnbanMember), ctx, chatUUID, userUUID)
+}
+
+// UnpinMessage mocks base method.
+func (m *MockDBRepo) UnpinMessage(ctx context.Context, chatUUID, messageUUID string) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UnpinMessage", ctx, chatUUID, messageUUID)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UnpinMessage indicates an expected call of UnpinMessage.
+func (mr *MockDBRepoMockRecorder) UnpinMessage(ctx, chatUUID, messageUUID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UnpinMessage", reflect.TypeOf((*MockDBRepo)(nil).UnpinMessage), ctx, chatUUID, messageUUID)
+}
+
+// UnsubscribeChannel mocks base method.
+func (m *MockDBRepo) UnsubscribeChannel(ctx context.Context, chatUUID, userUUID string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UnsubscribeChannel", ctx, chatUUID, userUUID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UnsubscribeChannel indicates an expected call of UnsubscribeChannel.
+func (mr *MockDBRepoMockRecorder) UnsubscribeChannel(ctx, chatUUID, userUUID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UnsubscribeChannel", reflect.TypeOf((*MockDBRepo)(nil).UnsubscribeChannel), ctx, chatUUID, userUUID)
+}
+
+// UpdateUsersLastOnline mocks base method.
+func (m *MockDBRepo) UpdateUsersLastOnline(ctx context.Context, lastOnline map[string]time.Time) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateUsersLastOnline", ctx, lastOnline)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateUsersLastOnline indicates an expected call of UpdateUsersLastOnline.
+func (mr *MockDBRepoMockRecorder) UpdateUsersLastOnline(ctx, lastOnline interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateUsersLastOnline", reflect.TypeOf((*MockDBRepo)(nil).UpdateUsersLastOnline), ctx, lastOnline)
+}
+
+// WithTx mocks base method.
+func (m *MockDBRepo) WithTx(ctx context.Context, fn func(context.Context) error) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "WithTx", ctx, fn)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// WithTx indicates an expected call of WithTx.
+func (mr *MockDBRepoMockRecorder) WithTx(ctx, fn interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "WithTx", reflect.TypeOf((*MockDBRepo)(nil).WithTx), ctx, fn)
+}
+
 // MockUserClient is a mock of UserClient interface.
 type MockUserClient struct {
 	ctrl     *gomock.Controller
@@ -221,3 +871,40 @@ func (mr *MockUserClientMockRecorder) GetUserInfoByUUID(ctx, userUUID interface{
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUserInfoByUUID", reflect.TypeOf((*MockUserClient)(nil).GetUserInfoByUUID), ctx, userUUID)
 }
+
+// MockNotificationProducer is a mock of NotificationProducer interface.
+type MockNotificationProducer struct {
+	ctrl     *gomock.Controller
+	recorder *MockNotificationProducerMockRecorder
+}
+
+// MockNotificationProducerMockRecorder is the mock recorder for MockNotificationProducer.
+type MockNotificationProducerMockRecorder struct {
+	mock *MockNotificationProducer
+}
+
+// NewMockNotificationProducer creates a new mock instance.
+func NewMockNotificationProducer(ctrl *gomock.Controller) *MockNotificationProducer {
+	mock := &MockNotificationProducer{ctrl: ctrl}
+	mock.recorder = &MockNotificationProducerMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockNotificationProducer) EXPECT() *MockNotificationProducerMockRecorder {
+	return m.recorder
+}
+
+// ProduceMessage mocks base method.
+func (m *MockNotificationProducer) ProduceMessage(ctx context.Context, message, key any) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ProduceMessage", ctx, message, key)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ProduceMessage indicates an expected call of ProduceMessage.
+func (mr *MockNotificationProducerMockRecorder) ProduceMessage(ctx, message, key interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ProduceMessage", reflect.TypeOf((*MockNotificationProducer)(nil).ProduceMessage), ctx, message, key)
+}
@@ -0,0 +1,205 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"time"
+	"unicode/utf8"
+
+	"github.com/google/uuid"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	logger_lib "github.com/s21platform/logger-lib"
+
+	"github.com/s21platform/chat-service/internal/config"
+	"github.com/s21platform/chat-service/internal/model"
+	"github.com/s21platform/chat-service/pkg/chat"
+)
+
+const (
+	maxBanReasonLength = 512
+	maxBanDuration     = 10 * 365 * 24 * time.Hour
+)
+
+func (s *Server) BanMember(ctx context.Context, in *chat.BanMemberIn) (*chat.BanMemberOut, error) {
+	logger := logger_lib.FromContext(ctx, config.KeyLogger)
+	logger.AddFuncName("BanMember")
+
+	userUUID, ok := ctx.Value(config.KeyUUID).(string)
+	if !ok {
+		logger.Error("failed to find uuid")
+		return nil, status.Error(codes.Internal, "failed to find uuid")
+	}
+
+	if utf8.RuneCountInString(in.Reason) > maxBanReasonLength {
+		logger.Error("failed to ban member: reason is too long")
+		return nil, status.Errorf(codes.InvalidArgument, "failed to ban member: reason must be at most %d characters", maxBanReasonLength)
+	}
+
+	duration := time.Duration(in.DurationSeconds) * time.Second
+	if in.DurationSeconds < 0 || duration > maxBanDuration {
+		logger.Error("failed to ban member: invalid duration")
+		return nil, status.Errorf(codes.InvalidArgument, "failed to ban member: duration must be between 0 and %d seconds", int64(maxBanDuration/time.Second))
+	}
+
+	err := s.checkMemberModeration(ctx, in.ChatUuid, userUUID, in.MemberUuid, true)
+	if err != nil {
+		logger.Error(status.Convert(err).Message())
+		return nil, err
+	}
+
+	ban, err := s.repository.BanMember(ctx, in.ChatUuid, in.MemberUuid, userUUID, in.Reason, duration)
+	if err != nil {
+		logger.Error(fmt.Sprintf("failed to ban member: %v", err))
+		return nil, status.Errorf(codes.Internal, "failed to ban member: %v", err)
+	}
+
+	s.publishChatEvent(ctx, in.ChatUuid, membershipChangedEvent(in.MemberUuid, model.MembershipActionBanned), in.MemberUuid)
+
+	out := &chat.BanMemberOut{
+		BannedAt: ban.BannedAt.Format(time.RFC3339),
+	}
+	if ban.BanUntil != nil {
+		out.BanUntil = ban.BanUntil.Format(time.RFC3339)
+	}
+
+	return out, nil
+}
+
+func (s *Server) UnbanMember(ctx context.Context, in *chat.UnbanMemberIn) (*chat.UnbanMemberOut, error) {
+	logger := logger_lib.FromContext(ctx, config.KeyLogger)
+	logger.AddFuncName("UnbanMember")
+
+	userUUID, ok := ctx.Value(config.KeyUUID).(string)
+	if !ok {
+		logger.Error("failed to find uuid")
+		return nil, status.Error(codes.Internal, "failed to find uuid")
+	}
+
+	_, err := s.checkModerator(ctx, in.ChatUuid, userUUID)
+	if err != nil {
+		logger.Error(status.Convert(err).Message())
+		return nil, err
+	}
+
+	unbanned, err := s.repository.UnbanMember(ctx, in.ChatUuid, in.MemberUuid)
+	if err != nil {
+		logger.Error(fmt.Sprintf("failed to unban member: %v", err))
+		return nil, status.Errorf(codes.Internal, "failed to unban member: %v", err)
+	}
+
+	if !unbanned {
+		logger.Error("failed to member is not banned")
+		return nil, status.Error(codes.NotFound, "failed to member is not banned")
+	}
+
+	s.publishChatEvent(ctx, in.ChatUuid, membershipChangedEvent(in.MemberUuid, model.MembershipActionUnbanned))
+
+	return &chat.UnbanMemberOut{
+		UnbanStatus: true,
+	}, nil
+}
+
+func (s *Server) KickMember(ctx context.Context, in *chat.KickMemberIn) (*chat.KickMemberOut, error) {
+	logger := logger_lib.FromContext(ctx, config.KeyLogger)
+	logger.AddFuncName("KickMember")
+
+	userUUID, ok := ctx.Value(config.KeyUUID).(string)
+	if !ok {
+		logger.Error("failed to find uuid")
+		return nil, status.Error(codes.Internal, "failed to find uuid")
+	}
+
+	err := s.checkMemberModeration(ctx, in.ChatUuid, userUUID, in.MemberUuid, false)
+	if err != nil {
+		logger.Error(status.Convert(err).Message())
+		return nil, err
+	}
+
+	err = s.repository.KickMember(ctx, in.ChatUuid, in.MemberUuid)
+	if err != nil {
+		logger.Error(fmt.Sprintf("failed to kick member: %v", err))
+		return nil, status.Errorf(codes.Internal, "failed to kick member: %v", err)
+	}
+
+	s.publishChatEvent(ctx, in.ChatUuid, membershipChangedEvent(in.MemberUuid, model.MembershipActionKicked), in.MemberUuid)
+
+	return &chat.KickMemberOut{
+		KickStatus: true,
+	}, nil
+}
+
+// checkModerator проверяет, что пользователь модерирует групповой чат или канал
+func (s *Server) checkModerator(ctx context.Context, chatUUID, userUUID string) (*model.ChatMember, error) {
+	member, err := s.repository.GetChatMember(ctx, chatUUID, userUUID)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get chat member: %v", err)
+	}
+
+	if member == nil || (member.StreamType != model.StreamTypeGroup && member.StreamType != model.StreamTypeChannel) {
+		return nil, status.Error(codes.PermissionDenied, "failed to user is not group chat or channel member")
+	}
+
+	if !member.CanModerate() {
+		return nil, status.Error(codes.PermissionDenied, "failed to user can not moderate chat members")
+	}
+
+	return member, nil
+}
+
+// checkMemberModeration проверяет, что модератор может применить меру к участнику или подписчику канала.
+// С allowFormer мера применяется и к вышедшему участнику: бан не дает ему вернуться по приглашению
+func (s *Server) checkMemberModeration(ctx context.Context, chatUUID, moderatorUUID, targetUUID string, allowFormer bool) error {
+	if _, err := uuid.Parse(targetUUID); err != nil {
+		return status.Errorf(codes.InvalidArgument, "failed to parse member uuid: %v", err)
+	}
+
+	if targetUUID == moderatorUUID {
+		return status.Error(codes.InvalidArgument, "failed to moderate yourself")
+	}
+
+	moderator, err := s.checkModerator(ctx, chatUUID, moderatorUUID)
+	if err != nil {
+		return err
+	}
+
+	target, err := s.repository.GetChatMember(ctx, chatUUID, targetUUID)
+	if err != nil {
+		return status.Errorf(codes.Internal, "failed to get chat member: %v", err)
+	}
+
+	// подписчик канала не состоит в stream_members и не имеет роли
+	if target == nil && moderator.StreamType == model.StreamTypeChannel {
+		isSubscriber, err := s.repository.HasChatAccess(ctx, chatUUID, targetUUID)
+		if err != nil {
+			return status.Errorf(codes.Internal, "failed to check chat access: %v", err)
+		}
+
+		if isSubscriber {
+			target = &model.ChatMember{StreamType: model.StreamTypeChannel}
+		}
+	}
+
+	// вышедший участник больше не имеет роли, бан сохранится в его строке stream_members
+	if target == nil && allowFormer {
+		isFormer, err := s.repository.IsFormerChatMember(ctx, chatUUID, targetUUID)
+		if err != nil {
+			return status.Errorf(codes.Internal, "failed to check former chat member: %v", err)
+		}
+
+		if isFormer {
+			target = &model.ChatMember{StreamType: moderator.StreamType, Role: model.RoleMember}
+		}
+	}
+
+	if target == nil {
+		return status.Error(codes.NotFound, "failed to member is not in chat")
+	}
+
+	if !moderator.CanModerateMember(target) {
+		return status.Error(codes.PermissionDenied, "failed to moderate member with the same or higher role")
+	}
+
+	return nil
+}
@@ -0,0 +1,85 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	logger_lib "github.com/s21platform/logger-lib"
+
+	"github.com/s21platform/chat-service/internal/config"
+	"github.com/s21platform/chat-service/internal/model"
+	"github.com/s21platform/chat-service/pkg/chat"
+)
+
+func (s *Server) SetChatNotifications(ctx context.Context, in *chat.SetChatNotificationsIn) (*chat.SetChatNotificationsOut, error) {
+	logger := logger_lib.FromContext(ctx, config.KeyLogger)
+	logger.AddFuncName("SetChatNotifications")
+
+	userUUID, ok := ctx.Value(config.KeyUUID).(string)
+	if !ok {
+		logger.Error("failed to find uuid")
+		return nil, status.Error(codes.Internal, "failed to find uuid")
+	}
+
+	settings, err := parseChatNotifications(in)
+	if err != nil {
+		logger.Error(status.Convert(err).Message())
+		return nil, err
+	}
+
+	err = s.checkChatAccess(ctx, in.ChatUuid, userUUID)
+	if err != nil {
+		logger.Error(status.Convert(err).Message())
+		return nil, err
+	}
+
+	notifications, err := s.repository.SetChatNotifications(ctx, in.ChatUuid, userUUID, settings)
+	if err != nil {
+		logger.Error(fmt.Sprintf("failed to set chat notifications: %v", err))
+		return nil, status.Errorf(codes.Internal, "failed to set chat notifications: %v", err)
+	}
+
+	out := &chat.SetChatNotificationsOut{
+		Muted:        notifications.Muted,
+		MentionsOnly: notifications.MentionsOnly,
+	}
+	if notifications.MutedUntil != nil {
+		out.MutedUntil = notifications.MutedUntil.Format(time.RFC3339)
+	}
+
+	return out, nil
+}
+
+// parseChatNotifications проверяет запрос; включение уведомлений сбрасывает срок и режим упоминаний
+func parseChatNotifications(in *chat.SetChatNotificationsIn) (*model.ChatNotifications, error) {
+	if !in.Muted {
+		return &model.ChatNotifications{}, nil
+	}
+
+	settings := &model.ChatNotifications{
+		Muted:        true,
+		MentionsOnly: in.MentionsOnly,
+	}
+
+	if in.MutedUntil == "" {
+		return settings, nil
+	}
+
+	mutedUntil, err := time.Parse(time.RFC3339, in.MutedUntil)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "failed to parse muted_until: %v", err)
+	}
+
+	if !mutedUntil.After(time.Now()) {
+		return nil, status.Error(codes.InvalidArgument, "failed to mute chat: muted_until must be in the future")
+	}
+
+	mutedUntil = mutedUntil.UTC()
+	settings.MutedUntil = &mutedUntil
+
+	return settings, nil
+}
@@ -0,0 +1,99 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	logger_lib "github.com/s21platform/logger-lib"
+
+	"github.com/s21platform/chat-service/internal/config"
+	"github.com/s21platform/chat-service/internal/model"
+	"github.com/s21platform/chat-service/pkg/chat"
+)
+
+const (
+	// сообщения одного чата за это время с первого из них уходят получателю одним уведомлением
+	notificationBatchWindow = 30 * time.Second
+	// как часто готовые группы отправляются в kafka
+	notificationFlushInterval = 5 * time.Second
+
+	notificationBatchSize = 100
+)
+
+// queueOfflineNotifications добавляет сообщение в группы уведомлений получателей, которые не в сети.
+// Ошибка только логируется: сообщение уже сохранено, а уведомление не должно ломать отправку
+func (s *Server) queueOfflineNotifications(ctx context.Context, chatUUID string, message *chat.Message, recipients []model.EventRecipient) {
+	offline := make([]string, 0, len(recipients))
+	for _, recipient := range recipients {
+		if !model.IsOnline(recipient.LastOnline, presenceTimeout) {
+			offline = append(offline, recipient.UserUUID)
+		}
+	}
+
+	if len(offline) == 0 {
+		return
+	}
+
+	err := s.repository.AddNotificationRequests(ctx, chatUUID, message.MessageUuid, message.Uuid, offline)
+	if err != nil {
+		logger := logger_lib.FromContext(ctx, config.KeyLogger)
+		logger.Error(fmt.Sprintf("failed to add notification requests: %v", err))
+	}
+}
+
+// RunNotificationSender периодически отправляет в kafka группы уведомлений, окно которых истекло.
+// Работает до отмены ctx, логгер берется из ctx.
+func (s *Server) RunNotificationSender(ctx context.Context, producer NotificationProducer) {
+	ticker := time.NewTicker(notificationFlushInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			s.sendNotifications(ctx, producer)
+		}
+	}
+}
+
+// sendNotifications удаляет из базы только отправленные группы, поэтому доставка at-least-once:
+// после сбоя неотправленные группы уйдут при следующем проходе
+func (s *Server) sendNotifications(ctx context.Context, producer NotificationProducer) {
+	logger := logger_lib.FromContext(ctx, config.KeyLogger)
+	logger.AddFuncName("sendNotifications")
+
+	var produceErr error
+	err := s.repository.WithTx(ctx, func(ctx context.Context) error {
+		batches, err := s.repository.GetReadyNotificationBatches(ctx, notificationBatchWindow, notificationBatchSize)
+		if err != nil {
+			return fmt.Errorf("failed to get notification batches: %v", err)
+		}
+
+		sent := make(model.NotificationBatchList, 0, len(*batches))
+		for _, batch := range *batches {
+			err = producer.ProduceMessage(ctx, batch.FromDTO(), batch.RecipientUUID)
+			if err != nil {
+				produceErr = fmt.Errorf("failed to produce notification request: %v", err)
+				break
+			}
+			sent = append(sent, batch)
+		}
+
+		err = s.repository.DeleteNotificationBatches(ctx, sent)
+		if err != nil {
+			return fmt.Errorf("failed to delete notification batches: %v", err)
+		}
+
+		return nil
+	})
+	if err != nil {
+		logger.Error(err.Error())
+		return
+	}
+
+	if produceErr != nil {
+		logger.Error(produceErr.Error())
+	}
+}
@@ -0,0 +1,39 @@
+package service
+
+import (
+	"context"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/s21platform/chat-service/internal/outbox"
+	"github.com/s21platform/chat-service/pkg/chat"
+)
+
+// addOutboxEvent сохраняет доменное событие для публикации в kafka.
+// Вызывается внутри inTx вместе с изменением, которое описывает событие
+func (s *Server) addOutboxEvent(ctx context.Context, chatUUID string, event *chat.ChatDomainEvent) error {
+	outboxEvent, err := outbox.NewEvent(chatUUID, event)
+	if err != nil {
+		return status.Errorf(codes.Internal, "failed to marshal outbox event: %v", err)
+	}
+
+	err = s.repository.AddOutboxEvent(ctx, outboxEvent)
+	if err != nil {
+		return status.Errorf(codes.Internal, "failed to add outbox event: %v", err)
+	}
+
+	return nil
+}
+
+func messageSentDomainEvent(message *chat.Message) *chat.ChatDomainEvent {
+	return &chat.ChatDomainEvent{Payload: &chat.ChatDomainEvent_MessageSent{MessageSent: message}}
+}
+
+func chatCreatedDomainEvent(chatType, createdBy string, memberUUIDs []string) *chat.ChatDomainEvent {
+	return &chat.ChatDomainEvent{Payload: &chat.ChatDomainEvent_ChatCreated{ChatCreated: &chat.ChatCreated{
+		ChatType:    chatType,
+		CreatedBy:   createdBy,
+		MemberUuids: memberUUIDs,
+	}}}
+}
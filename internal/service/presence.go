@@ -0,0 +1,93 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/emptypb"
+
+	logger_lib "github.com/s21platform/logger-lib"
+
+	"github.com/s21platform/chat-service/internal/config"
+	"github.com/s21platform/chat-service/internal/model"
+	"github.com/s21platform/chat-service/pkg/chat"
+)
+
+const (
+	// пользователь становится офлайн, если клиент не присылал heartbeat это время
+	presenceTimeout = 60 * time.Second
+
+	maxPresenceUsers = 100
+)
+
+func (s *Server) Heartbeat(ctx context.Context, _ *emptypb.Empty) (*chat.HeartbeatOut, error) {
+	logger := logger_lib.FromContext(ctx, config.KeyLogger)
+	logger.AddFuncName("Heartbeat")
+
+	userUUID, ok := ctx.Value(config.KeyUUID).(string)
+	if !ok {
+		logger.Error("failed to find uuid")
+		return nil, status.Error(codes.Internal, "failed to find uuid")
+	}
+
+	// время heartbeat хранится в users.last_online, поэтому онлайн-статус одинаков для всех экземпляров сервиса
+	err := s.repository.UpdateUsersLastOnline(ctx, map[string]time.Time{userUUID: time.Now().UTC()})
+	if err != nil {
+		logger.Error(fmt.Sprintf("failed to update user last online: %v", err))
+		return nil, status.Errorf(codes.Internal, "failed to update user last online: %v", err)
+	}
+
+	return &chat.HeartbeatOut{
+		TimeoutSeconds: int64(presenceTimeout / time.Second),
+	}, nil
+}
+
+func (s *Server) GetPresence(ctx context.Context, in *chat.GetPresenceIn) (*chat.GetPresenceOut, error) {
+	logger := logger_lib.FromContext(ctx, config.KeyLogger)
+	logger.AddFuncName("GetPresence")
+
+	if len(in.UserUuids) == 0 || len(in.UserUuids) > maxPresenceUsers {
+		logger.Error(fmt.Sprintf("failed to get presence: user_uuids must contain from 1 to %d uuids", maxPresenceUsers))
+		return nil, status.Errorf(codes.InvalidArgument, "failed to get presence: user_uuids must contain from 1 to %d uuids", maxPresenceUsers)
+	}
+
+	userUUIDs, err := uniqueUUIDs(in.UserUuids, "")
+	if err != nil {
+		logger.Error(fmt.Sprintf("failed to parse user uuids: %v", err))
+		return nil, status.Errorf(codes.InvalidArgument, "failed to parse user uuids: %v", err)
+	}
+
+	stored, err := s.repository.GetUsersLastOnline(ctx, userUUIDs)
+	if err != nil {
+		logger.Error(fmt.Sprintf("failed to get users last online: %v", err))
+		return nil, status.Errorf(codes.Internal, "failed to get users last online: %v", err)
+	}
+
+	lastOnline := make(map[string]*time.Time, len(*stored))
+	for _, presence := range *stored {
+		lastOnline[presence.UserUUID] = presence.LastOnline
+	}
+
+	presences := make(model.UserPresenceList, 0, len(in.UserUuids))
+	for _, userUUID := range in.UserUuids {
+		presences = append(presences, model.UserPresence{
+			UserUUID:   userUUID,
+			LastOnline: lastOnline[userUUID],
+			Online:     model.IsOnline(lastOnline[userUUID], presenceTimeout),
+		})
+	}
+
+	return &chat.GetPresenceOut{
+		Presences: presences.FromDTO(),
+	}, nil
+}
+
+// applyCompanionPresence дополняет личные чаты онлайн-статусом собеседника по времени его последнего heartbeat
+func (s *Server) applyCompanionPresence(chats model.ChatInfoList) {
+	for i := range chats {
+		chats[i].CompanionOnline = model.IsOnline(chats[i].CompanionLastOnline, presenceTimeout)
+	}
+}
@@ -2,9 +2,15 @@ package service
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"regexp"
+	"strings"
 	"time"
+	"unicode"
+	"unicode/utf8"
 
+	"github.com/google/uuid"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 	"google.golang.org/protobuf/types/known/emptypb"
@@ -13,19 +19,40 @@ import (
 
 	"github.com/s21platform/chat-service/internal/config"
 	"github.com/s21platform/chat-service/internal/model"
+	"github.com/s21platform/chat-service/internal/pkg/broker"
+	"github.com/s21platform/chat-service/internal/pkg/typing"
 	"github.com/s21platform/chat-service/pkg/chat"
 )
 
+const (
+	defaultMessagesPageSize = 15
+	maxMessagesPageSize     = 100
+
+	maxEntityIDLength = 128
+
+	maxEmojiLength = 16
+
+	maxForwardMessages = 100
+
+	maxSearchQueryLength = 256
+)
+
+var entityTypePattern = regexp.MustCompile(`^[a-z][a-z0-9_]{0,63}$`)
+
 type Server struct {
 	chat.UnimplementedChatServiceServer
 	repository DBRepo
 	userClient UserClient
+	events     *broker.Broker[*chat.Event]
+	typing     *typing.Tracker
 }
 
 func New(repo DBRepo, userClient UserClient) *Server {
 	return &Server{
 		repository: repo,
 		userClient: userClient,
+		events:     broker.New[*chat.Event](eventsBufferSize),
+		typing:     typing.New(typingTTL, typingThrottle, typingBufferSize),
 	}
 }
 
@@ -39,6 +66,17 @@ func (s *Server) CreatePrivateChat(ctx context.Context, in *chat.CreatePrivateCh
 		return nil, status.Error(codes.Internal, "failed to get initiatorID")
 	}
 
+	companionUUID, err := uuid.Parse(in.CompanionUuid)
+	if err != nil {
+		logger.Error(fmt.Sprintf("failed to parse companion uuid: %v", err))
+		return nil, status.Errorf(codes.InvalidArgument, "failed to parse companion uuid: %v", err)
+	}
+
+	if companionUUID.String() == initiatorID {
+		logger.Error("failed to create private chat with yourself")
+		return nil, status.Error(codes.InvalidArgument, "failed to create private chat with yourself")
+	}
+
 	initiatorSetup, err := s.userClient.GetUserInfoByUUID(ctx, initiatorID)
 	if err != nil {
 		logger.Error(fmt.Sprintf("failed to get initiator info: %v", err))
@@ -63,26 +101,41 @@ func (s *Server) CreatePrivateChat(ctx context.Context, in *chat.CreatePrivateCh
 		AvatarLink: companionSetup.AvatarLink,
 	}
 
-	chatUUID, err := s.repository.CreatePrivateChat(ctx)
-	if err != nil {
-		logger.Error(fmt.Sprintf("failed to create chat: %v", err))
-		return nil, status.Errorf(codes.Internal, "failed to create chat %v", err)
-	}
+	var (
+		chatUUID string
+		created  bool
+	)
+	err = s.inTx(ctx, func(ctx context.Context) error {
+		var err error
+		chatUUID, created, err = s.repository.GetOrCreatePrivateChat(ctx, model.PrivatePairKey(initiatorID, companionUUID.String()))
+		if err != nil {
+			return status.Errorf(codes.Internal, "failed to create chat: %v", err)
+		}
 
-	err = s.repository.AddPrivateChatMember(ctx, chatUUID, initiatorParams)
-	if err != nil {
-		logger.Error(fmt.Sprintf("failed to add initiator to private chat: %v", err))
-		return nil, status.Errorf(codes.Internal, "failed to add initiator to private chat: %v", err)
-	}
+		err = s.repository.AddPrivateChatMember(ctx, chatUUID, initiatorParams)
+		if err != nil {
+			return status.Errorf(codes.Internal, "failed to add initiator to private chat: %v", err)
+		}
 
-	err = s.repository.AddPrivateChatMember(ctx, chatUUID, companionParams)
+		err = s.repository.AddPrivateChatMember(ctx, chatUUID, companionParams)
+		if err != nil {
+			return status.Errorf(codes.Internal, "failed to add companion to private chat: %v", err)
+		}
+
+		if !created {
+			return nil
+		}
+
+		return s.addOutboxEvent(ctx, chatUUID, chatCreatedDomainEvent(model.StreamTypePrivate, initiatorID, []string{initiatorID, in.CompanionUuid}))
+	})
 	if err != nil {
-		logger.Error(fmt.Sprintf("failed to add companion to private chat: %v", err))
-		return nil, status.Errorf(codes.Internal, "failed to add companion to private chat: %v", err)
+		logger.Error(status.Convert(err).Message())
+		return nil, err
 	}
 
 	return &chat.CreatePrivateChatOut{
 		NewChatUuid: chatUUID,
+		Created:     created,
 	}, nil
 }
 
@@ -101,6 +154,7 @@ func (s *Server) GetChats(ctx context.Context, _ *emptypb.Empty) (*chat.GetChats
 		logger.Error(fmt.Sprintf("failed to get private chats: %v", err))
 		return nil, status.Errorf(codes.Internal, "failed to get private chats: %v", err)
 	}
+	s.applyCompanionPresence(*privateChats)
 
 	groupChats, err := s.repository.GetGroupChats(ctx, userUUID)
 	if err != nil {
@@ -108,16 +162,96 @@ func (s *Server) GetChats(ctx context.Context, _ *emptypb.Empty) (*chat.GetChats
 		return nil, status.Errorf(codes.Internal, "failed to get group chats: %v", err)
 	}
 
+	channels, err := s.repository.GetChannels(ctx, userUUID)
+	if err != nil {
+		logger.Error(fmt.Sprintf("failed to get channels: %v", err))
+		return nil, status.Errorf(codes.Internal, "failed to get channels: %v", err)
+	}
+
 	allChats := append(*privateChats, *groupChats...)
+	allChats = append(allChats, *channels...)
 
 	return &chat.GetChatsOut{
 		Chats: allChats.FromDTO(),
 	}, nil
 }
 
-func (s *Server) GetPrivateRecentMessages(ctx context.Context, in *chat.GetPrivateRecentMessagesIn) (*chat.GetPrivateRecentMessagesOut, error) {
+func (s *Server) CreateGroupChat(ctx context.Context, in *chat.CreateGroupChatIn) (*chat.CreateGroupChatOut, error) {
 	logger := logger_lib.FromContext(ctx, config.KeyLogger)
-	logger.AddFuncName("GetRecentMessages")
+	logger.AddFuncName("CreateGroupChat")
+
+	initiatorID, ok := ctx.Value(config.KeyUUID).(string)
+	if !ok {
+		logger.Error("failed to get initiatorID")
+		return nil, status.Error(codes.Internal, "failed to get initiatorID")
+	}
+
+	if strings.TrimSpace(in.Name) == "" {
+		logger.Error("failed to create group chat without name")
+		return nil, status.Error(codes.InvalidArgument, "failed to create group chat without name")
+	}
+
+	memberUUIDs, err := uniqueUUIDs(in.MemberUuids, initiatorID)
+	if err != nil {
+		logger.Error(fmt.Sprintf("failed to parse member uuids: %v", err))
+		return nil, status.Errorf(codes.InvalidArgument, "failed to parse member uuids: %v", err)
+	}
+
+	initiatorParams, err := s.getMemberParams(ctx, initiatorID)
+	if err != nil {
+		logger.Error(fmt.Sprintf("failed to get initiator info: %v", err))
+		return nil, status.Errorf(codes.Internal, "failed to get initiator info: %v", err)
+	}
+
+	membersParams := make([]*model.ChatMemberParams, 0, len(memberUUIDs))
+	for _, memberUUID := range memberUUIDs {
+		memberParams, err := s.getMemberParams(ctx, memberUUID)
+		if err != nil {
+			logger.Error(fmt.Sprintf("failed to get member info: %v", err))
+			return nil, status.Errorf(codes.Internal, "failed to get member info: %v", err)
+		}
+		membersParams = append(membersParams, memberParams)
+	}
+
+	var chatUUID string
+	err = s.inTx(ctx, func(ctx context.Context) error {
+		var err error
+		chatUUID, err = s.repository.CreateGroupChat(ctx, initiatorParams, in.Name, in.AvatarUrl)
+		if err != nil {
+			return status.Errorf(codes.Internal, "failed to create group chat: %v", err)
+		}
+
+		err = s.repository.AddChatMember(ctx, chatUUID, initiatorParams, model.RoleOwner, "")
+		if err != nil {
+			return status.Errorf(codes.Internal, "failed to add owner to group chat: %v", err)
+		}
+
+		for _, memberParams := range membersParams {
+			err = s.repository.AddChatMember(ctx, chatUUID, memberParams, model.RoleMember, initiatorID)
+			if err != nil {
+				return status.Errorf(codes.Internal, "failed to add member to group chat: %v", err)
+			}
+		}
+
+		return s.addOutboxEvent(ctx, chatUUID, chatCreatedDomainEvent(model.StreamTypeGroup, initiatorID, append([]string{initiatorID}, memberUUIDs...)))
+	})
+	if err != nil {
+		logger.Error(status.Convert(err).Message())
+		return nil, err
+	}
+
+	for _, memberUUID := range memberUUIDs {
+		s.publishChatEvent(ctx, chatUUID, membershipChangedEvent(memberUUID, model.MembershipActionAdded))
+	}
+
+	return &chat.CreateGroupChatOut{
+		NewChatUuid: chatUUID,
+	}, nil
+}
+
+func (s *Server) AddGroupMembers(ctx context.Context, in *chat.AddGroupMembersIn) (*chat.AddGroupMembersOut, error) {
+	logger := logger_lib.FromContext(ctx, config.KeyLogger)
+	logger.AddFuncName("AddGroupMembers")
 
 	userUUID, ok := ctx.Value(config.KeyUUID).(string)
 	if !ok {
@@ -125,20 +259,85 @@ func (s *Server) GetPrivateRecentMessages(ctx context.Context, in *chat.GetPriva
 		return nil, status.Error(codes.Internal, "failed to find uuid")
 	}
 
-	messages, err := s.repository.GetPrivateRecentMessages(ctx, in.ChatUuid, userUUID)
+	memberUUIDs, err := uniqueUUIDs(in.MemberUuids, userUUID)
 	if err != nil {
-		logger.Error(fmt.Sprintf("failed to fetch chat: %v", err))
-		return nil, status.Errorf(codes.Internal, "failed to fetch chat: %v", err)
+		logger.Error(fmt.Sprintf("failed to parse member uuids: %v", err))
+		return nil, status.Errorf(codes.InvalidArgument, "failed to parse member uuids: %v", err)
 	}
 
-	return &chat.GetPrivateRecentMessagesOut{
-		Messages: messages.FromDTO(),
+	if len(memberUUIDs) == 0 {
+		logger.Error("failed to add group members: empty member list")
+		return nil, status.Error(codes.InvalidArgument, "failed to add group members: empty member list")
+	}
+
+	_, err = s.checkGroupManager(ctx, in.ChatUuid, userUUID)
+	if err != nil {
+		logger.Error(status.Convert(err).Message())
+		return nil, err
+	}
+
+	addedUUIDs := make([]string, 0, len(memberUUIDs))
+	membersParams := make([]*model.ChatMemberParams, 0, len(memberUUIDs))
+	for _, memberUUID := range memberUUIDs {
+		member, err := s.repository.GetChatMember(ctx, in.ChatUuid, memberUUID)
+		if err != nil {
+			logger.Error(fmt.Sprintf("failed to get chat member: %v", err))
+			return nil, status.Errorf(codes.Internal, "failed to get chat member: %v", err)
+		}
+
+		if member != nil {
+			continue
+		}
+
+		// забаненного пользователя нельзя вернуть в чат, пока бан не снят или не истек
+		isBanned, err := s.repository.IsMemberBanned(ctx, in.ChatUuid, memberUUID)
+		if err != nil {
+			logger.Error(fmt.Sprintf("failed to check member ban: %v", err))
+			return nil, status.Errorf(codes.Internal, "failed to check member ban: %v", err)
+		}
+
+		if isBanned {
+			continue
+		}
+
+		memberParams, err := s.getMemberParams(ctx, memberUUID)
+		if err != nil {
+			logger.Error(fmt.Sprintf("failed to get member info: %v", err))
+			return nil, status.Errorf(codes.Internal, "failed to get member info: %v", err)
+		}
+
+		membersParams = append(membersParams, memberParams)
+		addedUUIDs = append(addedUUIDs, memberUUID)
+	}
+
+	// участники добавляются все вместе или не добавляются совсем
+	err = s.inTx(ctx, func(ctx context.Context) error {
+		for _, memberParams := range membersParams {
+			err := s.repository.AddChatMember(ctx, in.ChatUuid, memberParams, model.RoleMember, userUUID)
+			if err != nil {
+				return status.Errorf(codes.Internal, "failed to add member to group chat: %v", err)
+			}
+		}
+
+		return nil
+	})
+	if err != nil {
+		logger.Error(status.Convert(err).Message())
+		return nil, err
+	}
+
+	for _, memberUUID := range addedUUIDs {
+		s.publishChatEvent(ctx, in.ChatUuid, membershipChangedEvent(memberUUID, model.MembershipActionAdded))
+	}
+
+	return &chat.AddGroupMembersOut{
+		AddedUuids: addedUUIDs,
 	}, nil
 }
 
-func (s *Server) EditPrivateMessage(ctx context.Context, in *chat.EditPrivateMessageIn) (*chat.EditPrivateMessageOut, error) {
+func (s *Server) RemoveGroupMember(ctx context.Context, in *chat.RemoveGroupMemberIn) (*chat.RemoveGroupMemberOut, error) {
 	logger := logger_lib.FromContext(ctx, config.KeyLogger)
-	logger.AddFuncName("EditPrivateMessage")
+	logger.AddFuncName("RemoveGroupMember")
 
 	userUUID, ok := ctx.Value(config.KeyUUID).(string)
 	if !ok {
@@ -146,55 +345,141 @@ func (s *Server) EditPrivateMessage(ctx context.Context, in *chat.EditPrivateMes
 		return nil, status.Error(codes.Internal, "failed to find uuid")
 	}
 
-	isMember, err := s.repository.IsChatMember(ctx, in.ChatUuid, userUUID)
+	caller, err := s.checkGroupManager(ctx, in.ChatUuid, userUUID)
 	if err != nil {
-		logger.Error(fmt.Sprintf("failed to check user in chat: %v", err))
-		return nil, status.Errorf(codes.Internal, "failed to check user in chat: %v", err)
+		logger.Error(status.Convert(err).Message())
+		return nil, err
 	}
 
-	if !isMember {
-		logger.Error("failed to user is not chat member")
-		return nil, status.Error(codes.Internal, "failed to user is not chat member")
+	target, err := s.repository.GetChatMember(ctx, in.ChatUuid, in.MemberUuid)
+	if err != nil {
+		logger.Error(fmt.Sprintf("failed to get chat member: %v", err))
+		return nil, status.Errorf(codes.Internal, "failed to get chat member: %v", err)
+	}
+
+	if target == nil {
+		logger.Error("failed to member is not in group chat")
+		return nil, status.Error(codes.NotFound, "failed to member is not in group chat")
+	}
+
+	if target.Role == model.RoleOwner || (target.Role == model.RoleAdmin && caller.Role != model.RoleOwner) {
+		logger.Error("failed to remove member with the same or higher role")
+		return nil, status.Error(codes.PermissionDenied, "failed to remove member with the same or higher role")
 	}
 
-	isDeleted, err := s.repository.GetPrivateDeletionInfo(ctx, in.MessageUuid)
+	err = s.repository.RemoveChatMember(ctx, in.ChatUuid, in.MemberUuid)
 	if err != nil {
-		logger.Error(fmt.Sprintf("failed to check deletion status: %v", err))
-		return nil, status.Errorf(codes.Internal, "failed to check deletion status: %v", err)
+		logger.Error(fmt.Sprintf("failed to remove group member: %v", err))
+		return nil, status.Errorf(codes.Internal, "failed to remove group member: %v", err)
 	}
 
-	if isDeleted != nil && isDeleted.DeletedAt != "" {
-		logger.Error("failed to edit deleted message")
-		return nil, status.Error(codes.Internal, "failed to edit deleted message")
+	s.publishChatEvent(ctx, in.ChatUuid, membershipChangedEvent(in.MemberUuid, model.MembershipActionRemoved), in.MemberUuid)
+
+	return &chat.RemoveGroupMemberOut{
+		RemovalStatus: true,
+	}, nil
+}
+
+func (s *Server) CreateChannel(ctx context.Context, in *chat.CreateChannelIn) (*chat.CreateChannelOut, error) {
+	logger := logger_lib.FromContext(ctx, config.KeyLogger)
+	logger.AddFuncName("CreateChannel")
+
+	initiatorID, ok := ctx.Value(config.KeyUUID).(string)
+	if !ok {
+		logger.Error("failed to get initiatorID")
+		return nil, status.Error(codes.Internal, "failed to get initiatorID")
 	}
 
-	isUserMessageOwner, err := s.repository.IsMessageOwner(ctx, in.ChatUuid, in.MessageUuid, userUUID)
+	if strings.TrimSpace(in.Name) == "" {
+		logger.Error("failed to create channel without name")
+		return nil, status.Error(codes.InvalidArgument, "failed to create channel without name")
+	}
+
+	initiatorParams, err := s.getMemberParams(ctx, initiatorID)
 	if err != nil {
-		logger.Error(fmt.Sprintf("failed to check message owner: %v", err))
-		return nil, status.Errorf(codes.Internal, "failed to check message owner: %v", err)
+		logger.Error(fmt.Sprintf("failed to get initiator info: %v", err))
+		return nil, status.Errorf(codes.Internal, "failed to get initiator info: %v", err)
 	}
 
-	if !isUserMessageOwner {
-		logger.Error("failed to user is not message owner")
-		return nil, status.Error(codes.Internal, "failed to user is not message owner")
+	var chatUUID string
+	err = s.inTx(ctx, func(ctx context.Context) error {
+		var err error
+		chatUUID, err = s.repository.CreateChannel(ctx, initiatorParams, in.Name, in.AvatarUrl)
+		if err != nil {
+			return status.Errorf(codes.Internal, "failed to create channel: %v", err)
+		}
+
+		err = s.repository.AddChatMember(ctx, chatUUID, initiatorParams, model.RoleOwner, "")
+		if err != nil {
+			return status.Errorf(codes.Internal, "failed to add owner to channel: %v", err)
+		}
+
+		return s.addOutboxEvent(ctx, chatUUID, chatCreatedDomainEvent(model.StreamTypeChannel, initiatorID, []string{initiatorID}))
+	})
+	if err != nil {
+		logger.Error(status.Convert(err).Message())
+		return nil, err
+	}
+
+	return &chat.CreateChannelOut{
+		NewChatUuid: chatUUID,
+	}, nil
+}
+
+func (s *Server) SubscribeChannel(ctx context.Context, in *chat.SubscribeChannelIn) (*chat.SubscribeChannelOut, error) {
+	logger := logger_lib.FromContext(ctx, config.KeyLogger)
+	logger.AddFuncName("SubscribeChannel")
+
+	userUUID, ok := ctx.Value(config.KeyUUID).(string)
+	if !ok {
+		logger.Error("failed to find uuid")
+		return nil, status.Error(codes.Internal, "failed to find uuid")
 	}
 
-	data, err := s.repository.EditPrivateMessage(ctx, in.MessageUuid, in.NewContent)
+	streamType, err := s.repository.GetStreamType(ctx, in.ChatUuid)
 	if err != nil {
-		logger.Error(fmt.Sprintf("failed to edit private message: %v", err))
-		return nil, status.Errorf(codes.Internal, "failed to edit private message: %v", err)
+		logger.Error(fmt.Sprintf("failed to get chat type: %v", err))
+		return nil, status.Errorf(codes.Internal, "failed to get chat type: %v", err)
 	}
 
-	return &chat.EditPrivateMessageOut{
-		MessageUuid: data.MessageUUID.String(),
-		NewContent:  data.Content,
-		UpdatedAt:   data.UpdateAt.Format(time.RFC3339),
+	if streamType != model.StreamTypeChannel {
+		logger.Error("failed to find channel")
+		return nil, status.Error(codes.NotFound, "failed to find channel")
+	}
+
+	isBanned, err := s.repository.IsMemberBanned(ctx, in.ChatUuid, userUUID)
+	if err != nil {
+		logger.Error(fmt.Sprintf("failed to check member ban: %v", err))
+		return nil, status.Errorf(codes.Internal, "failed to check member ban: %v", err)
+	}
+
+	if isBanned {
+		logger.Error("failed to user is banned in chat")
+		return nil, status.Error(codes.PermissionDenied, "failed to user is banned in chat")
+	}
+
+	subscriberParams, err := s.getMemberParams(ctx, userUUID)
+	if err != nil {
+		logger.Error(fmt.Sprintf("failed to get subscriber info: %v", err))
+		return nil, status.Errorf(codes.Internal, "failed to get subscriber info: %v", err)
+	}
+
+	err = s.repository.SubscribeChannel(ctx, in.ChatUuid, subscriberParams)
+	if err != nil {
+		logger.Error(fmt.Sprintf("failed to subscribe channel: %v", err))
+		return nil, status.Errorf(codes.Internal, "failed to subscribe channel: %v", err)
+	}
+
+	s.publishUserEvent([]string{userUUID}, in.ChatUuid, membershipChangedEvent(userUUID, model.MembershipActionSubscribed))
+
+	return &chat.SubscribeChannelOut{
+		SubscriptionStatus: true,
 	}, nil
 }
 
-func (s *Server) DeletePrivateMessage(ctx context.Context, in *chat.DeletePrivateMessageIn) (*chat.DeletePrivateMessageOut, error) {
+func (s *Server) UnsubscribeChannel(ctx context.Context, in *chat.UnsubscribeChannelIn) (*chat.UnsubscribeChannelOut, error) {
 	logger := logger_lib.FromContext(ctx, config.KeyLogger)
-	logger.AddFuncName("DeletePrivateMessage")
+	logger.AddFuncName("UnsubscribeChannel")
 
 	userUUID, ok := ctx.Value(config.KeyUUID).(string)
 	if !ok {
@@ -202,44 +487,1124 @@ func (s *Server) DeletePrivateMessage(ctx context.Context, in *chat.DeletePrivat
 		return nil, status.Error(codes.Internal, "failed to find uuid")
 	}
 
-	userIsChatMember, err := s.repository.IsChatMember(ctx, in.ChatUuid, userUUID)
+	err := s.repository.UnsubscribeChannel(ctx, in.ChatUuid, userUUID)
 	if err != nil {
-		logger.Error(fmt.Sprintf("failed to check if user is chat member: %v", err))
-		return nil, status.Errorf(codes.Internal, "failed to check user is chat member: %v", err)
+		logger.Error(fmt.Sprintf("failed to unsubscribe channel: %v", err))
+		return nil, status.Errorf(codes.Internal, "failed to unsubscribe channel: %v", err)
 	}
 
-	if !userIsChatMember {
-		logger.Error("failed to user is not chat member")
-		return nil, status.Error(codes.Internal, "failed to user is not chat member")
+	s.publishUserEvent([]string{userUUID}, in.ChatUuid, membershipChangedEvent(userUUID, model.MembershipActionUnsubscribed))
+
+	return &chat.UnsubscribeChannelOut{
+		UnsubscriptionStatus: true,
+	}, nil
+}
+
+func (s *Server) PublishToChannel(ctx context.Context, in *chat.PublishToChannelIn) (*chat.PublishToChannelOut, error) {
+	logger := logger_lib.FromContext(ctx, config.KeyLogger)
+	logger.AddFuncName("PublishToChannel")
+
+	userUUID, ok := ctx.Value(config.KeyUUID).(string)
+	if !ok {
+		logger.Error("failed to find uuid")
+		return nil, status.Error(codes.Internal, "failed to find uuid")
 	}
 
-	if in.Mode != model.Self && in.Mode != model.All {
-		logger.Error(fmt.Sprintf("failed to invalid mode: %s", in.Mode))
-		return nil, status.Errorf(codes.Internal, "failed to invalid mode: %s", in.Mode)
+	if strings.TrimSpace(in.Content) == "" {
+		logger.Error("failed to send empty message")
+		return nil, status.Error(codes.InvalidArgument, "failed to send empty message")
 	}
 
-	deletionInfo, err := s.repository.GetPrivateDeletionInfo(ctx, in.MessageUuid)
+	member, err := s.repository.GetChatMember(ctx, in.ChatUuid, userUUID)
 	if err != nil {
-		logger.Error(fmt.Sprintf("failed to get private message deletion info: %v", err))
-		return nil, status.Errorf(codes.Internal, "failed to get private message deletion info: %v", err)
+		logger.Error(fmt.Sprintf("failed to get chat member: %v", err))
+		return nil, status.Errorf(codes.Internal, "failed to get chat member: %v", err)
 	}
 
-	if (deletionInfo.DeleteFormat == model.All) || (deletionInfo.DeleteFormat == model.Self && deletionInfo.DeletedBy == userUUID) {
-		logger.Error("failed to message is already deleted")
-		return nil, status.Error(codes.Internal, "failed to message is already deleted")
+	if member == nil || member.StreamType != model.StreamTypeChannel || !member.CanPublish() {
+		logger.Error("failed to user can not publish to channel")
+		return nil, status.Error(codes.PermissionDenied, "failed to user can not publish to channel")
 	}
 
-	if deletionInfo.DeleteFormat == model.Self && deletionInfo.DeletedBy != userUUID {
-		in.Mode = model.All
+	var published *chat.Message
+	err = s.inTx(ctx, func(ctx context.Context) error {
+		message, err := s.repository.SendPrivateMessage(ctx, &model.NewMessage{
+			ChatUUID:   in.ChatUuid,
+			SenderUUID: userUUID,
+			Content:    in.Content,
+		})
+		if err != nil {
+			return status.Errorf(codes.Internal, "failed to publish to channel: %v", err)
+		}
+
+		published = message.FromDTO()
+		return s.addOutboxEvent(ctx, in.ChatUuid, messageSentDomainEvent(published))
+	})
+	if err != nil {
+		logger.Error(status.Convert(err).Message())
+		return nil, err
+	}
+
+	s.publishChatEvent(ctx, in.ChatUuid, messageSentEvent(published))
+
+	return &chat.PublishToChannelOut{
+		Message: published,
+	}, nil
+}
+
+func (s *Server) GetOrCreateCommentStream(ctx context.Context, in *chat.GetOrCreateCommentStreamIn) (*chat.GetOrCreateCommentStreamOut, error) {
+	logger := logger_lib.FromContext(ctx, config.KeyLogger)
+	logger.AddFuncName("GetOrCreateCommentStream")
+
+	userUUID, ok := ctx.Value(config.KeyUUID).(string)
+	if !ok {
+		logger.Error("failed to find uuid")
+		return nil, status.Error(codes.Internal, "failed to find uuid")
+	}
+
+	if !entityTypePattern.MatchString(in.EntityType) || in.EntityId == "" || len(in.EntityId) > maxEntityIDLength {
+		logger.Error("failed to invalid comment entity")
+		return nil, status.Error(codes.InvalidArgument, "failed to invalid comment entity")
 	}
 
-	isDeleted, err := s.repository.DeletePrivateMessage(ctx, userUUID, in.MessageUuid, in.Mode)
+	creatorParams, err := s.getMemberParams(ctx, userUUID)
 	if err != nil {
-		logger.Error(fmt.Sprintf("failed to delete private message: %v", err))
-		return nil, status.Errorf(codes.Internal, "failed to delete private message: %v", err)
+		logger.Error(fmt.Sprintf("failed to get user info: %v", err))
+		return nil, status.Errorf(codes.Internal, "failed to get user info: %v", err)
 	}
 
-	return &chat.DeletePrivateMessageOut{
-		DeletionStatus: isDeleted,
+	chatUUID, created, err := s.repository.GetOrCreateCommentStream(ctx, creatorParams, in.EntityType, in.EntityId)
+	if err != nil {
+		logger.Error(fmt.Sprintf("failed to get comment stream: %v", err))
+		return nil, status.Errorf(codes.Internal, "failed to get comment stream: %v", err)
+	}
+
+	return &chat.GetOrCreateCommentStreamOut{
+		ChatUuid: chatUUID,
+		Created:  created,
+	}, nil
+}
+
+func (s *Server) GetComments(ctx context.Context, in *chat.GetCommentsIn) (*chat.GetCommentsOut, error) {
+	logger := logger_lib.FromContext(ctx, config.KeyLogger)
+	logger.AddFuncName("GetComments")
+
+	userUUID, ok := ctx.Value(config.KeyUUID).(string)
+	if !ok {
+		logger.Error("failed to find uuid")
+		return nil, status.Error(codes.Internal, "failed to find uuid")
+	}
+
+	page, err := messagePageFromRequest(in.Limit, in.Cursor, "")
+	if err != nil {
+		logger.Error(fmt.Sprintf("failed to parse page params: %v", err))
+		return nil, status.Errorf(codes.InvalidArgument, "failed to parse page params: %v", err)
+	}
+
+	err = s.checkCommentStream(ctx, in.ChatUuid)
+	if err != nil {
+		logger.Error(status.Convert(err).Message())
+		return nil, err
+	}
+
+	comments, nextCursor, err := s.getMessagesPage(ctx, in.ChatUuid, userUUID, page)
+	if err != nil {
+		logger.Error(fmt.Sprintf("failed to fetch comments: %v", err))
+		return nil, status.Errorf(codes.Internal, "failed to fetch comments: %v", err)
+	}
+
+	return &chat.GetCommentsOut{
+		Comments:   comments.FromDTO(),
+		NextCursor: nextCursor,
+	}, nil
+}
+
+func (s *Server) PostComment(ctx context.Context, in *chat.PostCommentIn) (*chat.PostCommentOut, error) {
+	logger := logger_lib.FromContext(ctx, config.KeyLogger)
+	logger.AddFuncName("PostComment")
+
+	userUUID, ok := ctx.Value(config.KeyUUID).(string)
+	if !ok {
+		logger.Error("failed to find uuid")
+		return nil, status.Error(codes.Internal, "failed to find uuid")
+	}
+
+	if strings.TrimSpace(in.Content) == "" {
+		logger.Error("failed to send empty message")
+		return nil, status.Error(codes.InvalidArgument, "failed to send empty message")
+	}
+
+	for _, replyUUID := range []string{in.RootUuid, in.ParentUuid} {
+		if replyUUID == "" {
+			continue
+		}
+		if _, err := uuid.Parse(replyUUID); err != nil {
+			logger.Error(fmt.Sprintf("failed to parse reply uuid: %v", err))
+			return nil, status.Errorf(codes.InvalidArgument, "failed to parse reply uuid: %v", err)
+		}
+	}
+
+	err := s.checkCommentStream(ctx, in.ChatUuid)
+	if err != nil {
+		logger.Error(status.Convert(err).Message())
+		return nil, err
+	}
+
+	authorParams, err := s.getMemberParams(ctx, userUUID)
+	if err != nil {
+		logger.Error(fmt.Sprintf("failed to get user info: %v", err))
+		return nil, status.Errorf(codes.Internal, "failed to get user info: %v", err)
+	}
+
+	var posted *chat.Message
+	err = s.inTx(ctx, func(ctx context.Context) error {
+		// комментировать может любой пользователь платформы, поэтому автор становится участником ленты при первом комментарии
+		err := s.repository.EnsureChatMember(ctx, in.ChatUuid, authorParams, model.RoleMember)
+		if err != nil {
+			return status.Errorf(codes.Internal, "failed to join comment stream: %v", err)
+		}
+
+		comment, err := s.repository.SendPrivateMessage(ctx, &model.NewMessage{
+			ChatUUID:   in.ChatUuid,
+			SenderUUID: userUUID,
+			Content:    in.Content,
+			RootUUID:   in.RootUuid,
+			ParentUUID: in.ParentUuid,
+		})
+		if err != nil {
+			return status.Errorf(codes.Internal, "failed to post comment: %v", err)
+		}
+
+		posted = comment.FromDTO()
+		return s.addOutboxEvent(ctx, in.ChatUuid, messageSentDomainEvent(posted))
+	})
+	if err != nil {
+		logger.Error(status.Convert(err).Message())
+		return nil, err
+	}
+
+	s.publishChatEvent(ctx, in.ChatUuid, messageSentEvent(posted))
+
+	return &chat.PostCommentOut{
+		Comment: posted,
 	}, nil
 }
+
+func (s *Server) MarkMessagesRead(ctx context.Context, in *chat.MarkMessagesReadIn) (*chat.MarkMessagesReadOut, error) {
+	logger := logger_lib.FromContext(ctx, config.KeyLogger)
+	logger.AddFuncName("MarkMessagesRead")
+
+	userUUID, ok := ctx.Value(config.KeyUUID).(string)
+	if !ok {
+		logger.Error("failed to find uuid")
+		return nil, status.Error(codes.Internal, "failed to find uuid")
+	}
+
+	if _, err := uuid.Parse(in.UpToMessageUuid); err != nil {
+		logger.Error(fmt.Sprintf("failed to parse message uuid: %v", err))
+		return nil, status.Errorf(codes.InvalidArgument, "failed to parse message uuid: %v", err)
+	}
+
+	err := s.checkChatAccess(ctx, in.ChatUuid, userUUID)
+	if err != nil {
+		logger.Error(status.Convert(err).Message())
+		return nil, err
+	}
+
+	marked, err := s.repository.MarkMessagesRead(ctx, in.ChatUuid, userUUID, in.UpToMessageUuid)
+	if err != nil {
+		logger.Error(fmt.Sprintf("failed to mark messages read: %v", err))
+		return nil, status.Errorf(codes.Internal, "failed to mark messages read: %v", err)
+	}
+
+	if marked > 0 {
+		s.publishChatEvent(ctx, in.ChatUuid, &chat.Event{Payload: &chat.Event_ReadReceipt{ReadReceipt: &chat.ReadReceipt{
+			UserUuid:        userUUID,
+			UpToMessageUuid: in.UpToMessageUuid,
+		}}})
+	}
+
+	return &chat.MarkMessagesReadOut{
+		MarkedCount: marked,
+	}, nil
+}
+
+func (s *Server) GetMessageReaders(ctx context.Context, in *chat.GetMessageReadersIn) (*chat.GetMessageReadersOut, error) {
+	logger := logger_lib.FromContext(ctx, config.KeyLogger)
+	logger.AddFuncName("GetMessageReaders")
+
+	userUUID, ok := ctx.Value(config.KeyUUID).(string)
+	if !ok {
+		logger.Error("failed to find uuid")
+		return nil, status.Error(codes.Internal, "failed to find uuid")
+	}
+
+	if _, err := uuid.Parse(in.MessageUuid); err != nil {
+		logger.Error(fmt.Sprintf("failed to parse message uuid: %v", err))
+		return nil, status.Errorf(codes.InvalidArgument, "failed to parse message uuid: %v", err)
+	}
+
+	err := s.checkChatAccess(ctx, in.ChatUuid, userUUID)
+	if err != nil {
+		logger.Error(status.Convert(err).Message())
+		return nil, err
+	}
+
+	readers, err := s.repository.GetMessageReaders(ctx, in.ChatUuid, in.MessageUuid, userUUID)
+	if err != nil {
+		logger.Error(fmt.Sprintf("failed to get message readers: %v", err))
+		return nil, status.Errorf(codes.Internal, "failed to get message readers: %v", err)
+	}
+
+	return &chat.GetMessageReadersOut{
+		Readers: readers.FromDTO(),
+	}, nil
+}
+
+func (s *Server) AddReaction(ctx context.Context, in *chat.AddReactionIn) (*chat.AddReactionOut, error) {
+	logger := logger_lib.FromContext(ctx, config.KeyLogger)
+	logger.AddFuncName("AddReaction")
+
+	userUUID, ok := ctx.Value(config.KeyUUID).(string)
+	if !ok {
+		logger.Error("failed to find uuid")
+		return nil, status.Error(codes.Internal, "failed to find uuid")
+	}
+
+	err := s.checkReactionTarget(ctx, in.ChatUuid, in.MessageUuid, userUUID, in.Emoji)
+	if err != nil {
+		logger.Error(status.Convert(err).Message())
+		return nil, err
+	}
+
+	err = s.repository.AddReaction(ctx, in.MessageUuid, userUUID, in.Emoji)
+	if err != nil {
+		logger.Error(fmt.Sprintf("failed to add reaction: %v", err))
+		return nil, status.Errorf(codes.Internal, "failed to add reaction: %v", err)
+	}
+
+	reactions, err := s.repository.GetMessageReactions(ctx, in.MessageUuid, userUUID)
+	if err != nil {
+		logger.Error(fmt.Sprintf("failed to get message reactions: %v", err))
+		return nil, status.Errorf(codes.Internal, "failed to get message reactions: %v", err)
+	}
+
+	return &chat.AddReactionOut{
+		Reactions: reactions.FromDTO(),
+	}, nil
+}
+
+func (s *Server) RemoveReaction(ctx context.Context, in *chat.RemoveReactionIn) (*chat.RemoveReactionOut, error) {
+	logger := logger_lib.FromContext(ctx, config.KeyLogger)
+	logger.AddFuncName("RemoveReaction")
+
+	userUUID, ok := ctx.Value(config.KeyUUID).(string)
+	if !ok {
+		logger.Error("failed to find uuid")
+		return nil, status.Error(codes.Internal, "failed to find uuid")
+	}
+
+	err := s.checkReactionTarget(ctx, in.ChatUuid, in.MessageUuid, userUUID, in.Emoji)
+	if err != nil {
+		logger.Error(status.Convert(err).Message())
+		return nil, err
+	}
+
+	err = s.repository.RemoveReaction(ctx, in.MessageUuid, userUUID, in.Emoji)
+	if err != nil {
+		logger.Error(fmt.Sprintf("failed to remove reaction: %v", err))
+		return nil, status.Errorf(codes.Internal, "failed to remove reaction: %v", err)
+	}
+
+	reactions, err := s.repository.GetMessageReactions(ctx, in.MessageUuid, userUUID)
+	if err != nil {
+		logger.Error(fmt.Sprintf("failed to get message reactions: %v", err))
+		return nil, status.Errorf(codes.Internal, "failed to get message reactions: %v", err)
+	}
+
+	return &chat.RemoveReactionOut{
+		Reactions: reactions.FromDTO(),
+	}, nil
+}
+
+func (s *Server) PinMessage(ctx context.Context, in *chat.PinMessageIn) (*chat.PinMessageOut, error) {
+	logger := logger_lib.FromContext(ctx, config.KeyLogger)
+	logger.AddFuncName("PinMessage")
+
+	userUUID, ok := ctx.Value(config.KeyUUID).(string)
+	if !ok {
+		logger.Error("failed to find uuid")
+		return nil, status.Error(codes.Internal, "failed to find uuid")
+	}
+
+	err := s.checkPinAccess(ctx, in.ChatUuid, in.MessageUuid, userUUID, true)
+	if err != nil {
+		logger.Error(status.Convert(err).Message())
+		return nil, err
+	}
+
+	var (
+		pinned bool
+		notice *model.Message
+	)
+	err = s.inTx(ctx, func(ctx context.Context) error {
+		var err error
+		pinned, err = s.repository.PinMessage(ctx, in.ChatUuid, in.MessageUuid, userUUID)
+		if err != nil {
+			return status.Errorf(codes.Internal, "failed to pin message: %v", err)
+		}
+
+		if !pinned {
+			return nil
+		}
+
+		notice, err = s.repository.SendPrivateMessage(ctx, &model.NewMessage{
+			ChatUUID:   in.ChatUuid,
+			SenderUUID: userUUID,
+			Content:    model.SystemMessagePinned,
+			ParentUUID: in.MessageUuid,
+			Type:       model.MessageTypeSystem,
+		})
+		if err != nil {
+			return status.Errorf(codes.Internal, "failed to send pin system message: %v", err)
+		}
+
+		return s.addOutboxEvent(ctx, in.ChatUuid, messageSentDomainEvent(notice.FromDTO()))
+	})
+	if err != nil {
+		logger.Error(status.Convert(err).Message())
+		return nil, err
+	}
+
+	if pinned {
+		s.publishChatEvent(ctx, in.ChatUuid, messageSentEvent(notice.FromDTO()))
+	}
+
+	return &chat.PinMessageOut{
+		PinStatus: pinned,
+	}, nil
+}
+
+func (s *Server) UnpinMessage(ctx context.Context, in *chat.UnpinMessageIn) (*chat.UnpinMessageOut, error) {
+	logger := logger_lib.FromContext(ctx, config.KeyLogger)
+	logger.AddFuncName("UnpinMessage")
+
+	userUUID, ok := ctx.Value(config.KeyUUID).(string)
+	if !ok {
+		logger.Error("failed to find uuid")
+		return nil, status.Error(codes.Internal, "failed to find uuid")
+	}
+
+	err := s.checkPinAccess(ctx, in.ChatUuid, in.MessageUuid, userUUID, false)
+	if err != nil {
+		logger.Error(status.Convert(err).Message())
+		return nil, err
+	}
+
+	unpinned, err := s.repository.UnpinMessage(ctx, in.ChatUuid, in.MessageUuid)
+	if err != nil {
+		logger.Error(fmt.Sprintf("failed to unpin message: %v", err))
+		return nil, status.Errorf(codes.Internal, "failed to unpin message: %v", err)
+	}
+
+	return &chat.UnpinMessageOut{
+		UnpinStatus: unpinned,
+	}, nil
+}
+
+func (s *Server) ListPinnedMessages(ctx context.Context, in *chat.ListPinnedMessagesIn) (*chat.ListPinnedMessagesOut, error) {
+	logger := logger_lib.FromContext(ctx, config.KeyLogger)
+	logger.AddFuncName("ListPinnedMessages")
+
+	userUUID, ok := ctx.Value(config.KeyUUID).(string)
+	if !ok {
+		logger.Error("failed to find uuid")
+		return nil, status.Error(codes.Internal, "failed to find uuid")
+	}
+
+	err := s.checkChatAccess(ctx, in.ChatUuid, userUUID)
+	if err != nil {
+		logger.Error(status.Convert(err).Message())
+		return nil, err
+	}
+
+	pinned, err := s.repository.GetPinnedMessages(ctx, in.ChatUuid, userUUID)
+	if err != nil {
+		logger.Error(fmt.Sprintf("failed to get pinned messages: %v", err))
+		return nil, status.Errorf(codes.Internal, "failed to get pinned messages: %v", err)
+	}
+
+	return &chat.ListPinnedMessagesOut{
+		PinnedMessages: pinned.FromDTO(),
+	}, nil
+}
+
+func (s *Server) GetPrivateRecentMessages(ctx context.Context, in *chat.GetPrivateRecentMessagesIn) (*chat.GetPrivateRecentMessagesOut, error) {
+	logger := logger_lib.FromContext(ctx, config.KeyLogger)
+	logger.AddFuncName("GetRecentMessages")
+
+	userUUID, ok := ctx.Value(config.KeyUUID).(string)
+	if !ok {
+		logger.Error("failed to find uuid")
+		return nil, status.Error(codes.Internal, "failed to find uuid")
+	}
+
+	page, err := messagePageFromRequest(in.Limit, in.Cursor, in.AfterMessageUuid)
+	if err != nil {
+		logger.Error(fmt.Sprintf("failed to parse page params: %v", err))
+		return nil, status.Errorf(codes.InvalidArgument, "failed to parse page params: %v", err)
+	}
+
+	err = s.checkChatAccess(ctx, in.ChatUuid, userUUID)
+	if err != nil {
+		logger.Error(status.Convert(err).Message())
+		return nil, err
+	}
+
+	messages, nextCursor, err := s.getMessagesPage(ctx, in.ChatUuid, userUUID, page)
+	if err != nil {
+		logger.Error(fmt.Sprintf("failed to fetch chat: %v", err))
+		return nil, status.Errorf(codes.Internal, "failed to fetch chat: %v", err)
+	}
+
+	return &chat.GetPrivateRecentMessagesOut{
+		Messages:   messages.FromDTO(),
+		NextCursor: nextCursor,
+	}, nil
+}
+
+func (s *Server) SendPrivateMessage(ctx context.Context, in *chat.SendPrivateMessageIn) (*chat.SendPrivateMessageOut, error) {
+	logger := logger_lib.FromContext(ctx, config.KeyLogger)
+	logger.AddFuncName("SendPrivateMessage")
+
+	userUUID, ok := ctx.Value(config.KeyUUID).(string)
+	if !ok {
+		logger.Error("failed to find uuid")
+		return nil, status.Error(codes.Internal, "failed to find uuid")
+	}
+
+	messageType, media := model.MediaFromDTO(in.Attachment)
+	if media == nil && strings.TrimSpace(in.Content) == "" {
+		logger.Error("failed to send empty message")
+		return nil, status.Error(codes.InvalidArgument, "failed to send empty message")
+	}
+
+	if media != nil {
+		if err := media.Validate(messageType); err != nil {
+			logger.Error(fmt.Sprintf("failed to validate attachment: %v", err))
+			return nil, status.Errorf(codes.InvalidArgument, "failed to validate attachment: %v", err)
+		}
+	}
+
+	for _, replyUUID := range []string{in.RootUuid, in.ParentUuid} {
+		if replyUUID == "" {
+			continue
+		}
+		if _, err := uuid.Parse(replyUUID); err != nil {
+			logger.Error(fmt.Sprintf("failed to parse reply uuid: %v", err))
+			return nil, status.Errorf(codes.InvalidArgument, "failed to parse reply uuid: %v", err)
+		}
+	}
+
+	isMember, err := s.repository.IsChatMember(ctx, in.ChatUuid, userUUID)
+	if err != nil {
+		logger.Error(fmt.Sprintf("failed to check user in chat: %v", err))
+		return nil, status.Errorf(codes.Internal, "failed to check user in chat: %v", err)
+	}
+
+	if !isMember {
+		logger.Error("failed to user is not chat member")
+		return nil, status.Error(codes.PermissionDenied, "failed to user is not chat member")
+	}
+
+	var sent *chat.Message
+	err = s.inTx(ctx, func(ctx context.Context) error {
+		message, err := s.repository.SendPrivateMessage(ctx, &model.NewMessage{
+			ChatUUID:   in.ChatUuid,
+			SenderUUID: userUUID,
+			Content:    in.Content,
+			RootUUID:   in.RootUuid,
+			ParentUUID: in.ParentUuid,
+			Type:       messageType,
+			Media:      media,
+		})
+		if err != nil {
+			return status.Errorf(codes.Internal, "failed to send private message: %v", err)
+		}
+
+		sent = message.FromDTO()
+		return s.addOutboxEvent(ctx, in.ChatUuid, messageSentDomainEvent(sent))
+	})
+	if err != nil {
+		logger.Error(status.Convert(err).Message())
+		return nil, err
+	}
+
+	s.publishChatEvent(ctx, in.ChatUuid, messageSentEvent(sent))
+
+	return &chat.SendPrivateMessageOut{
+		Message: sent,
+	}, nil
+}
+
+func (s *Server) EditPrivateMessage(ctx context.Context, in *chat.EditPrivateMessageIn) (*chat.EditPrivateMessageOut, error) {
+	logger := logger_lib.FromContext(ctx, config.KeyLogger)
+	logger.AddFuncName("EditPrivateMessage")
+
+	userUUID, ok := ctx.Value(config.KeyUUID).(string)
+	if !ok {
+		logger.Error("failed to find uuid")
+		return nil, status.Error(codes.Internal, "failed to find uuid")
+	}
+
+	isMember, err := s.repository.IsChatMember(ctx, in.ChatUuid, userUUID)
+	if err != nil {
+		logger.Error(fmt.Sprintf("failed to check user in chat: %v", err))
+		return nil, status.Errorf(codes.Internal, "failed to check user in chat: %v", err)
+	}
+
+	if !isMember {
+		logger.Error("failed to user is not chat member")
+		return nil, status.Error(codes.PermissionDenied, "failed to user is not chat member")
+	}
+
+	isDeleted, err := s.repository.GetPrivateDeletionInfo(ctx, in.ChatUuid, userUUID, in.MessageUuid)
+	if err != nil {
+		logger.Error(fmt.Sprintf("failed to check deletion status: %v", err))
+		return nil, status.Errorf(codes.Internal, "failed to check deletion status: %v", err)
+	}
+
+	if isDeleted == nil {
+		logger.Error("failed to find message in chat")
+		return nil, status.Error(codes.NotFound, "failed to find message in chat")
+	}
+
+	if isDeleted.DeletedAt != "" {
+		logger.Error("failed to edit deleted message")
+		return nil, status.Error(codes.Internal, "failed to edit deleted message")
+	}
+
+	isUserMessageOwner, err := s.repository.IsMessageOwner(ctx, in.ChatUuid, in.MessageUuid, userUUID)
+	if err != nil {
+		logger.Error(fmt.Sprintf("failed to check message owner: %v", err))
+		return nil, status.Errorf(codes.Internal, "failed to check message owner: %v", err)
+	}
+
+	if !isUserMessageOwner {
+		logger.Error("failed to user is not message owner")
+		return nil, status.Error(codes.Internal, "failed to user is not message owner")
+	}
+
+	var edited *chat.MessageEdited
+	err = s.inTx(ctx, func(ctx context.Context) error {
+		data, err := s.repository.EditPrivateMessage(ctx, in.MessageUuid, in.NewContent)
+		if err != nil {
+			return status.Errorf(codes.Internal, "failed to edit private message: %v", err)
+		}
+
+		edited = &chat.MessageEdited{
+			MessageUuid: data.MessageUUID.String(),
+			NewContent:  data.Content,
+			UpdatedAt:   data.UpdateAt.Format(time.RFC3339),
+		}
+		return s.addOutboxEvent(ctx, in.ChatUuid, &chat.ChatDomainEvent{Payload: &chat.ChatDomainEvent_MessageEdited{MessageEdited: edited}})
+	})
+	if err != nil {
+		logger.Error(status.Convert(err).Message())
+		return nil, err
+	}
+
+	s.publishChatEvent(ctx, in.ChatUuid, &chat.Event{Payload: &chat.Event_MessageEdited{MessageEdited: edited}})
+
+	return &chat.EditPrivateMessageOut{
+		MessageUuid: edited.MessageUuid,
+		NewContent:  edited.NewContent,
+		UpdatedAt:   edited.UpdatedAt,
+	}, nil
+}
+
+func (s *Server) GetMessageRevisions(ctx context.Context, in *chat.GetMessageRevisionsIn) (*chat.GetMessageRevisionsOut, error) {
+	logger := logger_lib.FromContext(ctx, config.KeyLogger)
+	logger.AddFuncName("GetMessageRevisions")
+
+	userUUID, ok := ctx.Value(config.KeyUUID).(string)
+	if !ok {
+		logger.Error("failed to find uuid")
+		return nil, status.Error(codes.Internal, "failed to find uuid")
+	}
+
+	member, err := s.repository.GetChatMember(ctx, in.ChatUuid, userUUID)
+	if err != nil {
+		logger.Error(fmt.Sprintf("failed to get chat member: %v", err))
+		return nil, status.Errorf(codes.Internal, "failed to get chat member: %v", err)
+	}
+
+	if member == nil {
+		logger.Error("failed to user is not chat member")
+		return nil, status.Error(codes.PermissionDenied, "failed to user is not chat member")
+	}
+
+	// полная история правок доступна автору сообщения и модераторам чата
+	if !member.CanModerate() {
+		isOwner, err := s.repository.IsMessageOwner(ctx, in.ChatUuid, in.MessageUuid, userUUID)
+		if err != nil {
+			logger.Error(fmt.Sprintf("failed to check message owner: %v", err))
+			return nil, status.Errorf(codes.Internal, "failed to check message owner: %v", err)
+		}
+
+		if !isOwner {
+			logger.Error("failed to user can not view message revisions")
+			return nil, status.Error(codes.PermissionDenied, "failed to user can not view message revisions")
+		}
+	}
+
+	revisions, err := s.repository.GetMessageRevisions(ctx, in.ChatUuid, in.MessageUuid, userUUID)
+	if err != nil {
+		logger.Error(fmt.Sprintf("failed to get message revisions: %v", err))
+		return nil, status.Errorf(codes.Internal, "failed to get message revisions: %v", err)
+	}
+
+	return &chat.GetMessageRevisionsOut{
+		Revisions: revisions.FromDTO(),
+	}, nil
+}
+
+func (s *Server) ForwardMessages(ctx context.Context, in *chat.ForwardMessagesIn) (*chat.ForwardMessagesOut, error) {
+	logger := logger_lib.FromContext(ctx, config.KeyLogger)
+	logger.AddFuncName("ForwardMessages")
+
+	userUUID, ok := ctx.Value(config.KeyUUID).(string)
+	if !ok {
+		logger.Error("failed to find uuid")
+		return nil, status.Error(codes.Internal, "failed to find uuid")
+	}
+
+	messageUUIDs, err := uniqueUUIDs(in.MessageUuids, "")
+	if err != nil {
+		logger.Error(fmt.Sprintf("failed to parse message uuids: %v", err))
+		return nil, status.Errorf(codes.InvalidArgument, "failed to parse message uuids: %v", err)
+	}
+
+	if len(messageUUIDs) == 0 || len(messageUUIDs) > maxForwardMessages {
+		logger.Error("failed to forward messages: invalid message count")
+		return nil, status.Errorf(codes.InvalidArgument, "failed to forward messages: message count must be between 1 and %d", maxForwardMessages)
+	}
+
+	for _, chatUUID := range []string{in.SourceChatUuid, in.TargetChatUuid} {
+		isMember, err := s.repository.IsChatMember(ctx, chatUUID, userUUID)
+		if err != nil {
+			logger.Error(fmt.Sprintf("failed to check user in chat: %v", err))
+			return nil, status.Errorf(codes.Internal, "failed to check user in chat: %v", err)
+		}
+
+		if !isMember {
+			logger.Error("failed to user is not chat member")
+			return nil, status.Error(codes.PermissionDenied, "failed to user is not chat member")
+		}
+	}
+
+	var forwarded []*chat.Message
+	err = s.inTx(ctx, func(ctx context.Context) error {
+		messages, err := s.repository.ForwardMessages(ctx, in.SourceChatUuid, in.TargetChatUuid, userUUID, messageUUIDs)
+		if err != nil {
+			return status.Errorf(codes.Internal, "failed to forward messages: %v", err)
+		}
+
+		if len(*messages) == 0 {
+			return status.Error(codes.NotFound, "failed to find messages to forward")
+		}
+
+		forwarded = messages.FromDTO()
+		for _, message := range forwarded {
+			err = s.addOutboxEvent(ctx, in.TargetChatUuid, messageSentDomainEvent(message))
+			if err != nil {
+				return err
+			}
+		}
+
+		return nil
+	})
+	if err != nil {
+		logger.Error(status.Convert(err).Message())
+		return nil, err
+	}
+
+	for _, message := range forwarded {
+		s.publishChatEvent(ctx, in.TargetChatUuid, messageSentEvent(message))
+	}
+
+	return &chat.ForwardMessagesOut{
+		Messages: forwarded,
+	}, nil
+}
+
+func (s *Server) SearchMessages(ctx context.Context, in *chat.SearchMessagesIn) (*chat.SearchMessagesOut, error) {
+	logger := logger_lib.FromContext(ctx, config.KeyLogger)
+	logger.AddFuncName("SearchMessages")
+
+	userUUID, ok := ctx.Value(config.KeyUUID).(string)
+	if !ok {
+		logger.Error("failed to find uuid")
+		return nil, status.Error(codes.Internal, "failed to find uuid")
+	}
+
+	searchQuery := strings.TrimSpace(in.Query)
+	if searchQuery == "" || utf8.RuneCountInString(searchQuery) > maxSearchQueryLength {
+		logger.Error("failed to search messages: invalid query length")
+		return nil, status.Errorf(codes.InvalidArgument, "failed to search messages: query must be between 1 and %d characters", maxSearchQueryLength)
+	}
+
+	page, err := messagePageFromRequest(in.Limit, in.Cursor, "")
+	if err != nil {
+		logger.Error(fmt.Sprintf("failed to parse page params: %v", err))
+		return nil, status.Errorf(codes.InvalidArgument, "failed to parse page params: %v", err)
+	}
+
+	// результаты поиска листаются только от новых сообщений к старым
+	if page.Forward {
+		logger.Error("failed to parse page params: forward cursor is not supported")
+		return nil, status.Error(codes.InvalidArgument, "failed to parse page params: forward cursor is not supported")
+	}
+
+	if in.ChatUuid != "" {
+		err = s.checkChatAccess(ctx, in.ChatUuid, userUUID)
+		if err != nil {
+			logger.Error(status.Convert(err).Message())
+			return nil, err
+		}
+	}
+
+	// запрашиваем на один результат больше, чтобы понять, есть ли следующая страница
+	limit := page.Limit
+	page.Limit++
+
+	results, err := s.repository.SearchMessages(ctx, userUUID, searchQuery, in.ChatUuid, page)
+	if err != nil {
+		logger.Error(fmt.Sprintf("failed to search messages: %v", err))
+		return nil, status.Errorf(codes.Internal, "failed to search messages: %v", err)
+	}
+
+	var nextCursor string
+	if uint64(len(*results)) > limit {
+		*results = (*results)[:limit]
+		nextCursor = results.NextCursor()
+	}
+
+	return &chat.SearchMessagesOut{
+		Results:    results.FromDTO(),
+		NextCursor: nextCursor,
+	}, nil
+}
+
+func (s *Server) DeletePrivateMessage(ctx context.Context, in *chat.DeletePrivateMessageIn) (*chat.DeletePrivateMessageOut, error) {
+	logger := logger_lib.FromContext(ctx, config.KeyLogger)
+	logger.AddFuncName("DeletePrivateMessage")
+
+	userUUID, ok := ctx.Value(config.KeyUUID).(string)
+	if !ok {
+		logger.Error("failed to find uuid")
+		return nil, status.Error(codes.Internal, "failed to find uuid")
+	}
+
+	userIsChatMember, err := s.repository.IsChatMember(ctx, in.ChatUuid, userUUID)
+	if err != nil {
+		logger.Error(fmt.Sprintf("failed to check if user is chat member: %v", err))
+		return nil, status.Errorf(codes.Internal, "failed to check user is chat member: %v", err)
+	}
+
+	if !userIsChatMember {
+		logger.Error("failed to user is not chat member")
+		return nil, status.Error(codes.PermissionDenied, "failed to user is not chat member")
+	}
+
+	if in.Mode != model.Self && in.Mode != model.All {
+		logger.Error(fmt.Sprintf("failed to invalid mode: %s", in.Mode))
+		return nil, status.Errorf(codes.Internal, "failed to invalid mode: %s", in.Mode)
+	}
+
+	deletionInfo, err := s.repository.GetPrivateDeletionInfo(ctx, in.ChatUuid, userUUID, in.MessageUuid)
+	if err != nil {
+		logger.Error(fmt.Sprintf("failed to get private message deletion info: %v", err))
+		return nil, status.Errorf(codes.Internal, "failed to get private message deletion info: %v", err)
+	}
+
+	if deletionInfo == nil {
+		logger.Error("failed to find message in chat")
+		return nil, status.Error(codes.NotFound, "failed to find message in chat")
+	}
+
+	if (deletionInfo.DeleteFormat == model.All) || (deletionInfo.DeleteFormat == model.Self && deletionInfo.DeletedBy == userUUID) {
+		logger.Error("failed to message is already deleted")
+		return nil, status.Error(codes.Internal, "failed to message is already deleted")
+	}
+
+	if deletionInfo.DeleteFormat == model.Self && deletionInfo.DeletedBy != userUUID {
+		in.Mode = model.All
+	}
+
+	messageDeleted := &chat.MessageDeleted{
+		MessageUuid: in.MessageUuid,
+		Mode:        in.Mode,
+	}
+
+	var isDeleted bool
+	err = s.inTx(ctx, func(ctx context.Context) error {
+		isDeleted, err = s.repository.DeletePrivateMessage(ctx, in.ChatUuid, userUUID, in.MessageUuid, in.Mode)
+		if err != nil {
+			return status.Errorf(codes.Internal, "failed to delete private message: %v", err)
+		}
+
+		if !isDeleted {
+			return status.Error(codes.NotFound, "failed to find message in chat")
+		}
+
+		// удаление для себя меняет только представление пользователя, другим сервисам оно не интересно
+		if in.Mode == model.Self {
+			return nil
+		}
+
+		return s.addOutboxEvent(ctx, in.ChatUuid, &chat.ChatDomainEvent{Payload: &chat.ChatDomainEvent_MessageDeleted{MessageDeleted: messageDeleted}})
+	})
+	if err != nil {
+		logger.Error(status.Convert(err).Message())
+		return nil, err
+	}
+
+	deleted := &chat.Event{Payload: &chat.Event_MessageDeleted{MessageDeleted: messageDeleted}}
+	// удаление для себя видно только самому пользователю
+	if in.Mode == model.Self {
+		s.publishUserEvent([]string{userUUID}, in.ChatUuid, deleted)
+	} else {
+		s.publishChatEvent(ctx, in.ChatUuid, deleted)
+	}
+
+	return &chat.DeletePrivateMessageOut{
+		DeletionStatus: isDeleted,
+	}, nil
+}
+
+func messagePageFromRequest(limit int32, rawCursor, afterMessageUUID string) (*model.MessagePage, error) {
+	if limit < 0 || limit > maxMessagesPageSize {
+		return nil, fmt.Errorf("limit must be between 0 and %d", maxMessagesPageSize)
+	}
+
+	page := &model.MessagePage{
+		Limit: defaultMessagesPageSize,
+	}
+	if limit > 0 {
+		page.Limit = uint64(limit)
+	}
+
+	if rawCursor != "" {
+		cursor, err := model.DecodeMessageCursor(rawCursor)
+		if err != nil {
+			return nil, err
+		}
+		page.Cursor = cursor
+		page.Forward = cursor.Forward
+
+		return page, nil
+	}
+
+	if afterMessageUUID != "" {
+		if _, err := uuid.Parse(afterMessageUUID); err != nil {
+			return nil, fmt.Errorf("invalid after_message_uuid: %v", err)
+		}
+		page.AfterMessageUUID = afterMessageUUID
+		page.Forward = true
+	}
+
+	return page, nil
+}
+
+func (s *Server) getMessagesPage(ctx context.Context, chatUUID, userUUID string, page *model.MessagePage) (*model.MessageList, string, error) {
+	// запрашиваем на одно сообщение больше, чтобы понять, есть ли следующая страница
+	limit := page.Limit
+	page.Limit++
+
+	messages, err := s.repository.GetPrivateRecentMessages(ctx, chatUUID, userUUID, page)
+	if err != nil {
+		return nil, "", err
+	}
+
+	var nextCursor string
+	if uint64(len(*messages)) > limit {
+		*messages = (*messages)[:limit]
+		nextCursor = messages.NextCursor(page.Forward)
+	}
+
+	return messages, nextCursor, nil
+}
+
+func (s *Server) getMemberParams(ctx context.Context, userUUID string) (*model.ChatMemberParams, error) {
+	userInfo, err := s.userClient.GetUserInfoByUUID(ctx, userUUID)
+	if err != nil {
+		return nil, err
+	}
+
+	return &model.ChatMemberParams{
+		UserUUID:   userUUID,
+		Nickname:   userInfo.Nickname,
+		AvatarLink: userInfo.AvatarLink,
+	}, nil
+}
+
+// checkGroupManager проверяет, что пользователь состоит в групповом чате и может управлять его участниками
+func (s *Server) checkGroupManager(ctx context.Context, chatUUID, userUUID string) (*model.ChatMember, error) {
+	member, err := s.repository.GetChatMember(ctx, chatUUID, userUUID)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get chat member: %v", err)
+	}
+
+	if member == nil || member.StreamType != model.StreamTypeGroup {
+		return nil, status.Error(codes.PermissionDenied, "failed to user is not group chat member")
+	}
+
+	if !member.CanManageMembers() {
+		return nil, status.Error(codes.PermissionDenied, "failed to user can not manage group members")
+	}
+
+	return member, nil
+}
+
+func (s *Server) checkCommentStream(ctx context.Context, chatUUID string) error {
+	streamType, err := s.repository.GetStreamType(ctx, chatUUID)
+	if err != nil {
+		return status.Errorf(codes.Internal, "failed to get chat type: %v", err)
+	}
+
+	if streamType != model.StreamTypeComment {
+		return status.Error(codes.NotFound, "failed to find comment stream")
+	}
+
+	return nil
+}
+
+// inTx выполняет fn в транзакции репозитория. fn возвращает status-ошибки,
+// ошибки открытия и фиксации транзакции приводятся к codes.Internal
+func (s *Server) inTx(ctx context.Context, fn func(ctx context.Context) error) error {
+	err := s.repository.WithTx(ctx, fn)
+	if _, ok := status.FromError(err); !ok {
+		return status.Errorf(codes.Internal, "failed to run transaction: %v", err)
+	}
+
+	return err
+}
+
+func (s *Server) checkChatAccess(ctx context.Context, chatUUID, userUUID string) error {
+	hasAccess, err := s.repository.HasChatAccess(ctx, chatUUID, userUUID)
+	if err != nil {
+		return status.Errorf(codes.Internal, "failed to check chat access: %v", err)
+	}
+
+	if !hasAccess {
+		return status.Error(codes.PermissionDenied, "failed to user has no access to chat")
+	}
+
+	return nil
+}
+
+func (s *Server) checkReactionTarget(ctx context.Context, chatUUID, messageUUID, userUUID, emoji string) error {
+	if err := validateEmoji(emoji); err != nil {
+		return status.Errorf(codes.InvalidArgument, "failed to validate emoji: %v", err)
+	}
+
+	isMember, err := s.repository.IsChatMember(ctx, chatUUID, userUUID)
+	if err != nil {
+		return status.Errorf(codes.Internal, "failed to check user in chat: %v", err)
+	}
+
+	if !isMember {
+		return status.Error(codes.PermissionDenied, "failed to user is not chat member")
+	}
+
+	exists, err := s.repository.MessageExists(ctx, chatUUID, messageUUID, userUUID)
+	if err != nil {
+		return status.Errorf(codes.Internal, "failed to check message: %v", err)
+	}
+
+	if !exists {
+		return status.Error(codes.NotFound, "failed to find message in chat")
+	}
+
+	return nil
+}
+
+// checkPinAccess проверяет право закреплять сообщения в чате. Открепление не требует существования сообщения:
+// закреп удаленного для всех сообщения иначе нельзя было бы снять
+func (s *Server) checkPinAccess(ctx context.Context, chatUUID, messageUUID, userUUID string, requireMessage bool) error {
+	if _, err := uuid.Parse(messageUUID); err != nil {
+		return status.Errorf(codes.InvalidArgument, "failed to parse message uuid: %v", err)
+	}
+
+	member, err := s.repository.GetChatMember(ctx, chatUUID, userUUID)
+	if err != nil {
+		return status.Errorf(codes.Internal, "failed to get chat member: %v", err)
+	}
+
+	if member == nil {
+		return status.Error(codes.PermissionDenied, "failed to user is not chat member")
+	}
+
+	if !member.CanPin() {
+		return status.Error(codes.PermissionDenied, "failed to user can not pin messages")
+	}
+
+	if !requireMessage {
+		return nil
+	}
+
+	exists, err := s.repository.MessageExists(ctx, chatUUID, messageUUID, userUUID)
+	if err != nil {
+		return status.Errorf(codes.Internal, "failed to check message: %v", err)
+	}
+
+	if !exists {
+		return status.Error(codes.NotFound, "failed to find message in chat")
+	}
+
+	return nil
+}
+
+func validateEmoji(emoji string) error {
+	if emoji == "" || utf8.RuneCountInString(emoji) > maxEmojiLength {
+		return fmt.Errorf("emoji must be between 1 and %d characters", maxEmojiLength)
+	}
+
+	// реакция должна быть эмодзи, а не произвольным текстом
+	hasEmojiRune := false
+	for _, r := range emoji {
+		if unicode.IsSpace(r) || unicode.IsControl(r) {
+			return errors.New("emoji must not contain whitespace")
+		}
+		if r > unicode.MaxASCII {
+			hasEmojiRune = true
+		}
+	}
+
+	if !hasEmojiRune {
+		return errors.New("emoji must not be plain text")
+	}
+
+	return nil
+}
+
+func uniqueUUIDs(uuids []string, excludeUUID string) ([]string, error) {
+	seen := make(map[string]struct{}, len(uuids))
+	result := make([]string, 0, len(uuids))
+
+	for _, value := range uuids {
+		if _, err := uuid.Parse(value); err != nil {
+			return nil, fmt.Errorf("invalid uuid %q: %v", value, err)
+		}
+
+		if _, ok := seen[value]; ok || value == excludeUUID {
+			continue
+		}
+
+		seen[value] = struct{}{}
+		result = append(result, value)
+	}
+
+	return result, nil
+}
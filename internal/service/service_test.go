@@ -9,6 +9,8 @@ import (
 	"github.com/golang/mock/gomock"
 	"github.com/google/uuid"
 	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc"
+	"google.golang.org/protobuf/encoding/protojson"
 	"google.golang.org/protobuf/types/known/emptypb"
 
 	logger_lib "github.com/s21platform/logger-lib"
@@ -18,6 +20,11 @@ import (
 	"github.com/s21platform/chat-service/pkg/chat"
 )
 
+// runInTx заменяет транзакцию в моке WithTx прямым вызовом fn
+func runInTx(ctx context.Context, fn func(ctx context.Context) error) error {
+	return fn(ctx)
+}
+
 func TestServer_CreatePrivateChat(t *testing.T) {
 	t.Parallel()
 
@@ -36,6 +43,8 @@ func TestServer_CreatePrivateChat(t *testing.T) {
 	ctx = context.WithValue(ctx, config.KeyUUID, initiatorUUID)
 
 	s := New(mockRepo, mockUserClient)
+	mockRepo.EXPECT().WithTx(gomock.Any(), gomock.Any()).DoAndReturn(runInTx).AnyTimes()
+	mockRepo.EXPECT().AddOutboxEvent(gomock.Any(), gomock.Any()).Return(nil).AnyTimes()
 
 	t.Run("success", func(t *testing.T) {
 		mockLogger.EXPECT().AddFuncName("CreatePrivateChat")
@@ -52,8 +61,8 @@ func TestServer_CreatePrivateChat(t *testing.T) {
 				AvatarLink: "test_avatar_link",
 			}, nil)
 
-		mockRepo.EXPECT().CreatePrivateChat(ctx).
-			Return("chat_uuid", nil)
+		mockRepo.EXPECT().GetOrCreatePrivateChat(ctx, model.PrivatePairKey(initiatorUUID, companionUUID)).
+			Return("chat_uuid", true, nil)
 		mockRepo.EXPECT().AddPrivateChatMember(ctx, gomock.Any(), gomock.Any()).
 			Return(nil)
 		mockRepo.EXPECT().AddPrivateChatMember(ctx, gomock.Any(), gomock.Any()).
@@ -66,6 +75,60 @@ func TestServer_CreatePrivateChat(t *testing.T) {
 		assert.NoError(t, err)
 		assert.NotNil(t, chatUUID)
 		assert.Equal(t, "chat_uuid", chatUUID.NewChatUuid)
+		assert.True(t, chatUUID.Created)
+	})
+
+	t.Run("existing_chat", func(t *testing.T) {
+		mockLogger.EXPECT().AddFuncName("CreatePrivateChat")
+
+		mockUserClient.EXPECT().GetUserInfoByUUID(ctx, initiatorUUID).
+			Return(&model.ChatMemberParams{
+				Nickname:   "test_initiator",
+				AvatarLink: "test_avatar_link",
+			}, nil)
+
+		mockUserClient.EXPECT().GetUserInfoByUUID(ctx, companionUUID).
+			Return(&model.ChatMemberParams{
+				Nickname:   "test_companion",
+				AvatarLink: "test_avatar_link",
+			}, nil)
+
+		mockRepo.EXPECT().GetOrCreatePrivateChat(ctx, model.PrivatePairKey(companionUUID, initiatorUUID)).
+			Return("chat_uuid", false, nil)
+		mockRepo.EXPECT().AddPrivateChatMember(ctx, "chat_uuid", gomock.Any()).
+			Return(nil).Times(2)
+
+		chatUUID, err := s.CreatePrivateChat(ctx, &chat.CreatePrivateChatIn{
+			CompanionUuid: companionUUID,
+		})
+
+		assert.NoError(t, err)
+		assert.Equal(t, "chat_uuid", chatUUID.NewChatUuid)
+		assert.False(t, chatUUID.Created)
+	})
+
+	t.Run("with_yourself", func(t *testing.T) {
+		mockLogger.EXPECT().AddFuncName("CreatePrivateChat")
+		mockLogger.EXPECT().Error("failed to create private chat with yourself")
+
+		_, err := s.CreatePrivateChat(ctx, &chat.CreatePrivateChatIn{
+			CompanionUuid: initiatorUUID,
+		})
+
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), "failed to create private chat with yourself")
+	})
+
+	t.Run("invalid_companion_uuid", func(t *testing.T) {
+		mockLogger.EXPECT().AddFuncName("CreatePrivateChat")
+		mockLogger.EXPECT().Error(gomock.Any())
+
+		_, err := s.CreatePrivateChat(ctx, &chat.CreatePrivateChatIn{
+			CompanionUuid: "not-a-uuid",
+		})
+
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), "failed to parse companion uuid")
 	})
 
 	t.Run("no_initiatorUUID", func(t *testing.T) {
@@ -134,8 +197,8 @@ func TestServer_CreatePrivateChat(t *testing.T) {
 				AvatarLink: "test_avatar_link",
 			}, nil)
 
-		mockRepo.EXPECT().CreatePrivateChat(ctx).
-			Return("", fmt.Errorf("failed to create chat"))
+		mockRepo.EXPECT().GetOrCreatePrivateChat(ctx, gomock.Any()).
+			Return("", false, fmt.Errorf("failed to create chat"))
 
 		_, err := s.CreatePrivateChat(ctx, &chat.CreatePrivateChatIn{
 			CompanionUuid: companionUUID,
@@ -161,8 +224,8 @@ func TestServer_CreatePrivateChat(t *testing.T) {
 				AvatarLink: "test_avatar_link",
 			}, nil)
 
-		mockRepo.EXPECT().CreatePrivateChat(ctx).
-			Return("chat_uuid", nil)
+		mockRepo.EXPECT().GetOrCreatePrivateChat(ctx, gomock.Any()).
+			Return("chat_uuid", true, nil)
 
 		mockRepo.EXPECT().AddPrivateChatMember(ctx, "chat_uuid", gomock.Any()).
 			Return(fmt.Errorf("failed to add initiator"))
@@ -191,8 +254,8 @@ func TestServer_CreatePrivateChat(t *testing.T) {
 				AvatarLink: "test_avatar_link",
 			}, nil)
 
-		mockRepo.EXPECT().CreatePrivateChat(ctx).
-			Return("chat_uuid", nil)
+		mockRepo.EXPECT().GetOrCreatePrivateChat(ctx, gomock.Any()).
+			Return("chat_uuid", true, nil)
 
 		mockRepo.EXPECT().AddPrivateChatMember(ctx, "chat_uuid", gomock.Any()).
 			Return(nil)
@@ -250,7 +313,8 @@ func TestServer_GetPrivateRecentMessages(t *testing.T) {
 			},
 		}
 
-		mockRepo.EXPECT().GetPrivateRecentMessages(ctx, chatUUID, userUUID).Return(expectedMessages, nil)
+		mockRepo.EXPECT().HasChatAccess(ctx, chatUUID, userUUID).Return(true, nil)
+		mockRepo.EXPECT().GetPrivateRecentMessages(ctx, chatUUID, userUUID, &model.MessagePage{Limit: 16}).Return(expectedMessages, nil)
 
 		messages, err := s.GetPrivateRecentMessages(ctx, &chat.GetPrivateRecentMessagesIn{
 			ChatUuid: chatUUID,
@@ -259,6 +323,110 @@ func TestServer_GetPrivateRecentMessages(t *testing.T) {
 		assert.NoError(t, err)
 		assert.NotNil(t, messages)
 		assert.Len(t, messages.Messages, 2)
+		assert.Empty(t, messages.NextCursor)
+	})
+
+	t.Run("success_next_page", func(t *testing.T) {
+		mockLogger.EXPECT().AddFuncName("GetRecentMessages")
+
+		lastSentAt := time.Now().Add(-time.Hour)
+		lastMessageUUID := uuid.New()
+		expectedMessages := &model.MessageList{
+			{ID: uuid.New(), Content: "message 2", SentAt: time.Now()},
+			{ID: lastMessageUUID, Content: "message 1", SentAt: lastSentAt},
+			{ID: uuid.New(), Content: "message 0", SentAt: lastSentAt.Add(-time.Minute)},
+		}
+
+		mockRepo.EXPECT().HasChatAccess(ctx, chatUUID, userUUID).Return(true, nil)
+		mockRepo.EXPECT().GetPrivateRecentMessages(ctx, chatUUID, userUUID, &model.MessagePage{Limit: 3}).Return(expectedMessages, nil)
+
+		messages, err := s.GetPrivateRecentMessages(ctx, &chat.GetPrivateRecentMessagesIn{
+			ChatUuid: chatUUID,
+			Limit:    2,
+		})
+
+		assert.NoError(t, err)
+		assert.Len(t, messages.Messages, 2)
+
+		cursor, err := model.DecodeMessageCursor(messages.NextCursor)
+		assert.NoError(t, err)
+		assert.Equal(t, lastMessageUUID.String(), cursor.ID)
+		assert.True(t, lastSentAt.Equal(cursor.SentAt))
+		assert.False(t, cursor.Forward)
+	})
+
+	t.Run("success_with_cursor", func(t *testing.T) {
+		mockLogger.EXPECT().AddFuncName("GetRecentMessages")
+
+		cursor := &model.MessageCursor{
+			SentAt:  time.Now().UTC(),
+			ID:      uuid.New().String(),
+			Forward: true,
+		}
+
+		mockRepo.EXPECT().HasChatAccess(ctx, chatUUID, userUUID).Return(true, nil)
+		mockRepo.EXPECT().GetPrivateRecentMessages(ctx, chatUUID, userUUID, gomock.Any()).
+			DoAndReturn(func(_ context.Context, _, _ string, page *model.MessagePage) (*model.MessageList, error) {
+				assert.True(t, page.Forward)
+				assert.Equal(t, cursor.ID, page.Cursor.ID)
+				assert.True(t, cursor.SentAt.Equal(page.Cursor.SentAt))
+				return &model.MessageList{}, nil
+			})
+
+		messages, err := s.GetPrivateRecentMessages(ctx, &chat.GetPrivateRecentMessagesIn{
+			ChatUuid: chatUUID,
+			Cursor:   cursor.Encode(),
+		})
+
+		assert.NoError(t, err)
+		assert.Empty(t, messages.Messages)
+		assert.Empty(t, messages.NextCursor)
+	})
+
+	t.Run("success_after_message", func(t *testing.T) {
+		mockLogger.EXPECT().AddFuncName("GetRecentMessages")
+
+		afterMessageUUID := uuid.New().String()
+
+		mockRepo.EXPECT().HasChatAccess(ctx, chatUUID, userUUID).Return(true, nil)
+		mockRepo.EXPECT().GetPrivateRecentMessages(ctx, chatUUID, userUUID, &model.MessagePage{
+			Limit:            16,
+			Forward:          true,
+			AfterMessageUUID: afterMessageUUID,
+		}).Return(&model.MessageList{}, nil)
+
+		_, err := s.GetPrivateRecentMessages(ctx, &chat.GetPrivateRecentMessagesIn{
+			ChatUuid:         chatUUID,
+			AfterMessageUuid: afterMessageUUID,
+		})
+
+		assert.NoError(t, err)
+	})
+
+	t.Run("invalid_cursor", func(t *testing.T) {
+		mockLogger.EXPECT().AddFuncName("GetRecentMessages")
+		mockLogger.EXPECT().Error(gomock.Any())
+
+		_, err := s.GetPrivateRecentMessages(ctx, &chat.GetPrivateRecentMessagesIn{
+			ChatUuid: chatUUID,
+			Cursor:   "not a cursor",
+		})
+
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), "failed to parse page params")
+	})
+
+	t.Run("invalid_limit", func(t *testing.T) {
+		mockLogger.EXPECT().AddFuncName("GetRecentMessages")
+		mockLogger.EXPECT().Error(gomock.Any())
+
+		_, err := s.GetPrivateRecentMessages(ctx, &chat.GetPrivateRecentMessagesIn{
+			ChatUuid: chatUUID,
+			Limit:    1000,
+		})
+
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), "failed to parse page params")
 	})
 
 	t.Run("no_userUUID", func(t *testing.T) {
@@ -275,11 +443,26 @@ func TestServer_GetPrivateRecentMessages(t *testing.T) {
 		assert.Contains(t, err.Error(), "failed to find uuid")
 	})
 
+	t.Run("no_access", func(t *testing.T) {
+		mockLogger.EXPECT().AddFuncName("GetRecentMessages")
+		mockLogger.EXPECT().Error("failed to user has no access to chat")
+
+		mockRepo.EXPECT().HasChatAccess(ctx, chatUUID, userUUID).Return(false, nil)
+
+		_, err := s.GetPrivateRecentMessages(ctx, &chat.GetPrivateRecentMessagesIn{
+			ChatUuid: chatUUID,
+		})
+
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), "failed to user has no access to chat")
+	})
+
 	t.Run("DB_error", func(t *testing.T) {
 		mockLogger.EXPECT().AddFuncName("GetRecentMessages")
 		mockLogger.EXPECT().Error(gomock.Any())
 
-		mockRepo.EXPECT().GetPrivateRecentMessages(ctx, gomock.Any(), gomock.Any()).Return(nil, fmt.Errorf("failed to fetch chat"))
+		mockRepo.EXPECT().HasChatAccess(ctx, chatUUID, userUUID).Return(true, nil)
+		mockRepo.EXPECT().GetPrivateRecentMessages(ctx, gomock.Any(), gomock.Any(), gomock.Any()).Return(nil, fmt.Errorf("failed to fetch chat"))
 
 		_, err := s.GetPrivateRecentMessages(ctx, &chat.GetPrivateRecentMessagesIn{
 			ChatUuid: chatUUID,
@@ -311,6 +494,8 @@ func TestServer_EditPrivateMessage(t *testing.T) {
 	ctx = context.WithValue(ctx, config.KeyUUID, userUUID)
 
 	s := New(mockRepo, mockUserClient)
+	mockRepo.EXPECT().WithTx(gomock.Any(), gomock.Any()).DoAndReturn(runInTx).AnyTimes()
+	mockRepo.EXPECT().AddOutboxEvent(gomock.Any(), gomock.Any()).Return(nil).AnyTimes()
 
 	t.Run("success", func(t *testing.T) {
 		mockLogger.EXPECT().AddFuncName("EditPrivateMessage")
@@ -319,7 +504,7 @@ func TestServer_EditPrivateMessage(t *testing.T) {
 			Return(true, nil)
 
 		deletionInfo := &model.DeletionInfo{}
-		mockRepo.EXPECT().GetPrivateDeletionInfo(ctx, messageUUID.String()).Return(deletionInfo, nil)
+		mockRepo.EXPECT().GetPrivateDeletionInfo(ctx, chatUUID, userUUID, messageUUID.String()).Return(deletionInfo, nil)
 
 		mockRepo.EXPECT().IsMessageOwner(ctx, chatUUID, messageUUID.String(), userUUID).
 			Return(true, nil)
@@ -399,7 +584,7 @@ func TestServer_EditPrivateMessage(t *testing.T) {
 		mockRepo.EXPECT().IsChatMember(ctx, gomock.Any(), gomock.Any()).
 			Return(true, nil)
 
-		mockRepo.EXPECT().GetPrivateDeletionInfo(ctx, messageUUID.String()).
+		mockRepo.EXPECT().GetPrivateDeletionInfo(ctx, chatUUID, userUUID, messageUUID.String()).
 			Return(nil, fmt.Errorf("failed to check deletion status"))
 
 		_, err := s.EditPrivateMessage(ctx, &chat.EditPrivateMessageIn{
@@ -419,7 +604,7 @@ func TestServer_EditPrivateMessage(t *testing.T) {
 		mockRepo.EXPECT().IsChatMember(ctx, gomock.Any(), gomock.Any()).
 			Return(true, nil)
 
-		mockRepo.EXPECT().GetPrivateDeletionInfo(ctx, messageUUID.String()).
+		mockRepo.EXPECT().GetPrivateDeletionInfo(ctx, chatUUID, userUUID, messageUUID.String()).
 			Return(&model.DeletionInfo{
 				DeletedAt: "time",
 			}, nil)
@@ -442,7 +627,7 @@ func TestServer_EditPrivateMessage(t *testing.T) {
 			Return(true, nil)
 
 		deletionInfo := &model.DeletionInfo{}
-		mockRepo.EXPECT().GetPrivateDeletionInfo(ctx, messageUUID.String()).Return(deletionInfo, nil)
+		mockRepo.EXPECT().GetPrivateDeletionInfo(ctx, chatUUID, userUUID, messageUUID.String()).Return(deletionInfo, nil)
 
 		mockRepo.EXPECT().IsMessageOwner(ctx, chatUUID, messageUUID.String(), userUUID).
 			Return(true, nil)
@@ -466,7 +651,7 @@ func TestServer_EditPrivateMessage(t *testing.T) {
 		mockRepo.EXPECT().IsChatMember(ctx, chatUUID, userUUID).
 			Return(true, nil)
 
-		mockRepo.EXPECT().GetPrivateDeletionInfo(ctx, messageUUID.String()).
+		mockRepo.EXPECT().GetPrivateDeletionInfo(ctx, chatUUID, userUUID, messageUUID.String()).
 			Return(&model.DeletionInfo{}, nil)
 
 		mockRepo.EXPECT().IsMessageOwner(ctx, chatUUID, messageUUID.String(), userUUID).
@@ -489,7 +674,7 @@ func TestServer_EditPrivateMessage(t *testing.T) {
 		mockRepo.EXPECT().IsChatMember(ctx, chatUUID, userUUID).
 			Return(true, nil)
 
-		mockRepo.EXPECT().GetPrivateDeletionInfo(ctx, messageUUID.String()).
+		mockRepo.EXPECT().GetPrivateDeletionInfo(ctx, chatUUID, userUUID, messageUUID.String()).
 			Return(&model.DeletionInfo{}, nil)
 
 		mockRepo.EXPECT().IsMessageOwner(ctx, chatUUID, messageUUID.String(), userUUID).
@@ -548,14 +733,27 @@ func TestServer_GetChats(t *testing.T) {
 			},
 		}
 
+		expChannels := &model.ChatInfoList{
+			{
+				LastMessage:          "News",
+				ChatName:             "Channel name",
+				AvatarURL:            "standart avatar url",
+				LastMessageTimestamp: &expectedLastMessageTime,
+				ChatUUID:             uuid.New().String(),
+				ChatType:             model.StreamTypeChannel,
+			},
+		}
+
 		mockRepo.EXPECT().GetPrivateChats(ctx, userUUID).Return(expPrivateChats, nil)
 		mockRepo.EXPECT().GetGroupChats(ctx, userUUID).Return(expGroupChats, nil)
+		mockRepo.EXPECT().GetChannels(ctx, userUUID).Return(expChannels, nil)
 
 		chats, err := s.GetChats(ctx, &emptypb.Empty{})
 
 		assert.NoError(t, err)
 		assert.NotNil(t, chats)
-		assert.Len(t, chats.Chats, 2)
+		assert.Len(t, chats.Chats, 3)
+		assert.Equal(t, model.StreamTypeChannel, chats.Chats[2].ChatType)
 	})
 
 	t.Run("no_userUUID", func(t *testing.T) {
@@ -596,6 +794,21 @@ func TestServer_GetChats(t *testing.T) {
 		assert.Error(t, err)
 		assert.Contains(t, err.Error(), expectedErr.Error())
 	})
+
+	t.Run("DB_channels_error", func(t *testing.T) {
+		expectedErr := fmt.Errorf("failed to get channels")
+
+		mockLogger.EXPECT().AddFuncName("GetChats")
+		mockLogger.EXPECT().Error(gomock.Any())
+		mockRepo.EXPECT().GetPrivateChats(ctx, userUUID).Return(&model.ChatInfoList{}, nil)
+		mockRepo.EXPECT().GetGroupChats(ctx, userUUID).Return(&model.ChatInfoList{}, nil)
+		mockRepo.EXPECT().GetChannels(ctx, userUUID).Return(nil, expectedErr)
+
+		_, err := s.GetChats(ctx, &emptypb.Empty{})
+
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), expectedErr.Error())
+	})
 }
 
 func TestServer_DeletePrivateMessage(t *testing.T) {
@@ -617,16 +830,18 @@ func TestServer_DeletePrivateMessage(t *testing.T) {
 	ctx = context.WithValue(ctx, config.KeyUUID, userUUID)
 
 	s := New(mockRepo, mockUserClient)
+	mockRepo.EXPECT().WithTx(gomock.Any(), gomock.Any()).DoAndReturn(runInTx).AnyTimes()
+	mockRepo.EXPECT().AddOutboxEvent(gomock.Any(), gomock.Any()).Return(nil).AnyTimes()
 
 	t.Run("success_self_to_all", func(t *testing.T) {
 		mockLogger.EXPECT().AddFuncName("DeletePrivateMessage")
 		mockRepo.EXPECT().IsChatMember(ctx, chatUUID, userUUID).Return(true, nil)
-		mockRepo.EXPECT().GetPrivateDeletionInfo(ctx, messageUUID).Return(&model.DeletionInfo{
+		mockRepo.EXPECT().GetPrivateDeletionInfo(ctx, chatUUID, userUUID, messageUUID).Return(&model.DeletionInfo{
 			DeletedBy:    uuid.New().String(),
 			DeleteFormat: model.Self,
 			DeletedAt:    time.Now().Format(time.RFC3339),
 		}, nil)
-		mockRepo.EXPECT().DeletePrivateMessage(ctx, userUUID, messageUUID, model.All).Return(true, nil)
+		mockRepo.EXPECT().DeletePrivateMessage(ctx, chatUUID, userUUID, messageUUID, model.All).Return(true, nil)
 
 		isDeleted, err := s.DeletePrivateMessage(ctx, &chat.DeletePrivateMessageIn{
 			ChatUuid:    chatUUID,
@@ -641,8 +856,8 @@ func TestServer_DeletePrivateMessage(t *testing.T) {
 	t.Run("success_direct_all", func(t *testing.T) {
 		mockLogger.EXPECT().AddFuncName("DeletePrivateMessage")
 		mockRepo.EXPECT().IsChatMember(ctx, chatUUID, userUUID).Return(true, nil)
-		mockRepo.EXPECT().GetPrivateDeletionInfo(ctx, messageUUID).Return(&model.DeletionInfo{}, nil)
-		mockRepo.EXPECT().DeletePrivateMessage(ctx, userUUID, messageUUID, model.All).Return(true, nil)
+		mockRepo.EXPECT().GetPrivateDeletionInfo(ctx, chatUUID, userUUID, messageUUID).Return(&model.DeletionInfo{}, nil)
+		mockRepo.EXPECT().DeletePrivateMessage(ctx, chatUUID, userUUID, messageUUID, model.All).Return(true, nil)
 
 		isDeleted, err := s.DeletePrivateMessage(ctx, &chat.DeletePrivateMessageIn{
 			ChatUuid:    chatUUID,
@@ -718,7 +933,7 @@ func TestServer_DeletePrivateMessage(t *testing.T) {
 	t.Run("already_deleted_all", func(t *testing.T) {
 		mockLogger.EXPECT().AddFuncName("DeletePrivateMessage")
 		mockRepo.EXPECT().IsChatMember(ctx, chatUUID, userUUID).Return(true, nil)
-		mockRepo.EXPECT().GetPrivateDeletionInfo(ctx, messageUUID).Return(&model.DeletionInfo{
+		mockRepo.EXPECT().GetPrivateDeletionInfo(ctx, chatUUID, userUUID, messageUUID).Return(&model.DeletionInfo{
 			DeletedBy:    uuid.New().String(),
 			DeleteFormat: model.All,
 			DeletedAt:    time.Now().Format(time.RFC3339),
@@ -738,7 +953,7 @@ func TestServer_DeletePrivateMessage(t *testing.T) {
 	t.Run("already_deleted_self_by_user", func(t *testing.T) {
 		mockLogger.EXPECT().AddFuncName("DeletePrivateMessage")
 		mockRepo.EXPECT().IsChatMember(ctx, chatUUID, userUUID).Return(true, nil)
-		mockRepo.EXPECT().GetPrivateDeletionInfo(ctx, messageUUID).Return(&model.DeletionInfo{
+		mockRepo.EXPECT().GetPrivateDeletionInfo(ctx, chatUUID, userUUID, messageUUID).Return(&model.DeletionInfo{
 			DeletedBy:    userUUID,
 			DeleteFormat: model.Self,
 			DeletedAt:    time.Now().Format(time.RFC3339),
@@ -759,7 +974,7 @@ func TestServer_DeletePrivateMessage(t *testing.T) {
 		expectedErr := fmt.Errorf("db error")
 		mockLogger.EXPECT().AddFuncName("DeletePrivateMessage")
 		mockRepo.EXPECT().IsChatMember(ctx, chatUUID, userUUID).Return(true, nil)
-		mockRepo.EXPECT().GetPrivateDeletionInfo(ctx, messageUUID).Return(nil, expectedErr)
+		mockRepo.EXPECT().GetPrivateDeletionInfo(ctx, chatUUID, userUUID, messageUUID).Return(nil, expectedErr)
 		mockLogger.EXPECT().Error(fmt.Sprintf("failed to get private message deletion info: %v", expectedErr))
 
 		_, err := s.DeletePrivateMessage(ctx, &chat.DeletePrivateMessageIn{
@@ -776,8 +991,8 @@ func TestServer_DeletePrivateMessage(t *testing.T) {
 		expectedErr := fmt.Errorf("db error")
 		mockLogger.EXPECT().AddFuncName("DeletePrivateMessage")
 		mockRepo.EXPECT().IsChatMember(ctx, chatUUID, userUUID).Return(true, nil)
-		mockRepo.EXPECT().GetPrivateDeletionInfo(ctx, messageUUID).Return(&model.DeletionInfo{}, nil)
-		mockRepo.EXPECT().DeletePrivateMessage(ctx, userUUID, messageUUID, model.All).Return(false, expectedErr)
+		mockRepo.EXPECT().GetPrivateDeletionInfo(ctx, chatUUID, userUUID, messageUUID).Return(&model.DeletionInfo{}, nil)
+		mockRepo.EXPECT().DeletePrivateMessage(ctx, chatUUID, userUUID, messageUUID, model.All).Return(false, expectedErr)
 		mockLogger.EXPECT().Error(fmt.Sprintf("failed to delete private message: %v", expectedErr))
 
 		_, err := s.DeletePrivateMessage(ctx, &chat.DeletePrivateMessageIn{
@@ -789,4 +1004,3310 @@ func TestServer_DeletePrivateMessage(t *testing.T) {
 		assert.Error(t, err)
 		assert.Contains(t, err.Error(), "failed to delete private message")
 	})
+
+	t.Run("message_not_in_chat", func(t *testing.T) {
+		mockLogger.EXPECT().AddFuncName("DeletePrivateMessage")
+		mockRepo.EXPECT().IsChatMember(ctx, chatUUID, userUUID).Return(true, nil)
+		mockRepo.EXPECT().GetPrivateDeletionInfo(ctx, chatUUID, userUUID, messageUUID).Return(nil, nil)
+		mockLogger.EXPECT().Error("failed to find message in chat")
+
+		_, err := s.DeletePrivateMessage(ctx, &chat.DeletePrivateMessageIn{
+			ChatUuid:    chatUUID,
+			MessageUuid: messageUUID,
+			Mode:        model.All,
+		})
+
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), "failed to find message in chat")
+	})
+
+	t.Run("message_hidden_on_delete", func(t *testing.T) {
+		mockLogger.EXPECT().AddFuncName("DeletePrivateMessage")
+		mockRepo.EXPECT().IsChatMember(ctx, chatUUID, userUUID).Return(true, nil)
+		mockRepo.EXPECT().GetPrivateDeletionInfo(ctx, chatUUID, userUUID, messageUUID).Return(&model.DeletionInfo{}, nil)
+		mockRepo.EXPECT().DeletePrivateMessage(ctx, chatUUID, userUUID, messageUUID, model.All).Return(false, nil)
+		mockLogger.EXPECT().Error("failed to find message in chat")
+
+		_, err := s.DeletePrivateMessage(ctx, &chat.DeletePrivateMessageIn{
+			ChatUuid:    chatUUID,
+			MessageUuid: messageUUID,
+			Mode:        model.All,
+		})
+
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), "failed to find message in chat")
+	})
+}
+
+func TestServer_SendPrivateMessage(t *testing.T) {
+	t.Parallel()
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockRepo := NewMockDBRepo(ctrl)
+	mockUserClient := NewMockUserClient(ctrl)
+	mockLogger := logger_lib.NewMockLoggerInterface(ctrl)
+
+	userUUID := uuid.New().String()
+	chatUUID := uuid.New().String()
+	parentUUID := uuid.New()
+	content := "hello"
+
+	ctx := context.Background()
+	ctx = context.WithValue(ctx, config.KeyLogger, mockLogger)
+	ctx = context.WithValue(ctx, config.KeyUUID, userUUID)
+
+	s := New(mockRepo, mockUserClient)
+	mockRepo.EXPECT().WithTx(gomock.Any(), gomock.Any()).DoAndReturn(runInTx).AnyTimes()
+	mockRepo.EXPECT().GetChatRecipients(gomock.Any(), gomock.Any()).Return(&model.EventRecipientList{}, nil).AnyTimes()
+
+	t.Run("success", func(t *testing.T) {
+		mockLogger.EXPECT().AddFuncName("SendPrivateMessage")
+
+		mockRepo.EXPECT().IsChatMember(ctx, chatUUID, userUUID).Return(true, nil)
+
+		sentAt := time.Now()
+		messageUUID := uuid.New()
+		mockRepo.EXPECT().SendPrivateMessage(ctx, &model.NewMessage{
+			ChatUUID:   chatUUID,
+			SenderUUID: userUUID,
+			Content:    content,
+			RootUUID:   parentUUID.String(),
+			ParentUUID: parentUUID.String(),
+			Type:       model.MessageTypeText,
+		}).Return(&model.Message{
+			ID:         messageUUID,
+			Uuid:       uuid.MustParse(userUUID),
+			Content:    content,
+			SentAt:     sentAt,
+			UpdatedAt:  sentAt,
+			RootUUID:   parentUUID,
+			ParentUUID: parentUUID,
+			Type:       model.MessageTypeText,
+		}, nil)
+		mockRepo.EXPECT().AddOutboxEvent(ctx, gomock.Any()).DoAndReturn(func(_ context.Context, event *model.NewOutboxEvent) error {
+			assert.Equal(t, chatUUID, event.ChatUUID)
+			assert.Equal(t, model.OutboxEventMessageSent, event.Type)
+
+			var domainEvent chat.ChatDomainEvent
+			assert.NoError(t, protojson.Unmarshal(event.Payload, &domainEvent))
+			assert.Equal(t, chatUUID, domainEvent.ChatUuid)
+			assert.Equal(t, messageUUID.String(), domainEvent.GetMessageSent().MessageUuid)
+			return nil
+		})
+
+		out, err := s.SendPrivateMessage(ctx, &chat.SendPrivateMessageIn{
+			ChatUuid:   chatUUID,
+			Content:    content,
+			RootUuid:   parentUUID.String(),
+			ParentUuid: parentUUID.String(),
+		})
+
+		assert.NoError(t, err)
+		assert.Equal(t, messageUUID.String(), out.Message.MessageUuid)
+		assert.Equal(t, userUUID, out.Message.Uuid)
+		assert.Equal(t, content, out.Message.Content)
+		assert.Equal(t, sentAt.Format(time.RFC3339), out.Message.SentAt)
+		assert.Equal(t, parentUUID.String(), out.Message.ParentUuid)
+	})
+
+	t.Run("no_userUUID", func(t *testing.T) {
+		badCtx := context.WithValue(context.Background(), config.KeyLogger, mockLogger)
+
+		mockLogger.EXPECT().AddFuncName("SendPrivateMessage")
+		mockLogger.EXPECT().Error("failed to find uuid")
+
+		_, err := s.SendPrivateMessage(badCtx, &chat.SendPrivateMessageIn{
+			ChatUuid: chatUUID,
+			Content:  content,
+		})
+
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), "failed to find uuid")
+	})
+
+	t.Run("empty_content", func(t *testing.T) {
+		mockLogger.EXPECT().AddFuncName("SendPrivateMessage")
+		mockLogger.EXPECT().Error("failed to send empty message")
+
+		_, err := s.SendPrivateMessage(ctx, &chat.SendPrivateMessageIn{
+			ChatUuid: chatUUID,
+			Content:  "   ",
+		})
+
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), "failed to send empty message")
+	})
+
+	t.Run("image_without_caption", func(t *testing.T) {
+		mockLogger.EXPECT().AddFuncName("SendPrivateMessage")
+
+		mockRepo.EXPECT().IsChatMember(ctx, chatUUID, userUUID).Return(true, nil)
+
+		media := &model.Media{
+			FileName:   "cat.png",
+			MimeType:   "image/png",
+			Size:       2048,
+			StorageKey: "chat/cat.png",
+			Width:      640,
+			Height:     480,
+		}
+		mockRepo.EXPECT().SendPrivateMessage(ctx, &model.NewMessage{
+			ChatUUID:   chatUUID,
+			SenderUUID: userUUID,
+			Type:       model.MessageTypeImage,
+			Media:      media,
+		}).Return(&model.Message{
+			ID:    uuid.New(),
+			Uuid:  uuid.MustParse(userUUID),
+			Type:  model.MessageTypeImage,
+			Media: media,
+		}, nil)
+		mockRepo.EXPECT().AddOutboxEvent(ctx, gomock.Any()).Return(nil)
+
+		out, err := s.SendPrivateMessage(ctx, &chat.SendPrivateMessageIn{
+			ChatUuid: chatUUID,
+			Attachment: &chat.Attachment{Payload: &chat.Attachment_Image{Image: &chat.ImageAttachment{
+				File: &chat.MediaFile{
+					FileName:   "cat.png",
+					MimeType:   "image/png",
+					Size:       2048,
+					StorageKey: "chat/cat.png",
+				},
+				Width:  640,
+				Height: 480,
+			}}},
+		})
+
+		assert.NoError(t, err)
+		assert.Equal(t, model.MessageTypeImage, out.Message.Type)
+		assert.Equal(t, int32(640), out.Message.Attachment.GetImage().Width)
+		assert.Equal(t, "chat/cat.png", out.Message.Attachment.GetImage().File.StorageKey)
+	})
+
+	t.Run("invalid_attachment", func(t *testing.T) {
+		mockLogger.EXPECT().AddFuncName("SendPrivateMessage")
+		mockLogger.EXPECT().Error(gomock.Any())
+
+		_, err := s.SendPrivateMessage(ctx, &chat.SendPrivateMessageIn{
+			ChatUuid: chatUUID,
+			Attachment: &chat.Attachment{Payload: &chat.Attachment_Speech{Speech: &chat.SpeechAttachment{
+				File: &chat.MediaFile{
+					MimeType:   "image/png",
+					Size:       2048,
+					StorageKey: "chat/voice.ogg",
+				},
+				DurationMs: 1500,
+			}}},
+		})
+
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), "failed to validate attachment")
+	})
+
+	t.Run("invalid_parent_uuid", func(t *testing.T) {
+		mockLogger.EXPECT().AddFuncName("SendPrivateMessage")
+		mockLogger.EXPECT().Error(gomock.Any())
+
+		_, err := s.SendPrivateMessage(ctx, &chat.SendPrivateMessageIn{
+			ChatUuid:   chatUUID,
+			Content:    content,
+			ParentUuid: "not-a-uuid",
+		})
+
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), "failed to parse reply uuid")
+	})
+
+	t.Run("IsChatMember_error", func(t *testing.T) {
+		mockLogger.EXPECT().AddFuncName("SendPrivateMessage")
+		mockLogger.EXPECT().Error(gomock.Any())
+
+		mockRepo.EXPECT().IsChatMember(ctx, chatUUID, userUUID).Return(false, fmt.Errorf("db error"))
+
+		_, err := s.SendPrivateMessage(ctx, &chat.SendPrivateMessageIn{
+			ChatUuid: chatUUID,
+			Content:  content,
+		})
+
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), "failed to check user in chat")
+	})
+
+	t.Run("not_chat_member", func(t *testing.T) {
+		mockLogger.EXPECT().AddFuncName("SendPrivateMessage")
+		mockLogger.EXPECT().Error("failed to user is not chat member")
+
+		mockRepo.EXPECT().IsChatMember(ctx, chatUUID, userUUID).Return(false, nil)
+
+		_, err := s.SendPrivateMessage(ctx, &chat.SendPrivateMessageIn{
+			ChatUuid: chatUUID,
+			Content:  content,
+		})
+
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), "failed to user is not chat member")
+	})
+
+	t.Run("DB_error", func(t *testing.T) {
+		mockLogger.EXPECT().AddFuncName("SendPrivateMessage")
+		mockLogger.EXPECT().Error(gomock.Any())
+
+		mockRepo.EXPECT().IsChatMember(ctx, chatUUID, userUUID).Return(true, nil)
+		mockRepo.EXPECT().SendPrivateMessage(ctx, gomock.Any()).Return(nil, fmt.Errorf("db error"))
+
+		_, err := s.SendPrivateMessage(ctx, &chat.SendPrivateMessageIn{
+			ChatUuid: chatUUID,
+			Content:  content,
+		})
+
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), "failed to send private message")
+	})
+
+	t.Run("outbox_error", func(t *testing.T) {
+		mockLogger.EXPECT().AddFuncName("SendPrivateMessage")
+		mockLogger.EXPECT().Error(gomock.Any())
+
+		mockRepo.EXPECT().IsChatMember(ctx, chatUUID, userUUID).Return(true, nil)
+		mockRepo.EXPECT().SendPrivateMessage(ctx, gomock.Any()).Return(&model.Message{
+			ID:   uuid.New(),
+			Uuid: uuid.MustParse(userUUID),
+		}, nil)
+		mockRepo.EXPECT().AddOutboxEvent(ctx, gomock.Any()).Return(fmt.Errorf("db error"))
+
+		_, err := s.SendPrivateMessage(ctx, &chat.SendPrivateMessageIn{
+			ChatUuid: chatUUID,
+			Content:  content,
+		})
+
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), "failed to add outbox event")
+	})
+}
+
+func TestServer_CreateGroupChat(t *testing.T) {
+	t.Parallel()
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockRepo := NewMockDBRepo(ctrl)
+	mockUserClient := NewMockUserClient(ctrl)
+	mockLogger := logger_lib.NewMockLoggerInterface(ctrl)
+
+	initiatorUUID := uuid.New().String()
+	memberUUID := uuid.New().String()
+	chatName := "study group"
+	avatarURL := "group_avatar_url"
+
+	ctx := context.Background()
+	ctx = context.WithValue(ctx, config.KeyLogger, mockLogger)
+	ctx = context.WithValue(ctx, config.KeyUUID, initiatorUUID)
+
+	s := New(mockRepo, mockUserClient)
+	mockRepo.EXPECT().WithTx(gomock.Any(), gomock.Any()).DoAndReturn(runInTx).AnyTimes()
+	mockRepo.EXPECT().AddOutboxEvent(gomock.Any(), gomock.Any()).Return(nil).AnyTimes()
+
+	initiatorParams := &model.ChatMemberParams{
+		UserUUID:   initiatorUUID,
+		Nickname:   "test_initiator",
+		AvatarLink: "test_avatar_link",
+	}
+	memberParams := &model.ChatMemberParams{
+		UserUUID:   memberUUID,
+		Nickname:   "test_member",
+		AvatarLink: "test_avatar_link",
+	}
+
+	t.Run("success", func(t *testing.T) {
+		mockLogger.EXPECT().AddFuncName("CreateGroupChat")
+
+		mockUserClient.EXPECT().GetUserInfoByUUID(ctx, initiatorUUID).Return(initiatorParams, nil)
+		mockUserClient.EXPECT().GetUserInfoByUUID(ctx, memberUUID).Return(memberParams, nil)
+
+		mockRepo.EXPECT().CreateGroupChat(ctx, initiatorParams, chatName, avatarURL).Return("chat_uuid", nil)
+		mockRepo.EXPECT().AddChatMember(ctx, "chat_uuid", initiatorParams, model.RoleOwner, "").Return(nil)
+		mockRepo.EXPECT().AddChatMember(ctx, "chat_uuid", memberParams, model.RoleMember, initiatorUUID).Return(nil)
+
+		out, err := s.CreateGroupChat(ctx, &chat.CreateGroupChatIn{
+			Name:        chatName,
+			AvatarUrl:   avatarURL,
+			MemberUuids: []string{memberUUID, memberUUID, initiatorUUID},
+		})
+
+		assert.NoError(t, err)
+		assert.Equal(t, "chat_uuid", out.NewChatUuid)
+	})
+
+	t.Run("no_initiatorUUID", func(t *testing.T) {
+		badCtx := context.WithValue(context.Background(), config.KeyLogger, mockLogger)
+
+		mockLogger.EXPECT().AddFuncName("CreateGroupChat")
+		mockLogger.EXPECT().Error("failed to get initiatorID")
+
+		_, err := s.CreateGroupChat(badCtx, &chat.CreateGroupChatIn{Name: chatName})
+
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), "failed to get initiatorID")
+	})
+
+	t.Run("empty_name", func(t *testing.T) {
+		mockLogger.EXPECT().AddFuncName("CreateGroupChat")
+		mockLogger.EXPECT().Error("failed to create group chat without name")
+
+		_, err := s.CreateGroupChat(ctx, &chat.CreateGroupChatIn{Name: " "})
+
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), "failed to create group chat without name")
+	})
+
+	t.Run("invalid_member_uuid", func(t *testing.T) {
+		mockLogger.EXPECT().AddFuncName("CreateGroupChat")
+		mockLogger.EXPECT().Error(gomock.Any())
+
+		_, err := s.CreateGroupChat(ctx, &chat.CreateGroupChatIn{
+			Name:        chatName,
+			MemberUuids: []string{"bad"},
+		})
+
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), "failed to parse member uuids")
+	})
+
+	t.Run("get_initiator_info_error", func(t *testing.T) {
+		mockLogger.EXPECT().AddFuncName("CreateGroupChat")
+		mockLogger.EXPECT().Error(gomock.Any())
+
+		mockUserClient.EXPECT().GetUserInfoByUUID(ctx, initiatorUUID).Return(nil, fmt.Errorf("user-service error"))
+
+		_, err := s.CreateGroupChat(ctx, &chat.CreateGroupChatIn{Name: chatName})
+
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), "failed to get initiator info")
+	})
+
+	t.Run("DB_error", func(t *testing.T) {
+		mockLogger.EXPECT().AddFuncName("CreateGroupChat")
+		mockLogger.EXPECT().Error(gomock.Any())
+
+		mockUserClient.EXPECT().GetUserInfoByUUID(ctx, initiatorUUID).Return(initiatorParams, nil)
+		mockRepo.EXPECT().CreateGroupChat(ctx, initiatorParams, chatName, "").Return("", fmt.Errorf("db error"))
+
+		_, err := s.CreateGroupChat(ctx, &chat.CreateGroupChatIn{Name: chatName})
+
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), "failed to create group chat")
+	})
+
+	t.Run("add_owner_error", func(t *testing.T) {
+		mockLogger.EXPECT().AddFuncName("CreateGroupChat")
+		mockLogger.EXPECT().Error(gomock.Any())
+
+		mockUserClient.EXPECT().GetUserInfoByUUID(ctx, initiatorUUID).Return(initiatorParams, nil)
+		mockRepo.EXPECT().CreateGroupChat(ctx, initiatorParams, chatName, "").Return("chat_uuid", nil)
+		mockRepo.EXPECT().AddChatMember(ctx, "chat_uuid", initiatorParams, model.RoleOwner, "").Return(fmt.Errorf("db error"))
+
+		_, err := s.CreateGroupChat(ctx, &chat.CreateGroupChatIn{Name: chatName})
+
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), "failed to add owner to group chat")
+	})
+
+	t.Run("add_member_error", func(t *testing.T) {
+		mockLogger.EXPECT().AddFuncName("CreateGroupChat")
+		mockLogger.EXPECT().Error(gomock.Any())
+
+		mockUserClient.EXPECT().GetUserInfoByUUID(ctx, initiatorUUID).Return(initiatorParams, nil)
+		mockUserClient.EXPECT().GetUserInfoByUUID(ctx, memberUUID).Return(memberParams, nil)
+		mockRepo.EXPECT().CreateGroupChat(ctx, initiatorParams, chatName, "").Return("chat_uuid", nil)
+		mockRepo.EXPECT().AddChatMember(ctx, "chat_uuid", initiatorParams, model.RoleOwner, "").Return(nil)
+		mockRepo.EXPECT().AddChatMember(ctx, "chat_uuid", memberParams, model.RoleMember, initiatorUUID).Return(fmt.Errorf("db error"))
+
+		_, err := s.CreateGroupChat(ctx, &chat.CreateGroupChatIn{
+			Name:        chatName,
+			MemberUuids: []string{memberUUID},
+		})
+
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), "failed to add member to group chat")
+	})
+}
+
+func TestServer_AddGroupMembers(t *testing.T) {
+	t.Parallel()
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockRepo := NewMockDBRepo(ctrl)
+	mockUserClient := NewMockUserClient(ctrl)
+	mockLogger := logger_lib.NewMockLoggerInterface(ctrl)
+
+	userUUID := uuid.New().String()
+	chatUUID := uuid.New().String()
+	newMemberUUID := uuid.New().String()
+	existingMemberUUID := uuid.New().String()
+
+	ctx := context.Background()
+	ctx = context.WithValue(ctx, config.KeyLogger, mockLogger)
+	ctx = context.WithValue(ctx, config.KeyUUID, userUUID)
+
+	s := New(mockRepo, mockUserClient)
+	mockRepo.EXPECT().WithTx(gomock.Any(), gomock.Any()).DoAndReturn(runInTx).AnyTimes()
+
+	t.Run("success", func(t *testing.T) {
+		mockLogger.EXPECT().AddFuncName("AddGroupMembers")
+
+		mockRepo.EXPECT().GetChatMember(ctx, chatUUID, userUUID).
+			Return(&model.ChatMember{StreamType: model.StreamTypeGroup, Role: model.RoleAdmin}, nil)
+		mockRepo.EXPECT().GetChatMember(ctx, chatUUID, newMemberUUID).Return(nil, nil)
+		mockRepo.EXPECT().IsMemberBanned(ctx, chatUUID, newMemberUUID).Return(false, nil)
+		mockRepo.EXPECT().GetChatMember(ctx, chatUUID, existingMemberUUID).
+			Return(&model.ChatMember{StreamType: model.StreamTypeGroup, Role: model.RoleMember}, nil)
+
+		mockUserClient.EXPECT().GetUserInfoByUUID(ctx, newMemberUUID).
+			Return(&model.ChatMemberParams{Nickname: "new_member"}, nil)
+		mockRepo.EXPECT().AddChatMember(ctx, chatUUID, &model.ChatMemberParams{
+			UserUUID: newMemberUUID,
+			Nickname: "new_member",
+		}, model.RoleMember, userUUID).Return(nil)
+
+		out, err := s.AddGroupMembers(ctx, &chat.AddGroupMembersIn{
+			ChatUuid:    chatUUID,
+			MemberUuids: []string{newMemberUUID, existingMemberUUID},
+		})
+
+		assert.NoError(t, err)
+		assert.Equal(t, []string{newMemberUUID}, out.AddedUuids)
+	})
+
+	t.Run("no_userUUID", func(t *testing.T) {
+		badCtx := context.WithValue(context.Background(), config.KeyLogger, mockLogger)
+
+		mockLogger.EXPECT().AddFuncName("AddGroupMembers")
+		mockLogger.EXPECT().Error("failed to find uuid")
+
+		_, err := s.AddGroupMembers(badCtx, &chat.AddGroupMembersIn{ChatUuid: chatUUID})
+
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), "failed to find uuid")
+	})
+
+	t.Run("empty_member_list", func(t *testing.T) {
+		mockLogger.EXPECT().AddFuncName("AddGroupMembers")
+		mockLogger.EXPECT().Error("failed to add group members: empty member list")
+
+		_, err := s.AddGroupMembers(ctx, &chat.AddGroupMembersIn{
+			ChatUuid:    chatUUID,
+			MemberUuids: []string{userUUID},
+		})
+
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), "empty member list")
+	})
+
+	t.Run("not_group_member", func(t *testing.T) {
+		mockLogger.EXPECT().AddFuncName("AddGroupMembers")
+		mockLogger.EXPECT().Error("failed to user is not group chat member")
+
+		mockRepo.EXPECT().GetChatMember(ctx, chatUUID, userUUID).Return(nil, nil)
+
+		_, err := s.AddGroupMembers(ctx, &chat.AddGroupMembersIn{
+			ChatUuid:    chatUUID,
+			MemberUuids: []string{newMemberUUID},
+		})
+
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), "failed to user is not group chat member")
+	})
+
+	t.Run("not_group_manager", func(t *testing.T) {
+		mockLogger.EXPECT().AddFuncName("AddGroupMembers")
+		mockLogger.EXPECT().Error("failed to user can not manage group members")
+
+		mockRepo.EXPECT().GetChatMember(ctx, chatUUID, userUUID).
+			Return(&model.ChatMember{StreamType: model.StreamTypeGroup, Role: model.RoleMember}, nil)
+
+		_, err := s.AddGroupMembers(ctx, &chat.AddGroupMembersIn{
+			ChatUuid:    chatUUID,
+			MemberUuids: []string{newMemberUUID},
+		})
+
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), "failed to user can not manage group members")
+	})
+
+	t.Run("DB_error", func(t *testing.T) {
+		mockLogger.EXPECT().AddFuncName("AddGroupMembers")
+		mockLogger.EXPECT().Error(gomock.Any())
+
+		mockRepo.EXPECT().GetChatMember(ctx, chatUUID, userUUID).
+			Return(&model.ChatMember{StreamType: model.StreamTypeGroup, Role: model.RoleOwner}, nil)
+		mockRepo.EXPECT().GetChatMember(ctx, chatUUID, newMemberUUID).Return(nil, nil)
+		mockRepo.EXPECT().IsMemberBanned(ctx, chatUUID, newMemberUUID).Return(false, nil)
+		mockUserClient.EXPECT().GetUserInfoByUUID(ctx, newMemberUUID).
+			Return(&model.ChatMemberParams{Nickname: "new_member"}, nil)
+		mockRepo.EXPECT().AddChatMember(ctx, chatUUID, gomock.Any(), model.RoleMember, userUUID).
+			Return(fmt.Errorf("db error"))
+
+		_, err := s.AddGroupMembers(ctx, &chat.AddGroupMembersIn{
+			ChatUuid:    chatUUID,
+			MemberUuids: []string{newMemberUUID},
+		})
+
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), "failed to add member to group chat")
+	})
+}
+
+func TestServer_RemoveGroupMember(t *testing.T) {
+	t.Parallel()
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockRepo := NewMockDBRepo(ctrl)
+	mockUserClient := NewMockUserClient(ctrl)
+	mockLogger := logger_lib.NewMockLoggerInterface(ctrl)
+
+	userUUID := uuid.New().String()
+	chatUUID := uuid.New().String()
+	memberUUID := uuid.New().String()
+
+	ctx := context.Background()
+	ctx = context.WithValue(ctx, config.KeyLogger, mockLogger)
+	ctx = context.WithValue(ctx, config.KeyUUID, userUUID)
+
+	s := New(mockRepo, mockUserClient)
+
+	in := &chat.RemoveGroupMemberIn{
+		ChatUuid:   chatUUID,
+		MemberUuid: memberUUID,
+	}
+
+	t.Run("success", func(t *testing.T) {
+		mockLogger.EXPECT().AddFuncName("RemoveGroupMember")
+
+		mockRepo.EXPECT().GetChatMember(ctx, chatUUID, userUUID).
+			Return(&model.ChatMember{StreamType: model.StreamTypeGroup, Role: model.RoleOwner}, nil)
+		mockRepo.EXPECT().GetChatMember(ctx, chatUUID, memberUUID).
+			Return(&model.ChatMember{StreamType: model.StreamTypeGroup, Role: model.RoleAdmin}, nil)
+		mockRepo.EXPECT().RemoveChatMember(ctx, chatUUID, memberUUID).Return(nil)
+
+		out, err := s.RemoveGroupMember(ctx, in)
+
+		assert.NoError(t, err)
+		assert.True(t, out.RemovalStatus)
+	})
+
+	t.Run("no_userUUID", func(t *testing.T) {
+		badCtx := context.WithValue(context.Background(), config.KeyLogger, mockLogger)
+
+		mockLogger.EXPECT().AddFuncName("RemoveGroupMember")
+		mockLogger.EXPECT().Error("failed to find uuid")
+
+		_, err := s.RemoveGroupMember(badCtx, in)
+
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), "failed to find uuid")
+	})
+
+	t.Run("not_group_manager", func(t *testing.T) {
+		mockLogger.EXPECT().AddFuncName("RemoveGroupMember")
+		mockLogger.EXPECT().Error("failed to user can not manage group members")
+
+		mockRepo.EXPECT().GetChatMember(ctx, chatUUID, userUUID).
+			Return(&model.ChatMember{StreamType: model.StreamTypeGroup, Role: model.RoleMember}, nil)
+
+		_, err := s.RemoveGroupMember(ctx, in)
+
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), "failed to user can not manage group members")
+	})
+
+	t.Run("target_not_member", func(t *testing.T) {
+		mockLogger.EXPECT().AddFuncName("RemoveGroupMember")
+		mockLogger.EXPECT().Error("failed to member is not in group chat")
+
+		mockRepo.EXPECT().GetChatMember(ctx, chatUUID, userUUID).
+			Return(&model.ChatMember{StreamType: model.StreamTypeGroup, Role: model.RoleOwner}, nil)
+		mockRepo.EXPECT().GetChatMember(ctx, chatUUID, memberUUID).Return(nil, nil)
+
+		_, err := s.RemoveGroupMember(ctx, in)
+
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), "failed to member is not in group chat")
+	})
+
+	t.Run("admin_removes_admin", func(t *testing.T) {
+		mockLogger.EXPECT().AddFuncName("RemoveGroupMember")
+		mockLogger.EXPECT().Error("failed to remove member with the same or higher role")
+
+		mockRepo.EXPECT().GetChatMember(ctx, chatUUID, userUUID).
+			Return(&model.ChatMember{StreamType: model.StreamTypeGroup, Role: model.RoleAdmin}, nil)
+		mockRepo.EXPECT().GetChatMember(ctx, chatUUID, memberUUID).
+			Return(&model.ChatMember{StreamType: model.StreamTypeGroup, Role: model.RoleAdmin}, nil)
+
+		_, err := s.RemoveGroupMember(ctx, in)
+
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), "failed to remove member with the same or higher role")
+	})
+
+	t.Run("DB_error", func(t *testing.T) {
+		mockLogger.EXPECT().AddFuncName("RemoveGroupMember")
+		mockLogger.EXPECT().Error(gomock.Any())
+
+		mockRepo.EXPECT().GetChatMember(ctx, chatUUID, userUUID).
+			Return(&model.ChatMember{StreamType: model.StreamTypeGroup, Role: model.RoleOwner}, nil)
+		mockRepo.EXPECT().GetChatMember(ctx, chatUUID, memberUUID).
+			Return(&model.ChatMember{StreamType: model.StreamTypeGroup, Role: model.RoleMember}, nil)
+		mockRepo.EXPECT().RemoveChatMember(ctx, chatUUID, memberUUID).Return(fmt.Errorf("db error"))
+
+		_, err := s.RemoveGroupMember(ctx, in)
+
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), "failed to remove group member")
+	})
+}
+
+func TestServer_CreateChannel(t *testing.T) {
+	t.Parallel()
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockRepo := NewMockDBRepo(ctrl)
+	mockUserClient := NewMockUserClient(ctrl)
+	mockLogger := logger_lib.NewMockLoggerInterface(ctrl)
+
+	initiatorUUID := uuid.New().String()
+	channelName := "announcements"
+
+	ctx := context.Background()
+	ctx = context.WithValue(ctx, config.KeyLogger, mockLogger)
+	ctx = context.WithValue(ctx, config.KeyUUID, initiatorUUID)
+
+	s := New(mockRepo, mockUserClient)
+	mockRepo.EXPECT().WithTx(gomock.Any(), gomock.Any()).DoAndReturn(runInTx).AnyTimes()
+	mockRepo.EXPECT().AddOutboxEvent(gomock.Any(), gomock.Any()).Return(nil).AnyTimes()
+
+	initiatorParams := &model.ChatMemberParams{
+		UserUUID: initiatorUUID,
+		Nickname: "test_initiator",
+	}
+
+	t.Run("success", func(t *testing.T) {
+		mockLogger.EXPECT().AddFuncName("CreateChannel")
+
+		mockUserClient.EXPECT().GetUserInfoByUUID(ctx, initiatorUUID).Return(initiatorParams, nil)
+		mockRepo.EXPECT().CreateChannel(ctx, initiatorParams, channelName, "avatar").Return("chat_uuid", nil)
+		mockRepo.EXPECT().AddChatMember(ctx, "chat_uuid", initiatorParams, model.RoleOwner, "").Return(nil)
+
+		out, err := s.CreateChannel(ctx, &chat.CreateChannelIn{
+			Name:      channelName,
+			AvatarUrl: "avatar",
+		})
+
+		assert.NoError(t, err)
+		assert.Equal(t, "chat_uuid", out.NewChatUuid)
+	})
+
+	t.Run("empty_name", func(t *testing.T) {
+		mockLogger.EXPECT().AddFuncName("CreateChannel")
+		mockLogger.EXPECT().Error("failed to create channel without name")
+
+		_, err := s.CreateChannel(ctx, &chat.CreateChannelIn{})
+
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), "failed to create channel without name")
+	})
+
+	t.Run("DB_error", func(t *testing.T) {
+		mockLogger.EXPECT().AddFuncName("CreateChannel")
+		mockLogger.EXPECT().Error(gomock.Any())
+
+		mockUserClient.EXPECT().GetUserInfoByUUID(ctx, initiatorUUID).Return(initiatorParams, nil)
+		mockRepo.EXPECT().CreateChannel(ctx, initiatorParams, channelName, "").Return("", fmt.Errorf("db error"))
+
+		_, err := s.CreateChannel(ctx, &chat.CreateChannelIn{Name: channelName})
+
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), "failed to create channel")
+	})
+
+	t.Run("add_owner_error", func(t *testing.T) {
+		mockLogger.EXPECT().AddFuncName("CreateChannel")
+		mockLogger.EXPECT().Error(gomock.Any())
+
+		mockUserClient.EXPECT().GetUserInfoByUUID(ctx, initiatorUUID).Return(initiatorParams, nil)
+		mockRepo.EXPECT().CreateChannel(ctx, initiatorParams, channelName, "").Return("chat_uuid", nil)
+		mockRepo.EXPECT().AddChatMember(ctx, "chat_uuid", initiatorParams, model.RoleOwner, "").Return(fmt.Errorf("db error"))
+
+		_, err := s.CreateChannel(ctx, &chat.CreateChannelIn{Name: channelName})
+
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), "failed to add owner to channel")
+	})
+}
+
+func TestServer_SubscribeChannel(t *testing.T) {
+	t.Parallel()
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockRepo := NewMockDBRepo(ctrl)
+	mockUserClient := NewMockUserClient(ctrl)
+	mockLogger := logger_lib.NewMockLoggerInterface(ctrl)
+
+	userUUID := uuid.New().String()
+	chatUUID := uuid.New().String()
+
+	ctx := context.Background()
+	ctx = context.WithValue(ctx, config.KeyLogger, mockLogger)
+	ctx = context.WithValue(ctx, config.KeyUUID, userUUID)
+
+	s := New(mockRepo, mockUserClient)
+
+	t.Run("success", func(t *testing.T) {
+		mockLogger.EXPECT().AddFuncName("SubscribeChannel")
+
+		mockRepo.EXPECT().GetStreamType(ctx, chatUUID).Return(model.StreamTypeChannel, nil)
+		mockRepo.EXPECT().IsMemberBanned(ctx, chatUUID, userUUID).Return(false, nil)
+		mockUserClient.EXPECT().GetUserInfoByUUID(ctx, userUUID).
+			Return(&model.ChatMemberParams{Nickname: "subscriber"}, nil)
+		mockRepo.EXPECT().SubscribeChannel(ctx, chatUUID, &model.ChatMemberParams{
+			UserUUID: userUUID,
+			Nickname: "subscriber",
+		}).Return(nil)
+
+		out, err := s.SubscribeChannel(ctx, &chat.SubscribeChannelIn{ChatUuid: chatUUID})
+
+		assert.NoError(t, err)
+		assert.True(t, out.SubscriptionStatus)
+	})
+
+	t.Run("not_channel", func(t *testing.T) {
+		mockLogger.EXPECT().AddFuncName("SubscribeChannel")
+		mockLogger.EXPECT().Error("failed to find channel")
+
+		mockRepo.EXPECT().GetStreamType(ctx, chatUUID).Return(model.StreamTypeGroup, nil)
+
+		_, err := s.SubscribeChannel(ctx, &chat.SubscribeChannelIn{ChatUuid: chatUUID})
+
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), "failed to find channel")
+	})
+
+	t.Run("DB_error", func(t *testing.T) {
+		mockLogger.EXPECT().AddFuncName("SubscribeChannel")
+		mockLogger.EXPECT().Error(gomock.Any())
+
+		mockRepo.EXPECT().GetStreamType(ctx, chatUUID).Return(model.StreamTypeChannel, nil)
+		mockRepo.EXPECT().IsMemberBanned(ctx, chatUUID, userUUID).Return(false, nil)
+		mockUserClient.EXPECT().GetUserInfoByUUID(ctx, userUUID).
+			Return(&model.ChatMemberParams{Nickname: "subscriber"}, nil)
+		mockRepo.EXPECT().SubscribeChannel(ctx, chatUUID, gomock.Any()).Return(fmt.Errorf("db error"))
+
+		_, err := s.SubscribeChannel(ctx, &chat.SubscribeChannelIn{ChatUuid: chatUUID})
+
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), "failed to subscribe channel")
+	})
+
+	t.Run("banned", func(t *testing.T) {
+		mockLogger.EXPECT().AddFuncName("SubscribeChannel")
+		mockLogger.EXPECT().Error("failed to user is banned in chat")
+
+		mockRepo.EXPECT().GetStreamType(ctx, chatUUID).Return(model.StreamTypeChannel, nil)
+		mockRepo.EXPECT().IsMemberBanned(ctx, chatUUID, userUUID).Return(true, nil)
+
+		_, err := s.SubscribeChannel(ctx, &chat.SubscribeChannelIn{ChatUuid: chatUUID})
+
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), "failed to user is banned in chat")
+	})
+}
+
+func TestServer_UnsubscribeChannel(t *testing.T) {
+	t.Parallel()
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockRepo := NewMockDBRepo(ctrl)
+	mockUserClient := NewMockUserClient(ctrl)
+	mockLogger := logger_lib.NewMockLoggerInterface(ctrl)
+
+	userUUID := uuid.New().String()
+	chatUUID := uuid.New().String()
+
+	ctx := context.Background()
+	ctx = context.WithValue(ctx, config.KeyLogger, mockLogger)
+	ctx = context.WithValue(ctx, config.KeyUUID, userUUID)
+
+	s := New(mockRepo, mockUserClient)
+
+	t.Run("success", func(t *testing.T) {
+		mockLogger.EXPECT().AddFuncName("UnsubscribeChannel")
+		mockRepo.EXPECT().UnsubscribeChannel(ctx, chatUUID, userUUID).Return(nil)
+
+		out, err := s.UnsubscribeChannel(ctx, &chat.UnsubscribeChannelIn{ChatUuid: chatUUID})
+
+		assert.NoError(t, err)
+		assert.True(t, out.UnsubscriptionStatus)
+	})
+
+	t.Run("DB_error", func(t *testing.T) {
+		mockLogger.EXPECT().AddFuncName("UnsubscribeChannel")
+		mockLogger.EXPECT().Error(gomock.Any())
+		mockRepo.EXPECT().UnsubscribeChannel(ctx, chatUUID, userUUID).Return(fmt.Errorf("db error"))
+
+		_, err := s.UnsubscribeChannel(ctx, &chat.UnsubscribeChannelIn{ChatUuid: chatUUID})
+
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), "failed to unsubscribe channel")
+	})
+}
+
+func TestServer_PublishToChannel(t *testing.T) {
+	t.Parallel()
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockRepo := NewMockDBRepo(ctrl)
+	mockUserClient := NewMockUserClient(ctrl)
+	mockLogger := logger_lib.NewMockLoggerInterface(ctrl)
+
+	userUUID := uuid.New().String()
+	chatUUID := uuid.New().String()
+	content := "release notes"
+
+	ctx := context.Background()
+	ctx = context.WithValue(ctx, config.KeyLogger, mockLogger)
+	ctx = context.WithValue(ctx, config.KeyUUID, userUUID)
+
+	s := New(mockRepo, mockUserClient)
+	mockRepo.EXPECT().WithTx(gomock.Any(), gomock.Any()).DoAndReturn(runInTx).AnyTimes()
+	mockRepo.EXPECT().AddOutboxEvent(gomock.Any(), gomock.Any()).Return(nil).AnyTimes()
+	mockRepo.EXPECT().GetChatRecipients(gomock.Any(), gomock.Any()).Return(&model.EventRecipientList{}, nil).AnyTimes()
+
+	t.Run("success", func(t *testing.T) {
+		mockLogger.EXPECT().AddFuncName("PublishToChannel")
+
+		mockRepo.EXPECT().GetChatMember(ctx, chatUUID, userUUID).
+			Return(&model.ChatMember{StreamType: model.StreamTypeChannel, Role: model.RoleOwner}, nil)
+		mockRepo.EXPECT().SendPrivateMessage(ctx, &model.NewMessage{
+			ChatUUID:   chatUUID,
+			SenderUUID: userUUID,
+			Content:    content,
+		}).Return(&model.Message{ID: uuid.New(), Content: content}, nil)
+
+		out, err := s.PublishToChannel(ctx, &chat.PublishToChannelIn{
+			ChatUuid: chatUUID,
+			Content:  content,
+		})
+
+		assert.NoError(t, err)
+		assert.Equal(t, content, out.Message.Content)
+	})
+
+	t.Run("empty_content", func(t *testing.T) {
+		mockLogger.EXPECT().AddFuncName("PublishToChannel")
+		mockLogger.EXPECT().Error("failed to send empty message")
+
+		_, err := s.PublishToChannel(ctx, &chat.PublishToChannelIn{ChatUuid: chatUUID})
+
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), "failed to send empty message")
+	})
+
+	t.Run("subscriber_can_not_publish", func(t *testing.T) {
+		mockLogger.EXPECT().AddFuncName("PublishToChannel")
+		mockLogger.EXPECT().Error("failed to user can not publish to channel")
+
+		mockRepo.EXPECT().GetChatMember(ctx, chatUUID, userUUID).Return(nil, nil)
+
+		_, err := s.PublishToChannel(ctx, &chat.PublishToChannelIn{
+			ChatUuid: chatUUID,
+			Content:  content,
+		})
+
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), "failed to user can not publish to channel")
+	})
+
+	t.Run("group_member_can_not_publish", func(t *testing.T) {
+		mockLogger.EXPECT().AddFuncName("PublishToChannel")
+		mockLogger.EXPECT().Error("failed to user can not publish to channel")
+
+		mockRepo.EXPECT().GetChatMember(ctx, chatUUID, userUUID).
+			Return(&model.ChatMember{StreamType: model.StreamTypeGroup, Role: model.RoleOwner}, nil)
+
+		_, err := s.PublishToChannel(ctx, &chat.PublishToChannelIn{
+			ChatUuid: chatUUID,
+			Content:  content,
+		})
+
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), "failed to user can not publish to channel")
+	})
+
+	t.Run("DB_error", func(t *testing.T) {
+		mockLogger.EXPECT().AddFuncName("PublishToChannel")
+		mockLogger.EXPECT().Error(gomock.Any())
+
+		mockRepo.EXPECT().GetChatMember(ctx, chatUUID, userUUID).
+			Return(&model.ChatMember{StreamType: model.StreamTypeChannel, Role: model.RoleAdmin}, nil)
+		mockRepo.EXPECT().SendPrivateMessage(ctx, gomock.Any()).Return(nil, fmt.Errorf("db error"))
+
+		_, err := s.PublishToChannel(ctx, &chat.PublishToChannelIn{
+			ChatUuid: chatUUID,
+			Content:  content,
+		})
+
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), "failed to publish to channel")
+	})
+}
+
+func TestServer_GetOrCreateCommentStream(t *testing.T) {
+	t.Parallel()
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockRepo := NewMockDBRepo(ctrl)
+	mockUserClient := NewMockUserClient(ctrl)
+	mockLogger := logger_lib.NewMockLoggerInterface(ctrl)
+
+	userUUID := uuid.New().String()
+	entityID := uuid.New().String()
+
+	ctx := context.Background()
+	ctx = context.WithValue(ctx, config.KeyLogger, mockLogger)
+	ctx = context.WithValue(ctx, config.KeyUUID, userUUID)
+
+	s := New(mockRepo, mockUserClient)
+
+	userParams := &model.ChatMemberParams{
+		UserUUID: userUUID,
+		Nickname: "commenter",
+	}
+
+	t.Run("success", func(t *testing.T) {
+		mockLogger.EXPECT().AddFuncName("GetOrCreateCommentStream")
+
+		mockUserClient.EXPECT().GetUserInfoByUUID(ctx, userUUID).Return(userParams, nil)
+		mockRepo.EXPECT().GetOrCreateCommentStream(ctx, userParams, "post", entityID).Return("chat_uuid", true, nil)
+
+		out, err := s.GetOrCreateCommentStream(ctx, &chat.GetOrCreateCommentStreamIn{
+			EntityType: "post",
+			EntityId:   entityID,
+		})
+
+		assert.NoError(t, err)
+		assert.Equal(t, "chat_uuid", out.ChatUuid)
+		assert.True(t, out.Created)
+	})
+
+	t.Run("invalid_entity", func(t *testing.T) {
+		mockLogger.EXPECT().AddFuncName("GetOrCreateCommentStream")
+		mockLogger.EXPECT().Error("failed to invalid comment entity")
+
+		_, err := s.GetOrCreateCommentStream(ctx, &chat.GetOrCreateCommentStreamIn{
+			EntityType: "Post Type",
+			EntityId:   entityID,
+		})
+
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), "failed to invalid comment entity")
+	})
+
+	t.Run("DB_error", func(t *testing.T) {
+		mockLogger.EXPECT().AddFuncName("GetOrCreateCommentStream")
+		mockLogger.EXPECT().Error(gomock.Any())
+
+		mockUserClient.EXPECT().GetUserInfoByUUID(ctx, userUUID).Return(userParams, nil)
+		mockRepo.EXPECT().GetOrCreateCommentStream(ctx, userParams, "project", entityID).Return("", false, fmt.Errorf("db error"))
+
+		_, err := s.GetOrCreateCommentStream(ctx, &chat.GetOrCreateCommentStreamIn{
+			EntityType: "project",
+			EntityId:   entityID,
+		})
+
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), "failed to get comment stream")
+	})
+}
+
+func TestServer_GetComments(t *testing.T) {
+	t.Parallel()
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockRepo := NewMockDBRepo(ctrl)
+	mockUserClient := NewMockUserClient(ctrl)
+	mockLogger := logger_lib.NewMockLoggerInterface(ctrl)
+
+	userUUID := uuid.New().String()
+	chatUUID := uuid.New().String()
+
+	ctx := context.Background()
+	ctx = context.WithValue(ctx, config.KeyLogger, mockLogger)
+	ctx = context.WithValue(ctx, config.KeyUUID, userUUID)
+
+	s := New(mockRepo, mockUserClient)
+
+	t.Run("success", func(t *testing.T) {
+		mockLogger.EXPECT().AddFuncName("GetComments")
+
+		mockRepo.EXPECT().GetStreamType(ctx, chatUUID).Return(model.StreamTypeComment, nil)
+		mockRepo.EXPECT().GetPrivateRecentMessages(ctx, chatUUID, userUUID, &model.MessagePage{Limit: 2}).
+			Return(&model.MessageList{
+				{ID: uuid.New(), Content: "second", SentAt: time.Now()},
+				{ID: uuid.New(), Content: "first", SentAt: time.Now().Add(-time.Minute)},
+			}, nil)
+
+		out, err := s.GetComments(ctx, &chat.GetCommentsIn{
+			ChatUuid: chatUUID,
+			Limit:    1,
+		})
+
+		assert.NoError(t, err)
+		assert.Len(t, out.Comments, 1)
+		assert.NotEmpty(t, out.NextCursor)
+	})
+
+	t.Run("not_comment_stream", func(t *testing.T) {
+		mockLogger.EXPECT().AddFuncName("GetComments")
+		mockLogger.EXPECT().Error("failed to find comment stream")
+
+		mockRepo.EXPECT().GetStreamType(ctx, chatUUID).Return(model.StreamTypePrivate, nil)
+
+		_, err := s.GetComments(ctx, &chat.GetCommentsIn{ChatUuid: chatUUID})
+
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), "failed to find comment stream")
+	})
+
+	t.Run("DB_error", func(t *testing.T) {
+		mockLogger.EXPECT().AddFuncName("GetComments")
+		mockLogger.EXPECT().Error(gomock.Any())
+
+		mockRepo.EXPECT().GetStreamType(ctx, chatUUID).Return(model.StreamTypeComment, nil)
+		mockRepo.EXPECT().GetPrivateRecentMessages(ctx, chatUUID, userUUID, gomock.Any()).Return(nil, fmt.Errorf("db error"))
+
+		_, err := s.GetComments(ctx, &chat.GetCommentsIn{ChatUuid: chatUUID})
+
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), "failed to fetch comments")
+	})
+}
+
+func TestServer_PostComment(t *testing.T) {
+	t.Parallel()
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockRepo := NewMockDBRepo(ctrl)
+	mockUserClient := NewMockUserClient(ctrl)
+	mockLogger := logger_lib.NewMockLoggerInterface(ctrl)
+
+	userUUID := uuid.New().String()
+	chatUUID := uuid.New().String()
+	content := "nice post"
+
+	ctx := context.Background()
+	ctx = context.WithValue(ctx, config.KeyLogger, mockLogger)
+	ctx = context.WithValue(ctx, config.KeyUUID, userUUID)
+
+	s := New(mockRepo, mockUserClient)
+	mockRepo.EXPECT().WithTx(gomock.Any(), gomock.Any()).DoAndReturn(runInTx).AnyTimes()
+	mockRepo.EXPECT().AddOutboxEvent(gomock.Any(), gomock.Any()).Return(nil).AnyTimes()
+	mockRepo.EXPECT().GetChatRecipients(gomock.Any(), gomock.Any()).Return(&model.EventRecipientList{}, nil).AnyTimes()
+
+	userParams := &model.ChatMemberParams{
+		UserUUID: userUUID,
+		Nickname: "commenter",
+	}
+
+	t.Run("success", func(t *testing.T) {
+		mockLogger.EXPECT().AddFuncName("PostComment")
+
+		mockRepo.EXPECT().GetStreamType(ctx, chatUUID).Return(model.StreamTypeComment, nil)
+		mockUserClient.EXPECT().GetUserInfoByUUID(ctx, userUUID).Return(userParams, nil)
+		mockRepo.EXPECT().EnsureChatMember(ctx, chatUUID, userParams, model.RoleMember).Return(nil)
+		mockRepo.EXPECT().SendPrivateMessage(ctx, &model.NewMessage{
+			ChatUUID:   chatUUID,
+			SenderUUID: userUUID,
+			Content:    content,
+		}).Return(&model.Message{ID: uuid.New(), Content: content}, nil)
+
+		out, err := s.PostComment(ctx, &chat.PostCommentIn{
+			ChatUuid: chatUUID,
+			Content:  content,
+		})
+
+		assert.NoError(t, err)
+		assert.Equal(t, content, out.Comment.Content)
+	})
+
+	t.Run("empty_content", func(t *testing.T) {
+		mockLogger.EXPECT().AddFuncName("PostComment")
+		mockLogger.EXPECT().Error("failed to send empty message")
+
+		_, err := s.PostComment(ctx, &chat.PostCommentIn{ChatUuid: chatUUID})
+
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), "failed to send empty message")
+	})
+
+	t.Run("not_comment_stream", func(t *testing.T) {
+		mockLogger.EXPECT().AddFuncName("PostComment")
+		mockLogger.EXPECT().Error("failed to find comment stream")
+
+		mockRepo.EXPECT().GetStreamType(ctx, chatUUID).Return("", nil)
+
+		_, err := s.PostComment(ctx, &chat.PostCommentIn{
+			ChatUuid: chatUUID,
+			Content:  content,
+		})
+
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), "failed to find comment stream")
+	})
+
+	t.Run("join_error", func(t *testing.T) {
+		mockLogger.EXPECT().AddFuncName("PostComment")
+		mockLogger.EXPECT().Error(gomock.Any())
+
+		mockRepo.EXPECT().GetStreamType(ctx, chatUUID).Return(model.StreamTypeComment, nil)
+		mockUserClient.EXPECT().GetUserInfoByUUID(ctx, userUUID).Return(userParams, nil)
+		mockRepo.EXPECT().EnsureChatMember(ctx, chatUUID, userParams, model.RoleMember).Return(fmt.Errorf("db error"))
+
+		_, err := s.PostComment(ctx, &chat.PostCommentIn{
+			ChatUuid: chatUUID,
+			Content:  content,
+		})
+
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), "failed to join comment stream")
+	})
+
+	t.Run("DB_error", func(t *testing.T) {
+		mockLogger.EXPECT().AddFuncName("PostComment")
+		mockLogger.EXPECT().Error(gomock.Any())
+
+		mockRepo.EXPECT().GetStreamType(ctx, chatUUID).Return(model.StreamTypeComment, nil)
+		mockUserClient.EXPECT().GetUserInfoByUUID(ctx, userUUID).Return(userParams, nil)
+		mockRepo.EXPECT().EnsureChatMember(ctx, chatUUID, userParams, model.RoleMember).Return(nil)
+		mockRepo.EXPECT().SendPrivateMessage(ctx, gomock.Any()).Return(nil, fmt.Errorf("db error"))
+
+		_, err := s.PostComment(ctx, &chat.PostCommentIn{
+			ChatUuid: chatUUID,
+			Content:  content,
+		})
+
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), "failed to post comment")
+	})
+}
+
+func TestServer_MarkMessagesRead(t *testing.T) {
+	t.Parallel()
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockRepo := NewMockDBRepo(ctrl)
+	mockUserClient := NewMockUserClient(ctrl)
+	mockLogger := logger_lib.NewMockLoggerInterface(ctrl)
+
+	userUUID := uuid.New().String()
+	chatUUID := uuid.New().String()
+	messageUUID := uuid.New().String()
+
+	ctx := context.Background()
+	ctx = context.WithValue(ctx, config.KeyLogger, mockLogger)
+	ctx = context.WithValue(ctx, config.KeyUUID, userUUID)
+
+	s := New(mockRepo, mockUserClient)
+
+	t.Run("success", func(t *testing.T) {
+		mockLogger.EXPECT().AddFuncName("MarkMessagesRead")
+		mockRepo.EXPECT().HasChatAccess(ctx, chatUUID, userUUID).Return(true, nil)
+		mockRepo.EXPECT().MarkMessagesRead(ctx, chatUUID, userUUID, messageUUID).Return(int64(3), nil)
+
+		out, err := s.MarkMessagesRead(ctx, &chat.MarkMessagesReadIn{ChatUuid: chatUUID, UpToMessageUuid: messageUUID})
+
+		assert.NoError(t, err)
+		assert.Equal(t, int64(3), out.MarkedCount)
+	})
+
+	t.Run("invalid_message_uuid", func(t *testing.T) {
+		mockLogger.EXPECT().AddFuncName("MarkMessagesRead")
+		mockLogger.EXPECT().Error(gomock.Any())
+
+		_, err := s.MarkMessagesRead(ctx, &chat.MarkMessagesReadIn{ChatUuid: chatUUID, UpToMessageUuid: "bad"})
+
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), "failed to parse message uuid")
+	})
+
+	t.Run("no_access", func(t *testing.T) {
+		mockLogger.EXPECT().AddFuncName("MarkMessagesRead")
+		mockLogger.EXPECT().Error(gomock.Any())
+		mockRepo.EXPECT().HasChatAccess(ctx, chatUUID, userUUID).Return(false, nil)
+
+		_, err := s.MarkMessagesRead(ctx, &chat.MarkMessagesReadIn{ChatUuid: chatUUID, UpToMessageUuid: messageUUID})
+
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), "failed to user has no access to chat")
+	})
+
+	t.Run("DB_error", func(t *testing.T) {
+		mockLogger.EXPECT().AddFuncName("MarkMessagesRead")
+		mockLogger.EXPECT().Error(gomock.Any())
+		mockRepo.EXPECT().HasChatAccess(ctx, chatUUID, userUUID).Return(true, nil)
+		mockRepo.EXPECT().MarkMessagesRead(ctx, chatUUID, userUUID, messageUUID).Return(int64(0), fmt.Errorf("db error"))
+
+		_, err := s.MarkMessagesRead(ctx, &chat.MarkMessagesReadIn{ChatUuid: chatUUID, UpToMessageUuid: messageUUID})
+
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), "failed to mark messages read")
+	})
+}
+
+func TestServer_GetMessageReaders(t *testing.T) {
+	t.Parallel()
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockRepo := NewMockDBRepo(ctrl)
+	mockUserClient := NewMockUserClient(ctrl)
+	mockLogger := logger_lib.NewMockLoggerInterface(ctrl)
+
+	userUUID := uuid.New().String()
+	chatUUID := uuid.New().String()
+	messageUUID := uuid.New().String()
+
+	ctx := context.Background()
+	ctx = context.WithValue(ctx, config.KeyLogger, mockLogger)
+	ctx = context.WithValue(ctx, config.KeyUUID, userUUID)
+
+	s := New(mockRepo, mockUserClient)
+
+	t.Run("success", func(t *testing.T) {
+		mockLogger.EXPECT().AddFuncName("GetMessageReaders")
+		mockRepo.EXPECT().HasChatAccess(ctx, chatUUID, userUUID).Return(true, nil)
+		mockRepo.EXPECT().GetMessageReaders(ctx, chatUUID, messageUUID, userUUID).Return(&model.MessageReaderList{
+			{UserUUID: userUUID, Nickname: "reader", ReadAt: time.Now()},
+		}, nil)
+
+		out, err := s.GetMessageReaders(ctx, &chat.GetMessageReadersIn{ChatUuid: chatUUID, MessageUuid: messageUUID})
+
+		assert.NoError(t, err)
+		assert.Len(t, out.Readers, 1)
+		assert.Equal(t, userUUID, out.Readers[0].UserUuid)
+	})
+
+	t.Run("no_access", func(t *testing.T) {
+		mockLogger.EXPECT().AddFuncName("GetMessageReaders")
+		mockLogger.EXPECT().Error(gomock.Any())
+		mockRepo.EXPECT().HasChatAccess(ctx, chatUUID, userUUID).Return(false, nil)
+
+		_, err := s.GetMessageReaders(ctx, &chat.GetMessageReadersIn{ChatUuid: chatUUID, MessageUuid: messageUUID})
+
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), "failed to user has no access to chat")
+	})
+
+	t.Run("DB_error", func(t *testing.T) {
+		mockLogger.EXPECT().AddFuncName("GetMessageReaders")
+		mockLogger.EXPECT().Error(gomock.Any())
+		mockRepo.EXPECT().HasChatAccess(ctx, chatUUID, userUUID).Return(true, nil)
+		mockRepo.EXPECT().GetMessageReaders(ctx, chatUUID, messageUUID, userUUID).Return(nil, fmt.Errorf("db error"))
+
+		_, err := s.GetMessageReaders(ctx, &chat.GetMessageReadersIn{ChatUuid: chatUUID, MessageUuid: messageUUID})
+
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), "failed to get message readers")
+	})
+
+	t.Run("rejoined_member_hidden_history", func(t *testing.T) {
+		rejoinedUUID := uuid.New().String()
+		rejoinedCtx := context.WithValue(ctx, config.KeyUUID, rejoinedUUID)
+
+		mockLogger.EXPECT().AddFuncName("GetMessageReaders")
+		mockRepo.EXPECT().HasChatAccess(rejoinedCtx, chatUUID, rejoinedUUID).Return(true, nil)
+		// сообщение отправлено до возвращения участника, поэтому запрос с его uuid ничего не находит
+		mockRepo.EXPECT().GetMessageReaders(rejoinedCtx, chatUUID, messageUUID, rejoinedUUID).Return(&model.MessageReaderList{}, nil)
+
+		out, err := s.GetMessageReaders(rejoinedCtx, &chat.GetMessageReadersIn{ChatUuid: chatUUID, MessageUuid: messageUUID})
+
+		assert.NoError(t, err)
+		assert.Empty(t, out.Readers)
+	})
+}
+
+func TestServer_GetMessageRevisions(t *testing.T) {
+	t.Parallel()
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockRepo := NewMockDBRepo(ctrl)
+	mockUserClient := NewMockUserClient(ctrl)
+	mockLogger := logger_lib.NewMockLoggerInterface(ctrl)
+
+	userUUID := uuid.New().String()
+	chatUUID := uuid.New().String()
+	messageUUID := uuid.New().String()
+
+	ctx := context.Background()
+	ctx = context.WithValue(ctx, config.KeyLogger, mockLogger)
+	ctx = context.WithValue(ctx, config.KeyUUID, userUUID)
+
+	s := New(mockRepo, mockUserClient)
+
+	writtenAt := time.Now().Add(-time.Hour)
+	revisions := &model.MessageRevisionList{
+		{Content: "first version", WrittenAt: writtenAt, ReplacedAt: writtenAt.Add(time.Minute)},
+	}
+
+	t.Run("success_author", func(t *testing.T) {
+		mockLogger.EXPECT().AddFuncName("GetMessageRevisions")
+		mockRepo.EXPECT().GetChatMember(ctx, chatUUID, userUUID).
+			Return(&model.ChatMember{StreamType: model.StreamTypePrivate}, nil)
+		mockRepo.EXPECT().IsMessageOwner(ctx, chatUUID, messageUUID, userUUID).Return(true, nil)
+		mockRepo.EXPECT().GetMessageRevisions(ctx, chatUUID, messageUUID, userUUID).Return(revisions, nil)
+
+		out, err := s.GetMessageRevisions(ctx, &chat.GetMessageRevisionsIn{ChatUuid: chatUUID, MessageUuid: messageUUID})
+
+		assert.NoError(t, err)
+		assert.Len(t, out.Revisions, 1)
+		assert.Equal(t, "first version", out.Revisions[0].Content)
+		assert.Equal(t, writtenAt.Format(time.RFC3339), out.Revisions[0].WrittenAt)
+	})
+
+	t.Run("success_moderator", func(t *testing.T) {
+		mockLogger.EXPECT().AddFuncName("GetMessageRevisions")
+		mockRepo.EXPECT().GetChatMember(ctx, chatUUID, userUUID).
+			Return(&model.ChatMember{StreamType: model.StreamTypeGroup, Role: model.RoleAdmin}, nil)
+		mockRepo.EXPECT().GetMessageRevisions(ctx, chatUUID, messageUUID, userUUID).Return(revisions, nil)
+
+		out, err := s.GetMessageRevisions(ctx, &chat.GetMessageRevisionsIn{ChatUuid: chatUUID, MessageUuid: messageUUID})
+
+		assert.NoError(t, err)
+		assert.Len(t, out.Revisions, 1)
+	})
+
+	t.Run("not_chat_member", func(t *testing.T) {
+		mockLogger.EXPECT().AddFuncName("GetMessageRevisions")
+		mockLogger.EXPECT().Error("failed to user is not chat member")
+		mockRepo.EXPECT().GetChatMember(ctx, chatUUID, userUUID).Return(nil, nil)
+
+		_, err := s.GetMessageRevisions(ctx, &chat.GetMessageRevisionsIn{ChatUuid: chatUUID, MessageUuid: messageUUID})
+
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), "failed to user is not chat member")
+	})
+
+	t.Run("not_author", func(t *testing.T) {
+		mockLogger.EXPECT().AddFuncName("GetMessageRevisions")
+		mockLogger.EXPECT().Error("failed to user can not view message revisions")
+		mockRepo.EXPECT().GetChatMember(ctx, chatUUID, userUUID).
+			Return(&model.ChatMember{StreamType: model.StreamTypeGroup, Role: model.RoleMember}, nil)
+		mockRepo.EXPECT().IsMessageOwner(ctx, chatUUID, messageUUID, userUUID).Return(false, nil)
+
+		_, err := s.GetMessageRevisions(ctx, &chat.GetMessageRevisionsIn{ChatUuid: chatUUID, MessageUuid: messageUUID})
+
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), "failed to user can not view message revisions")
+	})
+
+	t.Run("DB_error", func(t *testing.T) {
+		mockLogger.EXPECT().AddFuncName("GetMessageRevisions")
+		mockLogger.EXPECT().Error(gomock.Any())
+		mockRepo.EXPECT().GetChatMember(ctx, chatUUID, userUUID).
+			Return(&model.ChatMember{StreamType: model.StreamTypeGroup, Role: model.RoleOwner}, nil)
+		mockRepo.EXPECT().GetMessageRevisions(ctx, chatUUID, messageUUID, userUUID).Return(nil, fmt.Errorf("db error"))
+
+		_, err := s.GetMessageRevisions(ctx, &chat.GetMessageRevisionsIn{ChatUuid: chatUUID, MessageUuid: messageUUID})
+
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), "failed to get message revisions")
+	})
+}
+
+func TestServer_AddReaction(t *testing.T) {
+	t.Parallel()
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockRepo := NewMockDBRepo(ctrl)
+	mockUserClient := NewMockUserClient(ctrl)
+	mockLogger := logger_lib.NewMockLoggerInterface(ctrl)
+
+	userUUID := uuid.New().String()
+	chatUUID := uuid.New().String()
+	messageUUID := uuid.New().String()
+	emoji := "👍"
+
+	ctx := context.Background()
+	ctx = context.WithValue(ctx, config.KeyLogger, mockLogger)
+	ctx = context.WithValue(ctx, config.KeyUUID, userUUID)
+
+	s := New(mockRepo, mockUserClient)
+
+	t.Run("success", func(t *testing.T) {
+		mockLogger.EXPECT().AddFuncName("AddReaction")
+		mockRepo.EXPECT().IsChatMember(ctx, chatUUID, userUUID).Return(true, nil)
+		mockRepo.EXPECT().MessageExists(ctx, chatUUID, messageUUID, userUUID).Return(true, nil)
+		mockRepo.EXPECT().AddReaction(ctx, messageUUID, userUUID, emoji).Return(nil)
+		mockRepo.EXPECT().GetMessageReactions(ctx, messageUUID, userUUID).
+			Return(model.ReactionList{{Emoji: emoji, Count: 2, ReactedByMe: true}}, nil)
+
+		out, err := s.AddReaction(ctx, &chat.AddReactionIn{ChatUuid: chatUUID, MessageUuid: messageUUID, Emoji: emoji})
+
+		assert.NoError(t, err)
+		assert.Len(t, out.Reactions, 1)
+		assert.Equal(t, int64(2), out.Reactions[0].Count)
+		assert.True(t, out.Reactions[0].ReactedByMe)
+	})
+
+	t.Run("plain_text_emoji", func(t *testing.T) {
+		mockLogger.EXPECT().AddFuncName("AddReaction")
+		mockLogger.EXPECT().Error(gomock.Any())
+
+		_, err := s.AddReaction(ctx, &chat.AddReactionIn{ChatUuid: chatUUID, MessageUuid: messageUUID, Emoji: "ok"})
+
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), "failed to validate emoji")
+	})
+
+	t.Run("not_chat_member", func(t *testing.T) {
+		mockLogger.EXPECT().AddFuncName("AddReaction")
+		mockLogger.EXPECT().Error("failed to user is not chat member")
+		mockRepo.EXPECT().IsChatMember(ctx, chatUUID, userUUID).Return(false, nil)
+
+		_, err := s.AddReaction(ctx, &chat.AddReactionIn{ChatUuid: chatUUID, MessageUuid: messageUUID, Emoji: emoji})
+
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), "failed to user is not chat member")
+	})
+
+	t.Run("rejoined_member_hidden_history", func(t *testing.T) {
+		rejoinedUUID := uuid.New().String()
+		rejoinedCtx := context.WithValue(ctx, config.KeyUUID, rejoinedUUID)
+
+		mockLogger.EXPECT().AddFuncName("AddReaction")
+		mockLogger.EXPECT().Error("failed to find message in chat")
+		mockRepo.EXPECT().IsChatMember(rejoinedCtx, chatUUID, rejoinedUUID).Return(true, nil)
+		// участник снова в чате, но сообщение из истории до его возвращения ему не видно
+		mockRepo.EXPECT().MessageExists(rejoinedCtx, chatUUID, messageUUID, rejoinedUUID).Return(false, nil)
+
+		_, err := s.AddReaction(rejoinedCtx, &chat.AddReactionIn{ChatUuid: chatUUID, MessageUuid: messageUUID, Emoji: emoji})
+
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), "failed to find message in chat")
+	})
+
+	t.Run("message_not_found", func(t *testing.T) {
+		mockLogger.EXPECT().AddFuncName("AddReaction")
+		mockLogger.EXPECT().Error("failed to find message in chat")
+		mockRepo.EXPECT().IsChatMember(ctx, chatUUID, userUUID).Return(true, nil)
+		mockRepo.EXPECT().MessageExists(ctx, chatUUID, messageUUID, userUUID).Return(false, nil)
+
+		_, err := s.AddReaction(ctx, &chat.AddReactionIn{ChatUuid: chatUUID, MessageUuid: messageUUID, Emoji: emoji})
+
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), "failed to find message in chat")
+	})
+
+	t.Run("DB_error", func(t *testing.T) {
+		mockLogger.EXPECT().AddFuncName("AddReaction")
+		mockLogger.EXPECT().Error(gomock.Any())
+		mockRepo.EXPECT().IsChatMember(ctx, chatUUID, userUUID).Return(true, nil)
+		mockRepo.EXPECT().MessageExists(ctx, chatUUID, messageUUID, userUUID).Return(true, nil)
+		mockRepo.EXPECT().AddReaction(ctx, messageUUID, userUUID, emoji).Return(fmt.Errorf("db error"))
+
+		_, err := s.AddReaction(ctx, &chat.AddReactionIn{ChatUuid: chatUUID, MessageUuid: messageUUID, Emoji: emoji})
+
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), "failed to add reaction")
+	})
+}
+
+func TestServer_RemoveReaction(t *testing.T) {
+	t.Parallel()
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockRepo := NewMockDBRepo(ctrl)
+	mockUserClient := NewMockUserClient(ctrl)
+	mockLogger := logger_lib.NewMockLoggerInterface(ctrl)
+
+	userUUID := uuid.New().String()
+	chatUUID := uuid.New().String()
+	messageUUID := uuid.New().String()
+	emoji := "🔥"
+
+	ctx := context.Background()
+	ctx = context.WithValue(ctx, config.KeyLogger, mockLogger)
+	ctx = context.WithValue(ctx, config.KeyUUID, userUUID)
+
+	s := New(mockRepo, mockUserClient)
+
+	t.Run("success", func(t *testing.T) {
+		mockLogger.EXPECT().AddFuncName("RemoveReaction")
+		mockRepo.EXPECT().IsChatMember(ctx, chatUUID, userUUID).Return(true, nil)
+		mockRepo.EXPECT().MessageExists(ctx, chatUUID, messageUUID, userUUID).Return(true, nil)
+		mockRepo.EXPECT().RemoveReaction(ctx, messageUUID, userUUID, emoji).Return(nil)
+		mockRepo.EXPECT().GetMessageReactions(ctx, messageUUID, userUUID).Return(model.ReactionList{}, nil)
+
+		out, err := s.RemoveReaction(ctx, &chat.RemoveReactionIn{ChatUuid: chatUUID, MessageUuid: messageUUID, Emoji: emoji})
+
+		assert.NoError(t, err)
+		assert.Empty(t, out.Reactions)
+	})
+
+	t.Run("DB_error", func(t *testing.T) {
+		mockLogger.EXPECT().AddFuncName("RemoveReaction")
+		mockLogger.EXPECT().Error(gomock.Any())
+		mockRepo.EXPECT().IsChatMember(ctx, chatUUID, userUUID).Return(true, nil)
+		mockRepo.EXPECT().MessageExists(ctx, chatUUID, messageUUID, userUUID).Return(true, nil)
+		mockRepo.EXPECT().RemoveReaction(ctx, messageUUID, userUUID, emoji).Return(fmt.Errorf("db error"))
+
+		_, err := s.RemoveReaction(ctx, &chat.RemoveReactionIn{ChatUuid: chatUUID, MessageUuid: messageUUID, Emoji: emoji})
+
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), "failed to remove reaction")
+	})
+}
+
+func TestServer_PinMessage(t *testing.T) {
+	t.Parallel()
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockRepo := NewMockDBRepo(ctrl)
+	mockUserClient := NewMockUserClient(ctrl)
+	mockLogger := logger_lib.NewMockLoggerInterface(ctrl)
+
+	userUUID := uuid.New().String()
+	chatUUID := uuid.New().String()
+	messageUUID := uuid.New().String()
+
+	ctx := context.Background()
+	ctx = context.WithValue(ctx, config.KeyLogger, mockLogger)
+	ctx = context.WithValue(ctx, config.KeyUUID, userUUID)
+
+	s := New(mockRepo, mockUserClient)
+	mockRepo.EXPECT().WithTx(gomock.Any(), gomock.Any()).DoAndReturn(runInTx).AnyTimes()
+	mockRepo.EXPECT().AddOutboxEvent(gomock.Any(), gomock.Any()).Return(nil).AnyTimes()
+	mockRepo.EXPECT().GetChatRecipients(gomock.Any(), gomock.Any()).Return(&model.EventRecipientList{}, nil).AnyTimes()
+
+	t.Run("success_private", func(t *testing.T) {
+		mockLogger.EXPECT().AddFuncName("PinMessage")
+		mockRepo.EXPECT().GetChatMember(ctx, chatUUID, userUUID).
+			Return(&model.ChatMember{StreamType: model.StreamTypePrivate}, nil)
+		mockRepo.EXPECT().MessageExists(ctx, chatUUID, messageUUID, userUUID).Return(true, nil)
+		mockRepo.EXPECT().PinMessage(ctx, chatUUID, messageUUID, userUUID).Return(true, nil)
+		mockRepo.EXPECT().SendPrivateMessage(ctx, &model.NewMessage{
+			ChatUUID:   chatUUID,
+			SenderUUID: userUUID,
+			Content:    model.SystemMessagePinned,
+			ParentUUID: messageUUID,
+			Type:       model.MessageTypeSystem,
+		}).Return(&model.Message{}, nil)
+
+		out, err := s.PinMessage(ctx, &chat.PinMessageIn{ChatUuid: chatUUID, MessageUuid: messageUUID})
+
+		assert.NoError(t, err)
+		assert.True(t, out.PinStatus)
+	})
+
+	t.Run("already_pinned", func(t *testing.T) {
+		mockLogger.EXPECT().AddFuncName("PinMessage")
+		mockRepo.EXPECT().GetChatMember(ctx, chatUUID, userUUID).
+			Return(&model.ChatMember{StreamType: model.StreamTypeGroup, Role: model.RoleAdmin}, nil)
+		mockRepo.EXPECT().MessageExists(ctx, chatUUID, messageUUID, userUUID).Return(true, nil)
+		mockRepo.EXPECT().PinMessage(ctx, chatUUID, messageUUID, userUUID).Return(false, nil)
+
+		out, err := s.PinMessage(ctx, &chat.PinMessageIn{ChatUuid: chatUUID, MessageUuid: messageUUID})
+
+		assert.NoError(t, err)
+		assert.False(t, out.PinStatus)
+	})
+
+	t.Run("group_member_can_not_pin", func(t *testing.T) {
+		mockLogger.EXPECT().AddFuncName("PinMessage")
+		mockLogger.EXPECT().Error("failed to user can not pin messages")
+		mockRepo.EXPECT().GetChatMember(ctx, chatUUID, userUUID).
+			Return(&model.ChatMember{StreamType: model.StreamTypeGroup, Role: model.RoleMember}, nil)
+
+		_, err := s.PinMessage(ctx, &chat.PinMessageIn{ChatUuid: chatUUID, MessageUuid: messageUUID})
+
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), "failed to user can not pin messages")
+	})
+
+	t.Run("message_not_found", func(t *testing.T) {
+		mockLogger.EXPECT().AddFuncName("PinMessage")
+		mockLogger.EXPECT().Error("failed to find message in chat")
+		mockRepo.EXPECT().GetChatMember(ctx, chatUUID, userUUID).
+			Return(&model.ChatMember{StreamType: model.StreamTypePrivate}, nil)
+		mockRepo.EXPECT().MessageExists(ctx, chatUUID, messageUUID, userUUID).Return(false, nil)
+
+		_, err := s.PinMessage(ctx, &chat.PinMessageIn{ChatUuid: chatUUID, MessageUuid: messageUUID})
+
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), "failed to find message in chat")
+	})
+
+	t.Run("DB_error", func(t *testing.T) {
+		mockLogger.EXPECT().AddFuncName("PinMessage")
+		mockLogger.EXPECT().Error(gomock.Any())
+		mockRepo.EXPECT().GetChatMember(ctx, chatUUID, userUUID).
+			Return(&model.ChatMember{StreamType: model.StreamTypePrivate}, nil)
+		mockRepo.EXPECT().MessageExists(ctx, chatUUID, messageUUID, userUUID).Return(true, nil)
+		mockRepo.EXPECT().PinMessage(ctx, chatUUID, messageUUID, userUUID).Return(false, fmt.Errorf("db error"))
+
+		_, err := s.PinMessage(ctx, &chat.PinMessageIn{ChatUuid: chatUUID, MessageUuid: messageUUID})
+
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), "failed to pin message")
+	})
+}
+
+func TestServer_UnpinMessage(t *testing.T) {
+	t.Parallel()
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockRepo := NewMockDBRepo(ctrl)
+	mockUserClient := NewMockUserClient(ctrl)
+	mockLogger := logger_lib.NewMockLoggerInterface(ctrl)
+
+	userUUID := uuid.New().String()
+	chatUUID := uuid.New().String()
+	messageUUID := uuid.New().String()
+
+	ctx := context.Background()
+	ctx = context.WithValue(ctx, config.KeyLogger, mockLogger)
+	ctx = context.WithValue(ctx, config.KeyUUID, userUUID)
+
+	s := New(mockRepo, mockUserClient)
+
+	t.Run("success", func(t *testing.T) {
+		mockLogger.EXPECT().AddFuncName("UnpinMessage")
+		mockRepo.EXPECT().GetChatMember(ctx, chatUUID, userUUID).
+			Return(&model.ChatMember{StreamType: model.StreamTypeGroup, Role: model.RoleOwner}, nil)
+		mockRepo.EXPECT().UnpinMessage(ctx, chatUUID, messageUUID).Return(true, nil)
+
+		out, err := s.UnpinMessage(ctx, &chat.UnpinMessageIn{ChatUuid: chatUUID, MessageUuid: messageUUID})
+
+		assert.NoError(t, err)
+		assert.True(t, out.UnpinStatus)
+	})
+
+	t.Run("success_message_deleted_for_all", func(t *testing.T) {
+		mockLogger.EXPECT().AddFuncName("UnpinMessage")
+		mockRepo.EXPECT().GetChatMember(ctx, chatUUID, userUUID).
+			Return(&model.ChatMember{StreamType: model.StreamTypeGroup, Role: model.RoleAdmin}, nil)
+		mockRepo.EXPECT().MessageExists(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Times(0)
+		mockRepo.EXPECT().UnpinMessage(ctx, chatUUID, messageUUID).Return(true, nil)
+
+		out, err := s.UnpinMessage(ctx, &chat.UnpinMessageIn{ChatUuid: chatUUID, MessageUuid: messageUUID})
+
+		assert.NoError(t, err)
+		assert.True(t, out.UnpinStatus)
+	})
+
+	t.Run("not_chat_member", func(t *testing.T) {
+		mockLogger.EXPECT().AddFuncName("UnpinMessage")
+		mockLogger.EXPECT().Error("failed to user is not chat member")
+		mockRepo.EXPECT().GetChatMember(ctx, chatUUID, userUUID).Return(nil, nil)
+
+		_, err := s.UnpinMessage(ctx, &chat.UnpinMessageIn{ChatUuid: chatUUID, MessageUuid: messageUUID})
+
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), "failed to user is not chat member")
+	})
+}
+
+func TestServer_ListPinnedMessages(t *testing.T) {
+	t.Parallel()
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockRepo := NewMockDBRepo(ctrl)
+	mockUserClient := NewMockUserClient(ctrl)
+	mockLogger := logger_lib.NewMockLoggerInterface(ctrl)
+
+	userUUID := uuid.New().String()
+	chatUUID := uuid.New().String()
+
+	ctx := context.Background()
+	ctx = context.WithValue(ctx, config.KeyLogger, mockLogger)
+	ctx = context.WithValue(ctx, config.KeyUUID, userUUID)
+
+	s := New(mockRepo, mockUserClient)
+
+	t.Run("success", func(t *testing.T) {
+		mockLogger.EXPECT().AddFuncName("ListPinnedMessages")
+		mockRepo.EXPECT().HasChatAccess(ctx, chatUUID, userUUID).Return(true, nil)
+
+		pinnedAt := time.Now()
+		mockRepo.EXPECT().GetPinnedMessages(ctx, chatUUID, userUUID).Return(&model.PinnedMessageList{
+			{Message: model.Message{ID: uuid.New(), Content: "rules"}, PinnedBy: userUUID, PinnedAt: pinnedAt},
+		}, nil)
+
+		out, err := s.ListPinnedMessages(ctx, &chat.ListPinnedMessagesIn{ChatUuid: chatUUID})
+
+		assert.NoError(t, err)
+		assert.Len(t, out.PinnedMessages, 1)
+		assert.Equal(t, "rules", out.PinnedMessages[0].Message.Content)
+		assert.Equal(t, pinnedAt.Format(time.RFC3339), out.PinnedMessages[0].PinnedAt)
+	})
+
+	t.Run("DB_error", func(t *testing.T) {
+		mockLogger.EXPECT().AddFuncName("ListPinnedMessages")
+		mockLogger.EXPECT().Error(gomock.Any())
+		mockRepo.EXPECT().HasChatAccess(ctx, chatUUID, userUUID).Return(true, nil)
+		mockRepo.EXPECT().GetPinnedMessages(ctx, chatUUID, userUUID).Return(nil, fmt.Errorf("db error"))
+
+		_, err := s.ListPinnedMessages(ctx, &chat.ListPinnedMessagesIn{ChatUuid: chatUUID})
+
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), "failed to get pinned messages")
+	})
+}
+
+func TestServer_ForwardMessages(t *testing.T) {
+	t.Parallel()
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockRepo := NewMockDBRepo(ctrl)
+	mockUserClient := NewMockUserClient(ctrl)
+	mockLogger := logger_lib.NewMockLoggerInterface(ctrl)
+
+	userUUID := uuid.New().String()
+	sourceChatUUID := uuid.New().String()
+	targetChatUUID := uuid.New().String()
+	messageUUID := uuid.New().String()
+	authorUUID := uuid.New()
+
+	ctx := context.Background()
+	ctx = context.WithValue(ctx, config.KeyLogger, mockLogger)
+	ctx = context.WithValue(ctx, config.KeyUUID, userUUID)
+
+	s := New(mockRepo, mockUserClient)
+	mockRepo.EXPECT().WithTx(gomock.Any(), gomock.Any()).DoAndReturn(runInTx).AnyTimes()
+	mockRepo.EXPECT().AddOutboxEvent(gomock.Any(), gomock.Any()).Return(nil).AnyTimes()
+	mockRepo.EXPECT().GetChatRecipients(gomock.Any(), gomock.Any()).Return(&model.EventRecipientList{}, nil).AnyTimes()
+
+	in := &chat.ForwardMessagesIn{
+		SourceChatUuid: sourceChatUUID,
+		MessageUuids:   []string{messageUUID, messageUUID},
+		TargetChatUuid: targetChatUUID,
+	}
+
+	t.Run("success", func(t *testing.T) {
+		mockLogger.EXPECT().AddFuncName("ForwardMessages")
+		mockRepo.EXPECT().IsChatMember(ctx, sourceChatUUID, userUUID).Return(true, nil)
+		mockRepo.EXPECT().IsChatMember(ctx, targetChatUUID, userUUID).Return(true, nil)
+		mockRepo.EXPECT().ForwardMessages(ctx, sourceChatUUID, targetChatUUID, userUUID, []string{messageUUID}).
+			Return(&model.MessageList{{
+				ID:                   uuid.New(),
+				Uuid:                 uuid.MustParse(userUUID),
+				Content:              "original",
+				Type:                 model.MessageTypeText,
+				ForwardedFromMessage: uuid.NullUUID{UUID: uuid.MustParse(messageUUID), Valid: true},
+				ForwardedFromSender:  uuid.NullUUID{UUID: authorUUID, Valid: true},
+				ForwardedFromChat:    uuid.NullUUID{UUID: uuid.MustParse(sourceChatUUID), Valid: true},
+			}}, nil)
+
+		out, err := s.ForwardMessages(ctx, in)
+
+		assert.NoError(t, err)
+		assert.Len(t, out.Messages, 1)
+		assert.Equal(t, "original", out.Messages[0].Content)
+		assert.Equal(t, authorUUID.String(), out.Messages[0].ForwardedFrom.SenderUuid)
+		assert.Equal(t, sourceChatUUID, out.Messages[0].ForwardedFrom.ChatUuid)
+	})
+
+	t.Run("empty_message_list", func(t *testing.T) {
+		mockLogger.EXPECT().AddFuncName("ForwardMessages")
+		mockLogger.EXPECT().Error(gomock.Any())
+
+		_, err := s.ForwardMessages(ctx, &chat.ForwardMessagesIn{SourceChatUuid: sourceChatUUID, TargetChatUuid: targetChatUUID})
+
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), "failed to forward messages")
+	})
+
+	t.Run("not_target_member", func(t *testing.T) {
+		mockLogger.EXPECT().AddFuncName("ForwardMessages")
+		mockLogger.EXPECT().Error("failed to user is not chat member")
+		mockRepo.EXPECT().IsChatMember(ctx, sourceChatUUID, userUUID).Return(true, nil)
+		mockRepo.EXPECT().IsChatMember(ctx, targetChatUUID, userUUID).Return(false, nil)
+
+		_, err := s.ForwardMessages(ctx, in)
+
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), "failed to user is not chat member")
+	})
+
+	t.Run("nothing_forwarded", func(t *testing.T) {
+		mockLogger.EXPECT().AddFuncName("ForwardMessages")
+		mockLogger.EXPECT().Error("failed to find messages to forward")
+		mockRepo.EXPECT().IsChatMember(ctx, sourceChatUUID, userUUID).Return(true, nil)
+		mockRepo.EXPECT().IsChatMember(ctx, targetChatUUID, userUUID).Return(true, nil)
+		mockRepo.EXPECT().ForwardMessages(ctx, sourceChatUUID, targetChatUUID, userUUID, []string{messageUUID}).
+			Return(&model.MessageList{}, nil)
+
+		_, err := s.ForwardMessages(ctx, in)
+
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), "failed to find messages to forward")
+	})
+
+	t.Run("DB_error", func(t *testing.T) {
+		mockLogger.EXPECT().AddFuncName("ForwardMessages")
+		mockLogger.EXPECT().Error(gomock.Any())
+		mockRepo.EXPECT().IsChatMember(ctx, sourceChatUUID, userUUID).Return(true, nil)
+		mockRepo.EXPECT().IsChatMember(ctx, targetChatUUID, userUUID).Return(true, nil)
+		mockRepo.EXPECT().ForwardMessages(ctx, sourceChatUUID, targetChatUUID, userUUID, []string{messageUUID}).
+			Return(nil, fmt.Errorf("db error"))
+
+		_, err := s.ForwardMessages(ctx, in)
+
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), "failed to forward messages")
+	})
+}
+
+func TestServer_SearchMessages(t *testing.T) {
+	t.Parallel()
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockRepo := NewMockDBRepo(ctrl)
+	mockUserClient := NewMockUserClient(ctrl)
+	mockLogger := logger_lib.NewMockLoggerInterface(ctrl)
+
+	userUUID := uuid.New().String()
+	chatUUID := uuid.New().String()
+
+	ctx := context.Background()
+	ctx = context.WithValue(ctx, config.KeyLogger, mockLogger)
+	ctx = context.WithValue(ctx, config.KeyUUID, userUUID)
+
+	s := New(mockRepo, mockUserClient)
+
+	t.Run("success_all_chats", func(t *testing.T) {
+		mockLogger.EXPECT().AddFuncName("SearchMessages")
+
+		results := model.SearchResultList{}
+		for i := 0; i < 3; i++ {
+			results = append(results, model.SearchResult{
+				Message:  model.Message{ID: uuid.New(), Content: "deploy", SentAt: time.Now().Add(-time.Duration(i) * time.Minute)},
+				ChatUUID: chatUUID,
+				Snippet:  "<b>deploy</b>",
+			})
+		}
+		mockRepo.EXPECT().SearchMessages(ctx, userUUID, "deploy", "", &model.MessagePage{Limit: 3}).Return(&results, nil)
+
+		out, err := s.SearchMessages(ctx, &chat.SearchMessagesIn{Query: " deploy ", Limit: 2})
+
+		assert.NoError(t, err)
+		assert.Len(t, out.Results, 2)
+		assert.Equal(t, "<b>deploy</b>", out.Results[0].Snippet)
+		assert.NotEmpty(t, out.NextCursor)
+	})
+
+	t.Run("success_chat_scope", func(t *testing.T) {
+		mockLogger.EXPECT().AddFuncName("SearchMessages")
+		mockRepo.EXPECT().HasChatAccess(ctx, chatUUID, userUUID).Return(true, nil)
+		mockRepo.EXPECT().SearchMessages(ctx, userUUID, "релиз", chatUUID, &model.MessagePage{Limit: 16}).
+			Return(&model.SearchResultList{}, nil)
+
+		out, err := s.SearchMessages(ctx, &chat.SearchMessagesIn{Query: "релиз", ChatUuid: chatUUID})
+
+		assert.NoError(t, err)
+		assert.Empty(t, out.Results)
+		assert.Empty(t, out.NextCursor)
+	})
+
+	t.Run("empty_query", func(t *testing.T) {
+		mockLogger.EXPECT().AddFuncName("SearchMessages")
+		mockLogger.EXPECT().Error(gomock.Any())
+
+		_, err := s.SearchMessages(ctx, &chat.SearchMessagesIn{Query: "  "})
+
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), "failed to search messages")
+	})
+
+	t.Run("no_access", func(t *testing.T) {
+		mockLogger.EXPECT().AddFuncName("SearchMessages")
+		mockLogger.EXPECT().Error(gomock.Any())
+		mockRepo.EXPECT().HasChatAccess(ctx, chatUUID, userUUID).Return(false, nil)
+
+		_, err := s.SearchMessages(ctx, &chat.SearchMessagesIn{Query: "deploy", ChatUuid: chatUUID})
+
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), "failed to user has no access to chat")
+	})
+
+	t.Run("DB_error", func(t *testing.T) {
+		mockLogger.EXPECT().AddFuncName("SearchMessages")
+		mockLogger.EXPECT().Error(gomock.Any())
+		mockRepo.EXPECT().SearchMessages(ctx, userUUID, "deploy", "", gomock.Any()).Return(nil, fmt.Errorf("db error"))
+
+		_, err := s.SearchMessages(ctx, &chat.SearchMessagesIn{Query: "deploy"})
+
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), "failed to search messages")
+	})
+}
+
+type eventStreamMock struct {
+	grpc.ServerStream
+	ctx    context.Context
+	events chan *chat.Event
+}
+
+func (m *eventStreamMock) Context() context.Context {
+	return m.ctx
+}
+
+func (m *eventStreamMock) Send(event *chat.Event) error {
+	m.events <- event
+	return nil
+}
+
+func TestServer_SubscribeEvents(t *testing.T) {
+	t.Parallel()
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockRepo := NewMockDBRepo(ctrl)
+	mockUserClient := NewMockUserClient(ctrl)
+	mockLogger := logger_lib.NewMockLoggerInterface(ctrl)
+
+	userUUID := uuid.New().String()
+	chatUUID := uuid.New().String()
+
+	ctx := context.Background()
+	ctx = context.WithValue(ctx, config.KeyLogger, mockLogger)
+	ctx = context.WithValue(ctx, config.KeyUUID, userUUID)
+
+	s := New(mockRepo, mockUserClient)
+	mockRepo.EXPECT().WithTx(gomock.Any(), gomock.Any()).DoAndReturn(runInTx).AnyTimes()
+	mockRepo.EXPECT().AddOutboxEvent(gomock.Any(), gomock.Any()).Return(nil).AnyTimes()
+	mockRepo.EXPECT().AddNotificationRequests(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(nil).AnyTimes()
+
+	t.Run("receives_sent_message", func(t *testing.T) {
+		streamCtx, cancel := context.WithCancel(ctx)
+		stream := &eventStreamMock{ctx: streamCtx, events: make(chan *chat.Event, 1)}
+
+		mockLogger.EXPECT().AddFuncName("SubscribeEvents")
+		done := make(chan error)
+		go func() {
+			done <- s.SubscribeEvents(&emptypb.Empty{}, stream)
+		}()
+		assert.Eventually(t, s.events.HasSubscribers, time.Second, time.Millisecond)
+
+		mockLogger.EXPECT().AddFuncName("SendPrivateMessage")
+		mockRepo.EXPECT().IsChatMember(ctx, chatUUID, userUUID).Return(true, nil)
+		mockRepo.EXPECT().SendPrivateMessage(ctx, gomock.Any()).
+			Return(&model.Message{ID: uuid.New(), Uuid: uuid.MustParse(userUUID), Content: "ping", Type: model.MessageTypeText}, nil)
+		mockRepo.EXPECT().GetChatRecipients(ctx, chatUUID).Return(&model.EventRecipientList{{UserUUID: userUUID}}, nil)
+
+		_, err := s.SendPrivateMessage(ctx, &chat.SendPrivateMessageIn{ChatUuid: chatUUID, Content: "ping"})
+		assert.NoError(t, err)
+
+		event := <-stream.events
+		assert.Equal(t, chatUUID, event.ChatUuid)
+		assert.Equal(t, "ping", event.GetMessageSent().Content)
+		assert.False(t, event.Notify)
+
+		cancel()
+		assert.NoError(t, <-done)
+		assert.False(t, s.events.HasSubscribers())
+	})
+
+	t.Run("respects_notification_settings", func(t *testing.T) {
+		senderUUID := uuid.New().String()
+		senderCtx := context.WithValue(ctx, config.KeyUUID, senderUUID)
+
+		streamCtx, cancel := context.WithCancel(ctx)
+		stream := &eventStreamMock{ctx: streamCtx, events: make(chan *chat.Event, 3)}
+
+		mockLogger.EXPECT().AddFuncName("SubscribeEvents")
+		done := make(chan error)
+		go func() {
+			done <- s.SubscribeEvents(&emptypb.Empty{}, stream)
+		}()
+		assert.Eventually(t, s.events.HasSubscribers, time.Second, time.Millisecond)
+
+		recipients := &model.EventRecipientList{
+			{UserUUID: senderUUID},
+			{UserUUID: userUUID, Nickname: "Alice", Muted: true, MentionsOnly: true},
+		}
+		for _, content := range []string{"ping", "ping @alicebob", "ping @alice, hi"} {
+			mockLogger.EXPECT().AddFuncName("SendPrivateMessage")
+			mockRepo.EXPECT().IsChatMember(senderCtx, chatUUID, senderUUID).Return(true, nil)
+			mockRepo.EXPECT().SendPrivateMessage(senderCtx, gomock.Any()).
+				Return(&model.Message{ID: uuid.New(), Uuid: uuid.MustParse(senderUUID), Content: content, Type: model.MessageTypeText}, nil)
+			mockRepo.EXPECT().GetChatRecipients(senderCtx, chatUUID).Return(recipients, nil)
+
+			_, err := s.SendPrivateMessage(senderCtx, &chat.SendPrivateMessageIn{ChatUuid: chatUUID, Content: content})
+			assert.NoError(t, err)
+		}
+
+		muted := <-stream.events
+		assert.Equal(t, "ping", muted.GetMessageSent().Content)
+		assert.False(t, muted.Notify)
+
+		// упоминание другого никнейма, который начинается с никнейма получателя, не считается упоминанием
+		prefixed := <-stream.events
+		assert.Equal(t, "ping @alicebob", prefixed.GetMessageSent().Content)
+		assert.False(t, prefixed.Notify)
+
+		mentioned := <-stream.events
+		assert.Equal(t, "ping @alice, hi", mentioned.GetMessageSent().Content)
+		assert.True(t, mentioned.Notify)
+
+		cancel()
+		assert.NoError(t, <-done)
+	})
+
+	t.Run("no_userUUID", func(t *testing.T) {
+		badCtx := context.WithValue(context.Background(), config.KeyLogger, mockLogger)
+
+		mockLogger.EXPECT().AddFuncName("SubscribeEvents")
+		mockLogger.EXPECT().Error("failed to find uuid")
+
+		err := s.SubscribeEvents(&emptypb.Empty{}, &eventStreamMock{ctx: badCtx})
+
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), "failed to find uuid")
+	})
+}
+
+type typingStreamMock struct {
+	grpc.ServerStream
+	ctx     context.Context
+	updates chan *chat.TypingUpdate
+}
+
+func (m *typingStreamMock) Context() context.Context {
+	return m.ctx
+}
+
+func (m *typingStreamMock) Send(update *chat.TypingUpdate) error {
+	m.updates <- update
+	return nil
+}
+
+func TestServer_SetTyping(t *testing.T) {
+	t.Parallel()
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockRepo := NewMockDBRepo(ctrl)
+	mockUserClient := NewMockUserClient(ctrl)
+	mockLogger := logger_lib.NewMockLoggerInterface(ctrl)
+
+	userUUID := uuid.New().String()
+	chatUUID := uuid.New().String()
+
+	ctx := context.Background()
+	ctx = context.WithValue(ctx, config.KeyLogger, mockLogger)
+	ctx = context.WithValue(ctx, config.KeyUUID, userUUID)
+
+	s := New(mockRepo, mockUserClient)
+
+	t.Run("success_and_throttled", func(t *testing.T) {
+		mockLogger.EXPECT().AddFuncName("SetTyping").Times(2)
+		mockRepo.EXPECT().IsChatMember(ctx, chatUUID, userUUID).Return(true, nil).Times(2)
+
+		out, err := s.SetTyping(ctx, &chat.SetTypingIn{ChatUuid: chatUUID, Typing: true})
+		assert.NoError(t, err)
+		assert.True(t, out.Accepted)
+
+		out, err = s.SetTyping(ctx, &chat.SetTypingIn{ChatUuid: chatUUID, Typing: true})
+		assert.NoError(t, err)
+		assert.False(t, out.Accepted)
+	})
+
+	t.Run("not_chat_member", func(t *testing.T) {
+		mockLogger.EXPECT().AddFuncName("SetTyping")
+		mockLogger.EXPECT().Error("failed to user is not chat member")
+		mockRepo.EXPECT().IsChatMember(ctx, chatUUID, userUUID).Return(false, nil)
+
+		_, err := s.SetTyping(ctx, &chat.SetTypingIn{ChatUuid: chatUUID, Typing: true})
+
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), "failed to user is not chat member")
+	})
+}
+
+func TestServer_WatchTyping(t *testing.T) {
+	t.Parallel()
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockRepo := NewMockDBRepo(ctrl)
+	mockUserClient := NewMockUserClient(ctrl)
+	mockLogger := logger_lib.NewMockLoggerInterface(ctrl)
+
+	userUUID := uuid.New().String()
+	companionUUID := uuid.New().String()
+	chatUUID := uuid.New().String()
+
+	ctx := context.Background()
+	ctx = context.WithValue(ctx, config.KeyLogger, mockLogger)
+	ctx = context.WithValue(ctx, config.KeyUUID, userUUID)
+
+	s := New(mockRepo, mockUserClient)
+
+	t.Run("receives_snapshot_and_updates", func(t *testing.T) {
+		s.typing.Set(chatUUID, companionUUID, true)
+
+		streamCtx, cancel := context.WithCancel(ctx)
+		stream := &typingStreamMock{ctx: streamCtx, updates: make(chan *chat.TypingUpdate, 1)}
+
+		mockLogger.EXPECT().AddFuncName("WatchTyping")
+		mockRepo.EXPECT().IsChatMember(streamCtx, chatUUID, userUUID).Return(true, nil)
+
+		done := make(chan error)
+		go func() {
+			done <- s.WatchTyping(&chat.WatchTypingIn{ChatUuid: chatUUID}, stream)
+		}()
+
+		update := <-stream.updates
+		assert.Equal(t, companionUUID, update.UserUuid)
+		assert.True(t, update.Typing)
+
+		cancel()
+		assert.NoError(t, <-done)
+	})
+
+	t.Run("not_chat_member", func(t *testing.T) {
+		mockLogger.EXPECT().AddFuncName("WatchTyping")
+		mockLogger.EXPECT().Error("failed to user is not chat member")
+		mockRepo.EXPECT().IsChatMember(ctx, chatUUID, userUUID).Return(false, nil)
+
+		err := s.WatchTyping(&chat.WatchTypingIn{ChatUuid: chatUUID}, &typingStreamMock{ctx: ctx})
+
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), "failed to user is not chat member")
+	})
+}
+
+func TestServer_GetPresence(t *testing.T) {
+	t.Parallel()
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockRepo := NewMockDBRepo(ctrl)
+	mockUserClient := NewMockUserClient(ctrl)
+	mockLogger := logger_lib.NewMockLoggerInterface(ctrl)
+
+	userUUID := uuid.New().String()
+	offlineUUID := uuid.New().String()
+	unknownUUID := uuid.New().String()
+	lastOnline := time.Date(2025, 1, 2, 3, 4, 5, 0, time.UTC)
+
+	ctx := context.Background()
+	ctx = context.WithValue(ctx, config.KeyLogger, mockLogger)
+	ctx = context.WithValue(ctx, config.KeyUUID, userUUID)
+
+	s := New(mockRepo, mockUserClient)
+
+	t.Run("heartbeat_and_stored_last_online", func(t *testing.T) {
+		mockLogger.EXPECT().AddFuncName("Heartbeat")
+		mockLogger.EXPECT().AddFuncName("GetPresence")
+
+		var seenAt time.Time
+		mockRepo.EXPECT().UpdateUsersLastOnline(ctx, gomock.Any()).DoAndReturn(func(_ context.Context, lastOnline map[string]time.Time) error {
+			assert.Len(t, lastOnline, 1)
+			seenAt = lastOnline[userUUID]
+			assert.WithinDuration(t, time.Now(), seenAt, time.Second)
+			return nil
+		})
+
+		heartbeat, err := s.Heartbeat(ctx, &emptypb.Empty{})
+		assert.NoError(t, err)
+		assert.Equal(t, int64(60), heartbeat.TimeoutSeconds)
+
+		// статус вычисляется по сохраненному времени, поэтому не зависит от экземпляра, принявшего heartbeat
+		mockRepo.EXPECT().GetUsersLastOnline(ctx, []string{userUUID, offlineUUID, unknownUUID}).Return(&model.UserPresenceList{
+			{UserUUID: userUUID, LastOnline: &seenAt},
+			{UserUUID: offlineUUID, LastOnline: &lastOnline},
+		}, nil)
+
+		out, err := s.GetPresence(ctx, &chat.GetPresenceIn{UserUuids: []string{userUUID, offlineUUID, unknownUUID}})
+		assert.NoError(t, err)
+		assert.Len(t, out.Presences, 3)
+		assert.Equal(t, &chat.Presence{UserUuid: userUUID, Online: true, LastOnline: seenAt.Format(time.RFC3339)}, out.Presences[0])
+		assert.Equal(t, &chat.Presence{UserUuid: offlineUUID, LastOnline: lastOnline.Format(time.RFC3339)}, out.Presences[1])
+		assert.Equal(t, &chat.Presence{UserUuid: unknownUUID}, out.Presences[2])
+	})
+
+	t.Run("heartbeat_DB_error", func(t *testing.T) {
+		mockLogger.EXPECT().AddFuncName("Heartbeat")
+		mockLogger.EXPECT().Error("failed to update user last online: db is down")
+		mockRepo.EXPECT().UpdateUsersLastOnline(ctx, gomock.Any()).Return(fmt.Errorf("db is down"))
+
+		_, err := s.Heartbeat(ctx, &emptypb.Empty{})
+
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), "failed to update user last online")
+	})
+
+	t.Run("invalid_uuid", func(t *testing.T) {
+		mockLogger.EXPECT().AddFuncName("GetPresence")
+		mockLogger.EXPECT().Error(gomock.Any())
+
+		_, err := s.GetPresence(ctx, &chat.GetPresenceIn{UserUuids: []string{"not-a-uuid"}})
+
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), "failed to parse user uuids")
+	})
+
+	t.Run("empty_list", func(t *testing.T) {
+		mockLogger.EXPECT().AddFuncName("GetPresence")
+		mockLogger.EXPECT().Error("failed to get presence: user_uuids must contain from 1 to 100 uuids")
+
+		_, err := s.GetPresence(ctx, &chat.GetPresenceIn{})
+
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), "user_uuids must contain from 1 to 100 uuids")
+	})
+}
+
+func TestServer_queueOfflineNotifications(t *testing.T) {
+	t.Parallel()
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockRepo := NewMockDBRepo(ctrl)
+	mockUserClient := NewMockUserClient(ctrl)
+	mockLogger := logger_lib.NewMockLoggerInterface(ctrl)
+
+	chatUUID := uuid.New().String()
+	senderUUID := uuid.New().String()
+	offlineUUID := uuid.New().String()
+	onlineUUID := uuid.New().String()
+	mutedUUID := uuid.New().String()
+	messageUUID := uuid.New().String()
+	seenAt := time.Now().UTC()
+	staleAt := seenAt.Add(-2 * presenceTimeout)
+
+	ctx := context.WithValue(context.Background(), config.KeyLogger, mockLogger)
+
+	s := New(mockRepo, mockUserClient)
+
+	t.Run("offline_recipients_only", func(t *testing.T) {
+		mockRepo.EXPECT().GetChatRecipients(ctx, chatUUID).Return(&model.EventRecipientList{
+			{UserUUID: senderUUID},
+			{UserUUID: offlineUUID, LastOnline: &staleAt},
+			{UserUUID: onlineUUID, LastOnline: &seenAt},
+			{UserUUID: mutedUUID, Muted: true},
+		}, nil)
+		mockRepo.EXPECT().AddNotificationRequests(ctx, chatUUID, messageUUID, senderUUID, []string{offlineUUID}).Return(nil)
+
+		s.publishChatEvent(ctx, chatUUID, messageSentEvent(&chat.Message{MessageUuid: messageUUID, Uuid: senderUUID}))
+	})
+
+	t.Run("all_online", func(t *testing.T) {
+		mockRepo.EXPECT().GetChatRecipients(ctx, chatUUID).Return(&model.EventRecipientList{
+			{UserUUID: senderUUID},
+			{UserUUID: onlineUUID, LastOnline: &seenAt},
+		}, nil)
+
+		s.publishChatEvent(ctx, chatUUID, messageSentEvent(&chat.Message{MessageUuid: messageUUID, Uuid: senderUUID}))
+	})
+
+	t.Run("DB_error", func(t *testing.T) {
+		mockLogger.EXPECT().Error("failed to add notification requests: db error")
+		mockRepo.EXPECT().GetChatRecipients(ctx, chatUUID).Return(&model.EventRecipientList{
+			{UserUUID: offlineUUID},
+		}, nil)
+		mockRepo.EXPECT().AddNotificationRequests(ctx, chatUUID, messageUUID, senderUUID, []string{offlineUUID}).Return(fmt.Errorf("db error"))
+
+		s.publishChatEvent(ctx, chatUUID, messageSentEvent(&chat.Message{MessageUuid: messageUUID, Uuid: senderUUID}))
+	})
+}
+
+func TestServer_sendNotifications(t *testing.T) {
+	t.Parallel()
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockRepo := NewMockDBRepo(ctrl)
+	mockUserClient := NewMockUserClient(ctrl)
+	mockProducer := NewMockNotificationProducer(ctrl)
+	mockLogger := logger_lib.NewMockLoggerInterface(ctrl)
+
+	chatUUID := uuid.New().String()
+	senderUUID := uuid.New().String()
+	firstUUID := uuid.New().String()
+	secondUUID := uuid.New().String()
+	messageUUID := uuid.New().String()
+	firstAt := time.Date(2025, 1, 2, 3, 4, 5, 0, time.UTC)
+
+	batches := model.NotificationBatchList{
+		{
+			RecipientUUID:   firstUUID,
+			ChatUUID:        chatUUID,
+			Count:           2,
+			LastMessageUUID: messageUUID,
+			LastSenderUUID:  senderUUID,
+			FirstMessageAt:  firstAt,
+			LastMessageAt:   firstAt.Add(time.Second),
+		},
+		{RecipientUUID: secondUUID, ChatUUID: chatUUID, Count: 1},
+	}
+
+	ctx := context.WithValue(context.Background(), config.KeyLogger, mockLogger)
+
+	s := New(mockRepo, mockUserClient)
+	mockRepo.EXPECT().WithTx(gomock.Any(), gomock.Any()).DoAndReturn(runInTx).AnyTimes()
+
+	t.Run("success", func(t *testing.T) {
+		mockLogger.EXPECT().AddFuncName("sendNotifications")
+		mockRepo.EXPECT().GetReadyNotificationBatches(ctx, notificationBatchWindow, uint64(notificationBatchSize)).Return(&batches, nil)
+		mockProducer.EXPECT().ProduceMessage(ctx, gomock.Any(), firstUUID).DoAndReturn(func(_ context.Context, message any, _ any) error {
+			assert.Equal(t, &chat.NotificationRequested{
+				RecipientUuid:   firstUUID,
+				ChatUuid:        chatUUID,
+				MessagesCount:   2,
+				LastMessageUuid: messageUUID,
+				LastSenderUuid:  senderUUID,
+				FirstMessageAt:  "2025-01-02T03:04:05Z",
+				LastMessageAt:   "2025-01-02T03:04:06Z",
+			}, message)
+			return nil
+		})
+		mockProducer.EXPECT().ProduceMessage(ctx, gomock.Any(), secondUUID).Return(nil)
+		mockRepo.EXPECT().DeleteNotificationBatches(ctx, batches).Return(nil)
+
+		s.sendNotifications(ctx, mockProducer)
+	})
+
+	t.Run("produce_error_keeps_unsent", func(t *testing.T) {
+		mockLogger.EXPECT().AddFuncName("sendNotifications")
+		mockLogger.EXPECT().Error("failed to produce notification request: kafka is down")
+		mockRepo.EXPECT().GetReadyNotificationBatches(ctx, notificationBatchWindow, uint64(notificationBatchSize)).Return(&batches, nil)
+		mockProducer.EXPECT().ProduceMessage(ctx, gomock.Any(), firstUUID).Return(nil)
+		mockProducer.EXPECT().ProduceMessage(ctx, gomock.Any(), secondUUID).Return(fmt.Errorf("kafka is down"))
+		mockRepo.EXPECT().DeleteNotificationBatches(ctx, batches[:1]).Return(nil)
+
+		s.sendNotifications(ctx, mockProducer)
+	})
+
+	t.Run("DB_error", func(t *testing.T) {
+		mockLogger.EXPECT().AddFuncName("sendNotifications")
+		mockLogger.EXPECT().Error("failed to get notification batches: db error")
+		mockRepo.EXPECT().GetReadyNotificationBatches(ctx, notificationBatchWindow, uint64(notificationBatchSize)).Return(nil, fmt.Errorf("db error"))
+
+		s.sendNotifications(ctx, mockProducer)
+	})
+}
+
+func TestServer_SetChatNotifications(t *testing.T) {
+	t.Parallel()
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockRepo := NewMockDBRepo(ctrl)
+	mockUserClient := NewMockUserClient(ctrl)
+	mockLogger := logger_lib.NewMockLoggerInterface(ctrl)
+
+	userUUID := uuid.New().String()
+	chatUUID := uuid.New().String()
+
+	ctx := context.Background()
+	ctx = context.WithValue(ctx, config.KeyLogger, mockLogger)
+	ctx = context.WithValue(ctx, config.KeyUUID, userUUID)
+
+	s := New(mockRepo, mockUserClient)
+
+	t.Run("mute_until", func(t *testing.T) {
+		mutedUntil := time.Now().Add(time.Hour).UTC().Truncate(time.Second)
+
+		mockLogger.EXPECT().AddFuncName("SetChatNotifications")
+		mockRepo.EXPECT().HasChatAccess(ctx, chatUUID, userUUID).Return(true, nil)
+		mockRepo.EXPECT().SetChatNotifications(ctx, chatUUID, userUUID, &model.ChatNotifications{
+			Muted:        true,
+			MutedUntil:   &mutedUntil,
+			MentionsOnly: true,
+		}).Return(&model.ChatNotifications{Muted: true, MutedUntil: &mutedUntil, MentionsOnly: true}, nil)
+
+		out, err := s.SetChatNotifications(ctx, &chat.SetChatNotificationsIn{
+			ChatUuid:     chatUUID,
+			Muted:        true,
+			MutedUntil:   mutedUntil.Format(time.RFC3339),
+			MentionsOnly: true,
+		})
+
+		assert.NoError(t, err)
+		assert.True(t, out.Muted)
+		assert.Equal(t, mutedUntil.Format(time.RFC3339), out.MutedUntil)
+		assert.True(t, out.MentionsOnly)
+	})
+
+	t.Run("unmute_resets_settings", func(t *testing.T) {
+		mockLogger.EXPECT().AddFuncName("SetChatNotifications")
+		mockRepo.EXPECT().HasChatAccess(ctx, chatUUID, userUUID).Return(true, nil)
+		mockRepo.EXPECT().SetChatNotifications(ctx, chatUUID, userUUID, &model.ChatNotifications{}).
+			Return(&model.ChatNotifications{}, nil)
+
+		out, err := s.SetChatNotifications(ctx, &chat.SetChatNotificationsIn{
+			ChatUuid:     chatUUID,
+			MutedUntil:   "garbage",
+			MentionsOnly: true,
+		})
+
+		assert.NoError(t, err)
+		assert.False(t, out.Muted)
+		assert.Empty(t, out.MutedUntil)
+		assert.False(t, out.MentionsOnly)
+	})
+
+	t.Run("invalid_muted_until", func(t *testing.T) {
+		mockLogger.EXPECT().AddFuncName("SetChatNotifications")
+		mockLogger.EXPECT().Error(gomock.Any())
+
+		_, err := s.SetChatNotifications(ctx, &chat.SetChatNotificationsIn{ChatUuid: chatUUID, Muted: true, MutedUntil: "tomorrow"})
+
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), "failed to parse muted_until")
+	})
+
+	t.Run("muted_until_in_past", func(t *testing.T) {
+		mockLogger.EXPECT().AddFuncName("SetChatNotifications")
+		mockLogger.EXPECT().Error("failed to mute chat: muted_until must be in the future")
+
+		_, err := s.SetChatNotifications(ctx, &chat.SetChatNotificationsIn{
+			ChatUuid:   chatUUID,
+			Muted:      true,
+			MutedUntil: time.Now().Add(-time.Hour).Format(time.RFC3339),
+		})
+
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), "failed to mute chat: muted_until must be in the future")
+	})
+
+	t.Run("no_access", func(t *testing.T) {
+		mockLogger.EXPECT().AddFuncName("SetChatNotifications")
+		mockRepo.EXPECT().HasChatAccess(ctx, chatUUID, userUUID).Return(false, nil)
+		mockLogger.EXPECT().Error("failed to user has no access to chat")
+
+		_, err := s.SetChatNotifications(ctx, &chat.SetChatNotificationsIn{ChatUuid: chatUUID, Muted: true})
+
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), "failed to user has no access to chat")
+	})
+
+	t.Run("DB_error", func(t *testing.T) {
+		mockLogger.EXPECT().AddFuncName("SetChatNotifications")
+		mockRepo.EXPECT().HasChatAccess(ctx, chatUUID, userUUID).Return(true, nil)
+		mockRepo.EXPECT().SetChatNotifications(ctx, chatUUID, userUUID, &model.ChatNotifications{Muted: true}).
+			Return(nil, fmt.Errorf("DB error"))
+		mockLogger.EXPECT().Error(gomock.Any())
+
+		_, err := s.SetChatNotifications(ctx, &chat.SetChatNotificationsIn{ChatUuid: chatUUID, Muted: true})
+
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), "failed to set chat notifications")
+	})
+
+	t.Run("no_userUUID", func(t *testing.T) {
+		badCtx := context.WithValue(context.Background(), config.KeyLogger, mockLogger)
+
+		mockLogger.EXPECT().AddFuncName("SetChatNotifications")
+		mockLogger.EXPECT().Error("failed to find uuid")
+
+		_, err := s.SetChatNotifications(badCtx, &chat.SetChatNotificationsIn{ChatUuid: chatUUID, Muted: true})
+
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), "failed to find uuid")
+	})
+}
+
+func TestServer_LeaveChat(t *testing.T) {
+	t.Parallel()
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockRepo := NewMockDBRepo(ctrl)
+	mockUserClient := NewMockUserClient(ctrl)
+	mockLogger := logger_lib.NewMockLoggerInterface(ctrl)
+
+	userUUID := uuid.New().String()
+	chatUUID := uuid.New().String()
+
+	ctx := context.Background()
+	ctx = context.WithValue(ctx, config.KeyLogger, mockLogger)
+	ctx = context.WithValue(ctx, config.KeyUUID, userUUID)
+
+	s := New(mockRepo, mockUserClient)
+	mockRepo.EXPECT().WithTx(gomock.Any(), gomock.Any()).DoAndReturn(runInTx).AnyTimes()
+	mockRepo.EXPECT().AddOutboxEvent(gomock.Any(), gomock.Any()).Return(nil).AnyTimes()
+	mockRepo.EXPECT().GetChatRecipients(gomock.Any(), gomock.Any()).Return(&model.EventRecipientList{}, nil).AnyTimes()
+
+	t.Run("leave_group", func(t *testing.T) {
+		mockLogger.EXPECT().AddFuncName("LeaveChat")
+		mockRepo.EXPECT().GetChatMember(ctx, chatUUID, userUUID).
+			Return(&model.ChatMember{StreamType: model.StreamTypeGroup, Role: model.RoleMember}, nil)
+		mockRepo.EXPECT().RemoveChatMember(ctx, chatUUID, userUUID).Return(nil)
+		mockRepo.EXPECT().SendPrivateMessage(ctx, &model.NewMessage{
+			ChatUUID:   chatUUID,
+			SenderUUID: userUUID,
+			Content:    model.SystemMessageMemberLeft,
+			Type:       model.MessageTypeSystem,
+		}).Return(&model.Message{ID: uuid.New(), Content: model.SystemMessageMemberLeft, Type: model.MessageTypeSystem}, nil)
+
+		out, err := s.LeaveChat(ctx, &chat.LeaveChatIn{ChatUuid: chatUUID})
+
+		assert.NoError(t, err)
+		assert.True(t, out.LeaveStatus)
+	})
+
+	t.Run("leave_private", func(t *testing.T) {
+		mockLogger.EXPECT().AddFuncName("LeaveChat")
+		mockRepo.EXPECT().GetChatMember(ctx, chatUUID, userUUID).
+			Return(&model.ChatMember{StreamType: model.StreamTypePrivate}, nil)
+		mockRepo.EXPECT().RemoveChatMember(ctx, chatUUID, userUUID).Return(nil)
+		mockRepo.EXPECT().SendPrivateMessage(ctx, gomock.Any()).
+			Return(&model.Message{ID: uuid.New(), Content: model.SystemMessageMemberLeft, Type: model.MessageTypeSystem}, nil)
+
+		out, err := s.LeaveChat(ctx, &chat.LeaveChatIn{ChatUuid: chatUUID})
+
+		assert.NoError(t, err)
+		assert.True(t, out.LeaveStatus)
+	})
+
+	t.Run("not_member", func(t *testing.T) {
+		mockLogger.EXPECT().AddFuncName("LeaveChat")
+		mockRepo.EXPECT().GetChatMember(ctx, chatUUID, userUUID).Return(nil, nil)
+		mockLogger.EXPECT().Error("failed to user is not chat member")
+
+		_, err := s.LeaveChat(ctx, &chat.LeaveChatIn{ChatUuid: chatUUID})
+
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), "failed to user is not chat member")
+	})
+
+	t.Run("channel", func(t *testing.T) {
+		mockLogger.EXPECT().AddFuncName("LeaveChat")
+		mockRepo.EXPECT().GetChatMember(ctx, chatUUID, userUUID).
+			Return(&model.ChatMember{StreamType: model.StreamTypeChannel, Role: model.RoleAdmin}, nil)
+		mockLogger.EXPECT().Error("failed to leave chat of type channel")
+
+		_, err := s.LeaveChat(ctx, &chat.LeaveChatIn{ChatUuid: chatUUID})
+
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), "failed to leave chat of type channel")
+	})
+
+	t.Run("owner", func(t *testing.T) {
+		mockLogger.EXPECT().AddFuncName("LeaveChat")
+		mockRepo.EXPECT().GetChatMember(ctx, chatUUID, userUUID).
+			Return(&model.ChatMember{StreamType: model.StreamTypeGroup, Role: model.RoleOwner}, nil)
+		mockLogger.EXPECT().Error("failed to owner can not leave group chat")
+
+		_, err := s.LeaveChat(ctx, &chat.LeaveChatIn{ChatUuid: chatUUID})
+
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), "failed to owner can not leave group chat")
+	})
+
+	t.Run("DB_error", func(t *testing.T) {
+		mockLogger.EXPECT().AddFuncName("LeaveChat")
+		mockRepo.EXPECT().GetChatMember(ctx, chatUUID, userUUID).
+			Return(&model.ChatMember{StreamType: model.StreamTypeGroup, Role: model.RoleMember}, nil)
+		mockRepo.EXPECT().RemoveChatMember(ctx, chatUUID, userUUID).Return(fmt.Errorf("DB error"))
+		mockLogger.EXPECT().Error(gomock.Any())
+
+		_, err := s.LeaveChat(ctx, &chat.LeaveChatIn{ChatUuid: chatUUID})
+
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), "failed to leave chat")
+	})
+
+	t.Run("system_message_error", func(t *testing.T) {
+		mockLogger.EXPECT().AddFuncName("LeaveChat")
+		mockRepo.EXPECT().GetChatMember(ctx, chatUUID, userUUID).
+			Return(&model.ChatMember{StreamType: model.StreamTypeGroup, Role: model.RoleMember}, nil)
+		mockRepo.EXPECT().RemoveChatMember(ctx, chatUUID, userUUID).Return(nil)
+		mockRepo.EXPECT().SendPrivateMessage(ctx, gomock.Any()).Return(nil, fmt.Errorf("DB error"))
+		mockLogger.EXPECT().Error(gomock.Any())
+
+		_, err := s.LeaveChat(ctx, &chat.LeaveChatIn{ChatUuid: chatUUID})
+
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), "failed to send leave system message")
+	})
+
+	t.Run("no_userUUID", func(t *testing.T) {
+		badCtx := context.WithValue(context.Background(), config.KeyLogger, mockLogger)
+
+		mockLogger.EXPECT().AddFuncName("LeaveChat")
+		mockLogger.EXPECT().Error("failed to find uuid")
+
+		_, err := s.LeaveChat(badCtx, &chat.LeaveChatIn{ChatUuid: chatUUID})
+
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), "failed to find uuid")
+	})
+}
+
+func TestServer_inTx(t *testing.T) {
+	t.Parallel()
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockRepo := NewMockDBRepo(ctrl)
+	mockUserClient := NewMockUserClient(ctrl)
+
+	ctx := context.Background()
+
+	s := New(mockRepo, mockUserClient)
+
+	t.Run("commit", func(t *testing.T) {
+		mockRepo.EXPECT().WithTx(ctx, gomock.Any()).DoAndReturn(runInTx)
+
+		called := false
+		err := s.inTx(ctx, func(ctx context.Context) error {
+			called = true
+			return nil
+		})
+
+		assert.NoError(t, err)
+		assert.True(t, called)
+	})
+
+	t.Run("fn_error", func(t *testing.T) {
+		mockRepo.EXPECT().WithTx(ctx, gomock.Any()).DoAndReturn(runInTx)
+		mockRepo.EXPECT().HasChatAccess(ctx, "chat_uuid", "user_uuid").Return(false, nil)
+
+		err := s.inTx(ctx, func(ctx context.Context) error {
+			return s.checkChatAccess(ctx, "chat_uuid", "user_uuid")
+		})
+
+		assert.Error(t, err)
+		assert.Equal(t, "rpc error: code = PermissionDenied desc = failed to user has no access to chat", err.Error())
+	})
+
+	t.Run("transaction_error", func(t *testing.T) {
+		mockRepo.EXPECT().WithTx(ctx, gomock.Any()).Return(fmt.Errorf("failed to commit transaction: connection reset"))
+
+		err := s.inTx(ctx, func(ctx context.Context) error {
+			return nil
+		})
+
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), "failed to run transaction: failed to commit transaction")
+	})
+}
+
+func TestServer_BanMember(t *testing.T) {
+	t.Parallel()
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockRepo := NewMockDBRepo(ctrl)
+	mockUserClient := NewMockUserClient(ctrl)
+	mockLogger := logger_lib.NewMockLoggerInterface(ctrl)
+
+	userUUID := uuid.New().String()
+	chatUUID := uuid.New().String()
+	memberUUID := uuid.New().String()
+	bannedAt := time.Date(2025, 1, 2, 3, 4, 5, 0, time.UTC)
+	banUntil := bannedAt.Add(time.Hour)
+
+	ctx := context.Background()
+	ctx = context.WithValue(ctx, config.KeyLogger, mockLogger)
+	ctx = context.WithValue(ctx, config.KeyUUID, userUUID)
+
+	s := New(mockRepo, mockUserClient)
+
+	t.Run("success_channel_subscriber", func(t *testing.T) {
+		mockLogger.EXPECT().AddFuncName("BanMember")
+
+		mockRepo.EXPECT().GetChatMember(ctx, chatUUID, userUUID).
+			Return(&model.ChatMember{StreamType: model.StreamTypeChannel, Role: model.RoleAdmin}, nil)
+		mockRepo.EXPECT().GetChatMember(ctx, chatUUID, memberUUID).Return(nil, nil)
+		mockRepo.EXPECT().HasChatAccess(ctx, chatUUID, memberUUID).Return(true, nil)
+		mockRepo.EXPECT().BanMember(ctx, chatUUID, memberUUID, userUUID, "spam", time.Hour).
+			Return(&model.MemberBan{UserUUID: memberUUID, BannedBy: userUUID, Reason: "spam", BannedAt: bannedAt, BanUntil: &banUntil}, nil)
+
+		out, err := s.BanMember(ctx, &chat.BanMemberIn{
+			ChatUuid:        chatUUID,
+			MemberUuid:      memberUUID,
+			Reason:          "spam",
+			DurationSeconds: 3600,
+		})
+
+		assert.NoError(t, err)
+		assert.Equal(t, bannedAt.Format(time.RFC3339), out.BannedAt)
+		assert.Equal(t, banUntil.Format(time.RFC3339), out.BanUntil)
+	})
+
+	t.Run("success_former_member", func(t *testing.T) {
+		mockLogger.EXPECT().AddFuncName("BanMember")
+
+		mockRepo.EXPECT().GetChatMember(ctx, chatUUID, userUUID).
+			Return(&model.ChatMember{StreamType: model.StreamTypeGroup, Role: model.RoleAdmin}, nil)
+		mockRepo.EXPECT().GetChatMember(ctx, chatUUID, memberUUID).Return(nil, nil)
+		mockRepo.EXPECT().IsFormerChatMember(ctx, chatUUID, memberUUID).Return(true, nil)
+		mockRepo.EXPECT().BanMember(ctx, chatUUID, memberUUID, userUUID, "spam", time.Duration(0)).
+			Return(&model.MemberBan{UserUUID: memberUUID, BannedBy: userUUID, Reason: "spam", BannedAt: bannedAt}, nil)
+
+		out, err := s.BanMember(ctx, &chat.BanMemberIn{ChatUuid: chatUUID, MemberUuid: memberUUID, Reason: "spam"})
+
+		assert.NoError(t, err)
+		assert.Equal(t, bannedAt.Format(time.RFC3339), out.BannedAt)
+		assert.Empty(t, out.BanUntil)
+	})
+
+	t.Run("IsFormerChatMember_error", func(t *testing.T) {
+		mockLogger.EXPECT().AddFuncName("BanMember")
+		mockLogger.EXPECT().Error(gomock.Any())
+
+		mockRepo.EXPECT().GetChatMember(ctx, chatUUID, userUUID).
+			Return(&model.ChatMember{StreamType: model.StreamTypeGroup, Role: model.RoleAdmin}, nil)
+		mockRepo.EXPECT().GetChatMember(ctx, chatUUID, memberUUID).Return(nil, nil)
+		mockRepo.EXPECT().IsFormerChatMember(ctx, chatUUID, memberUUID).Return(false, fmt.Errorf("db error"))
+
+		_, err := s.BanMember(ctx, &chat.BanMemberIn{ChatUuid: chatUUID, MemberUuid: memberUUID})
+
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), "failed to check former chat member")
+	})
+
+	t.Run("admin_can_not_ban_admin", func(t *testing.T) {
+		mockLogger.EXPECT().AddFuncName("BanMember")
+		mockLogger.EXPECT().Error("failed to moderate member with the same or higher role")
+
+		mockRepo.EXPECT().GetChatMember(ctx, chatUUID, userUUID).
+			Return(&model.ChatMember{StreamType: model.StreamTypeGroup, Role: model.RoleAdmin}, nil)
+		mockRepo.EXPECT().GetChatMember(ctx, chatUUID, memberUUID).
+			Return(&model.ChatMember{StreamType: model.StreamTypeGroup, Role: model.RoleAdmin}, nil)
+
+		_, err := s.BanMember(ctx, &chat.BanMemberIn{ChatUuid: chatUUID, MemberUuid: memberUUID})
+
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), "failed to moderate member with the same or higher role")
+	})
+
+	t.Run("not_moderator", func(t *testing.T) {
+		mockLogger.EXPECT().AddFuncName("BanMember")
+		mockLogger.EXPECT().Error("failed to user can not moderate chat members")
+
+		mockRepo.EXPECT().GetChatMember(ctx, chatUUID, userUUID).
+			Return(&model.ChatMember{StreamType: model.StreamTypeGroup, Role: model.RoleMember}, nil)
+
+		_, err := s.BanMember(ctx, &chat.BanMemberIn{ChatUuid: chatUUID, MemberUuid: memberUUID})
+
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), "failed to user can not moderate chat members")
+	})
+
+	t.Run("member_not_found", func(t *testing.T) {
+		mockLogger.EXPECT().AddFuncName("BanMember")
+		mockLogger.EXPECT().Error("failed to member is not in chat")
+
+		mockRepo.EXPECT().GetChatMember(ctx, chatUUID, userUUID).
+			Return(&model.ChatMember{StreamType: model.StreamTypeGroup, Role: model.RoleOwner}, nil)
+		mockRepo.EXPECT().GetChatMember(ctx, chatUUID, memberUUID).Return(nil, nil)
+		mockRepo.EXPECT().IsFormerChatMember(ctx, chatUUID, memberUUID).Return(false, nil)
+
+		_, err := s.BanMember(ctx, &chat.BanMemberIn{ChatUuid: chatUUID, MemberUuid: memberUUID})
+
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), "failed to member is not in chat")
+	})
+
+	t.Run("negative_duration", func(t *testing.T) {
+		mockLogger.EXPECT().AddFuncName("BanMember")
+		mockLogger.EXPECT().Error("failed to ban member: invalid duration")
+
+		_, err := s.BanMember(ctx, &chat.BanMemberIn{ChatUuid: chatUUID, MemberUuid: memberUUID, DurationSeconds: -1})
+
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), "failed to ban member: duration must be between 0 and")
+	})
+
+	t.Run("ban_yourself", func(t *testing.T) {
+		mockLogger.EXPECT().AddFuncName("BanMember")
+		mockLogger.EXPECT().Error("failed to moderate yourself")
+
+		_, err := s.BanMember(ctx, &chat.BanMemberIn{ChatUuid: chatUUID, MemberUuid: userUUID})
+
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), "failed to moderate yourself")
+	})
+}
+
+func TestServer_UnbanMember(t *testing.T) {
+	t.Parallel()
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockRepo := NewMockDBRepo(ctrl)
+	mockUserClient := NewMockUserClient(ctrl)
+	mockLogger := logger_lib.NewMockLoggerInterface(ctrl)
+
+	userUUID := uuid.New().String()
+	chatUUID := uuid.New().String()
+	memberUUID := uuid.New().String()
+
+	ctx := context.Background()
+	ctx = context.WithValue(ctx, config.KeyLogger, mockLogger)
+	ctx = context.WithValue(ctx, config.KeyUUID, userUUID)
+
+	s := New(mockRepo, mockUserClient)
+
+	t.Run("success", func(t *testing.T) {
+		mockLogger.EXPECT().AddFuncName("UnbanMember")
+
+		mockRepo.EXPECT().GetChatMember(ctx, chatUUID, userUUID).
+			Return(&model.ChatMember{StreamType: model.StreamTypeGroup, Role: model.RoleOwner}, nil)
+		mockRepo.EXPECT().UnbanMember(ctx, chatUUID, memberUUID).Return(true, nil)
+
+		out, err := s.UnbanMember(ctx, &chat.UnbanMemberIn{ChatUuid: chatUUID, MemberUuid: memberUUID})
+
+		assert.NoError(t, err)
+		assert.True(t, out.UnbanStatus)
+	})
+
+	t.Run("not_banned", func(t *testing.T) {
+		mockLogger.EXPECT().AddFuncName("UnbanMember")
+		mockLogger.EXPECT().Error("failed to member is not banned")
+
+		mockRepo.EXPECT().GetChatMember(ctx, chatUUID, userUUID).
+			Return(&model.ChatMember{StreamType: model.StreamTypeGroup, Role: model.RoleOwner}, nil)
+		mockRepo.EXPECT().UnbanMember(ctx, chatUUID, memberUUID).Return(false, nil)
+
+		_, err := s.UnbanMember(ctx, &chat.UnbanMemberIn{ChatUuid: chatUUID, MemberUuid: memberUUID})
+
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), "failed to member is not banned")
+	})
+
+	t.Run("private_chat", func(t *testing.T) {
+		mockLogger.EXPECT().AddFuncName("UnbanMember")
+		mockLogger.EXPECT().Error("failed to user is not group chat or channel member")
+
+		mockRepo.EXPECT().GetChatMember(ctx, chatUUID, userUUID).
+			Return(&model.ChatMember{StreamType: model.StreamTypePrivate}, nil)
+
+		_, err := s.UnbanMember(ctx, &chat.UnbanMemberIn{ChatUuid: chatUUID, MemberUuid: memberUUID})
+
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), "failed to user is not group chat or channel member")
+	})
+}
+
+func TestServer_KickMember(t *testing.T) {
+	t.Parallel()
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockRepo := NewMockDBRepo(ctrl)
+	mockUserClient := NewMockUserClient(ctrl)
+	mockLogger := logger_lib.NewMockLoggerInterface(ctrl)
+
+	userUUID := uuid.New().String()
+	chatUUID := uuid.New().String()
+	memberUUID := uuid.New().String()
+
+	ctx := context.Background()
+	ctx = context.WithValue(ctx, config.KeyLogger, mockLogger)
+	ctx = context.WithValue(ctx, config.KeyUUID, userUUID)
+
+	s := New(mockRepo, mockUserClient)
+
+	t.Run("success", func(t *testing.T) {
+		mockLogger.EXPECT().AddFuncName("KickMember")
+
+		mockRepo.EXPECT().GetChatMember(ctx, chatUUID, userUUID).
+			Return(&model.ChatMember{StreamType: model.StreamTypeGroup, Role: model.RoleOwner}, nil)
+		mockRepo.EXPECT().GetChatMember(ctx, chatUUID, memberUUID).
+			Return(&model.ChatMember{StreamType: model.StreamTypeGroup, Role: model.RoleAdmin}, nil)
+		mockRepo.EXPECT().KickMember(ctx, chatUUID, memberUUID).Return(nil)
+
+		out, err := s.KickMember(ctx, &chat.KickMemberIn{ChatUuid: chatUUID, MemberUuid: memberUUID})
+
+		assert.NoError(t, err)
+		assert.True(t, out.KickStatus)
+	})
+
+	t.Run("owner_can_not_be_kicked", func(t *testing.T) {
+		mockLogger.EXPECT().AddFuncName("KickMember")
+		mockLogger.EXPECT().Error("failed to moderate member with the same or higher role")
+
+		mockRepo.EXPECT().GetChatMember(ctx, chatUUID, userUUID).
+			Return(&model.ChatMember{StreamType: model.StreamTypeChannel, Role: model.RoleAdmin}, nil)
+		mockRepo.EXPECT().GetChatMember(ctx, chatUUID, memberUUID).
+			Return(&model.ChatMember{StreamType: model.StreamTypeChannel, Role: model.RoleOwner}, nil)
+
+		_, err := s.KickMember(ctx, &chat.KickMemberIn{ChatUuid: chatUUID, MemberUuid: memberUUID})
+
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), "failed to moderate member with the same or higher role")
+	})
+
+	t.Run("DB_error", func(t *testing.T) {
+		mockLogger.EXPECT().AddFuncName("KickMember")
+		mockLogger.EXPECT().Error(gomock.Any())
+
+		mockRepo.EXPECT().GetChatMember(ctx, chatUUID, userUUID).
+			Return(&model.ChatMember{StreamType: model.StreamTypeGroup, Role: model.RoleAdmin}, nil)
+		mockRepo.EXPECT().GetChatMember(ctx, chatUUID, memberUUID).
+			Return(&model.ChatMember{StreamType: model.StreamTypeGroup, Role: model.RoleMember}, nil)
+		mockRepo.EXPECT().KickMember(ctx, chatUUID, memberUUID).Return(fmt.Errorf("db error"))
+
+		_, err := s.KickMember(ctx, &chat.KickMemberIn{ChatUuid: chatUUID, MemberUuid: memberUUID})
+
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), "failed to kick member")
+	})
+}
+
+func TestServer_CreateInviteLink(t *testing.T) {
+	t.Parallel()
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockRepo := NewMockDBRepo(ctrl)
+	mockUserClient := NewMockUserClient(ctrl)
+	mockLogger := logger_lib.NewMockLoggerInterface(ctrl)
+
+	userUUID := uuid.New().String()
+	chatUUID := uuid.New().String()
+	createdAt := time.Date(2025, 1, 2, 3, 4, 5, 0, time.UTC)
+	expiresAt := createdAt.Add(24 * time.Hour)
+	usageLimit := int32(30)
+
+	ctx := context.Background()
+	ctx = context.WithValue(ctx, config.KeyLogger, mockLogger)
+	ctx = context.WithValue(ctx, config.KeyUUID, userUUID)
+
+	s := New(mockRepo, mockUserClient)
+
+	t.Run("success", func(t *testing.T) {
+		mockLogger.EXPECT().AddFuncName("CreateInviteLink")
+
+		mockRepo.EXPECT().GetChatMember(ctx, chatUUID, userUUID).
+			Return(&model.ChatMember{StreamType: model.StreamTypeGroup, Role: model.RoleAdmin}, nil)
+		mockRepo.EXPECT().CreateInviteLink(ctx, chatUUID, userUUID, 24*time.Hour, usageLimit).
+			Return(&model.InviteLink{
+				Code:       "abc",
+				ChatUUID:   chatUUID,
+				CreatedBy:  userUUID,
+				CreatedAt:  createdAt,
+				ExpiresAt:  &expiresAt,
+				UsageLimit: &usageLimit,
+			}, nil)
+
+		out, err := s.CreateInviteLink(ctx, &chat.CreateInviteLinkIn{
+			ChatUuid:         chatUUID,
+			ExpiresInSeconds: 24 * 60 * 60,
+			UsageLimit:       usageLimit,
+		})
+
+		assert.NoError(t, err)
+		assert.Equal(t, &chat.InviteLink{
+			Code:       "abc",
+			ChatUuid:   chatUUID,
+			CreatedBy:  userUUID,
+			CreatedAt:  createdAt.Format(time.RFC3339),
+			ExpiresAt:  expiresAt.Format(time.RFC3339),
+			UsageLimit: usageLimit,
+		}, out.Link)
+	})
+
+	t.Run("not_manager", func(t *testing.T) {
+		mockLogger.EXPECT().AddFuncName("CreateInviteLink")
+		mockLogger.EXPECT().Error("failed to user can not manage group members")
+
+		mockRepo.EXPECT().GetChatMember(ctx, chatUUID, userUUID).
+			Return(&model.ChatMember{StreamType: model.StreamTypeGroup, Role: model.RoleMember}, nil)
+
+		_, err := s.CreateInviteLink(ctx, &chat.CreateInviteLinkIn{ChatUuid: chatUUID})
+
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), "failed to user can not manage group members")
+	})
+
+	t.Run("negative_usage_limit", func(t *testing.T) {
+		mockLogger.EXPECT().AddFuncName("CreateInviteLink")
+		mockLogger.EXPECT().Error("failed to create invite link: negative usage limit")
+
+		_, err := s.CreateInviteLink(ctx, &chat.CreateInviteLinkIn{ChatUuid: chatUUID, UsageLimit: -1})
+
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), "usage limit must not be negative")
+	})
+}
+
+func TestServer_RevokeInviteLink(t *testing.T) {
+	t.Parallel()
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockRepo := NewMockDBRepo(ctrl)
+	mockUserClient := NewMockUserClient(ctrl)
+	mockLogger := logger_lib.NewMockLoggerInterface(ctrl)
+
+	userUUID := uuid.New().String()
+	chatUUID := uuid.New().String()
+
+	ctx := context.Background()
+	ctx = context.WithValue(ctx, config.KeyLogger, mockLogger)
+	ctx = context.WithValue(ctx, config.KeyUUID, userUUID)
+
+	s := New(mockRepo, mockUserClient)
+
+	t.Run("success", func(t *testing.T) {
+		mockLogger.EXPECT().AddFuncName("RevokeInviteLink")
+
+		mockRepo.EXPECT().GetChatMember(ctx, chatUUID, userUUID).
+			Return(&model.ChatMember{StreamType: model.StreamTypeGroup, Role: model.RoleOwner}, nil)
+		mockRepo.EXPECT().RevokeInviteLink(ctx, chatUUID, "abc").Return(true, nil)
+
+		out, err := s.RevokeInviteLink(ctx, &chat.RevokeInviteLinkIn{ChatUuid: chatUUID, Code: "abc"})
+
+		assert.NoError(t, err)
+		assert.True(t, out.RevokeStatus)
+	})
+
+	t.Run("not_found", func(t *testing.T) {
+		mockLogger.EXPECT().AddFuncName("RevokeInviteLink")
+		mockLogger.EXPECT().Error("failed to find invite link")
+
+		mockRepo.EXPECT().GetChatMember(ctx, chatUUID, userUUID).
+			Return(&model.ChatMember{StreamType: model.StreamTypeGroup, Role: model.RoleOwner}, nil)
+		mockRepo.EXPECT().RevokeInviteLink(ctx, chatUUID, "abc").Return(false, nil)
+
+		_, err := s.RevokeInviteLink(ctx, &chat.RevokeInviteLinkIn{ChatUuid: chatUUID, Code: "abc"})
+
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), "failed to find invite link")
+	})
+}
+
+func TestServer_ListInviteLinks(t *testing.T) {
+	t.Parallel()
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockRepo := NewMockDBRepo(ctrl)
+	mockUserClient := NewMockUserClient(ctrl)
+	mockLogger := logger_lib.NewMockLoggerInterface(ctrl)
+
+	userUUID := uuid.New().String()
+	chatUUID := uuid.New().String()
+
+	ctx := context.Background()
+	ctx = context.WithValue(ctx, config.KeyLogger, mockLogger)
+	ctx = context.WithValue(ctx, config.KeyUUID, userUUID)
+
+	s := New(mockRepo, mockUserClient)
+
+	t.Run("success", func(t *testing.T) {
+		mockLogger.EXPECT().AddFuncName("ListInviteLinks")
+
+		mockRepo.EXPECT().GetChatMember(ctx, chatUUID, userUUID).
+			Return(&model.ChatMember{StreamType: model.StreamTypeGroup, Role: model.RoleAdmin}, nil)
+		mockRepo.EXPECT().GetInviteLinks(ctx, chatUUID).Return(&model.InviteLinkList{
+			{Code: "abc", ChatUUID: chatUUID, CreatedBy: userUUID, UsageCount: 3},
+		}, nil)
+
+		out, err := s.ListInviteLinks(ctx, &chat.ListInviteLinksIn{ChatUuid: chatUUID})
+
+		assert.NoError(t, err)
+		assert.Len(t, out.Links, 1)
+		assert.Equal(t, int32(3), out.Links[0].UsageCount)
+		assert.Empty(t, out.Links[0].ExpiresAt)
+	})
+
+	t.Run("not_group_member", func(t *testing.T) {
+		mockLogger.EXPECT().AddFuncName("ListInviteLinks")
+		mockLogger.EXPECT().Error("failed to user is not group chat member")
+
+		mockRepo.EXPECT().GetChatMember(ctx, chatUUID, userUUID).Return(nil, nil)
+
+		_, err := s.ListInviteLinks(ctx, &chat.ListInviteLinksIn{ChatUuid: chatUUID})
+
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), "failed to user is not group chat member")
+	})
+}
+
+func TestServer_JoinByInvite(t *testing.T) {
+	t.Parallel()
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockRepo := NewMockDBRepo(ctrl)
+	mockUserClient := NewMockUserClient(ctrl)
+	mockLogger := logger_lib.NewMockLoggerInterface(ctrl)
+
+	userUUID := uuid.New().String()
+	chatUUID := uuid.New().String()
+	link := &model.InviteLink{Code: "abc", ChatUUID: chatUUID, CreatedBy: uuid.New().String()}
+
+	ctx := context.Background()
+	ctx = context.WithValue(ctx, config.KeyLogger, mockLogger)
+	ctx = context.WithValue(ctx, config.KeyUUID, userUUID)
+
+	s := New(mockRepo, mockUserClient)
+
+	t.Run("success", func(t *testing.T) {
+		mockLogger.EXPECT().AddFuncName("JoinByInvite")
+
+		mockRepo.EXPECT().GetActiveInviteLink(ctx, "abc").Return(link, nil)
+		mockRepo.EXPECT().GetChatMember(ctx, chatUUID, userUUID).Return(nil, nil)
+		mockRepo.EXPECT().IsMemberBanned(ctx, chatUUID, userUUID).Return(false, nil)
+		mockUserClient.EXPECT().GetUserInfoByUUID(ctx, userUUID).
+			Return(&model.ChatMemberParams{Nickname: "student"}, nil)
+		mockRepo.EXPECT().JoinByInvite(ctx, "abc", &model.ChatMemberParams{
+			UserUUID: userUUID,
+			Nickname: "student",
+		}).Return(&model.InviteJoin{LinkActive: true, Joined: true}, nil)
+
+		out, err := s.JoinByInvite(ctx, &chat.JoinByInviteIn{Code: " abc "})
+
+		assert.NoError(t, err)
+		assert.Equal(t, chatUUID, out.ChatUuid)
+		assert.True(t, out.Joined)
+	})
+
+	t.Run("already_member", func(t *testing.T) {
+		mockLogger.EXPECT().AddFuncName("JoinByInvite")
+
+		mockRepo.EXPECT().GetActiveInviteLink(ctx, "abc").Return(link, nil)
+		mockRepo.EXPECT().GetChatMember(ctx, chatUUID, userUUID).
+			Return(&model.ChatMember{StreamType: model.StreamTypeGroup, Role: model.RoleMember}, nil)
+
+		out, err := s.JoinByInvite(ctx, &chat.JoinByInviteIn{Code: "abc"})
+
+		assert.NoError(t, err)
+		assert.Equal(t, chatUUID, out.ChatUuid)
+		assert.False(t, out.Joined)
+	})
+
+	t.Run("banned", func(t *testing.T) {
+		mockLogger.EXPECT().AddFuncName("JoinByInvite")
+		mockLogger.EXPECT().Error("failed to user is banned in chat")
+
+		mockRepo.EXPECT().GetActiveInviteLink(ctx, "abc").Return(link, nil)
+		mockRepo.EXPECT().GetChatMember(ctx, chatUUID, userUUID).Return(nil, nil)
+		mockRepo.EXPECT().IsMemberBanned(ctx, chatUUID, userUUID).Return(true, nil)
+
+		_, err := s.JoinByInvite(ctx, &chat.JoinByInviteIn{Code: "abc"})
+
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), "failed to user is banned in chat")
+	})
+
+	t.Run("inactive_link", func(t *testing.T) {
+		mockLogger.EXPECT().AddFuncName("JoinByInvite")
+		mockLogger.EXPECT().Error("failed to find active invite link")
+
+		mockRepo.EXPECT().GetActiveInviteLink(ctx, "abc").Return(nil, nil)
+
+		_, err := s.JoinByInvite(ctx, &chat.JoinByInviteIn{Code: "abc"})
+
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), "failed to find active invite link")
+	})
+
+	t.Run("link_exhausted_concurrently", func(t *testing.T) {
+		mockLogger.EXPECT().AddFuncName("JoinByInvite")
+		mockLogger.EXPECT().Error("failed to find active invite link")
+
+		mockRepo.EXPECT().GetActiveInviteLink(ctx, "abc").Return(link, nil)
+		mockRepo.EXPECT().GetChatMember(ctx, chatUUID, userUUID).Return(nil, nil)
+		mockRepo.EXPECT().IsMemberBanned(ctx, chatUUID, userUUID).Return(false, nil)
+		mockUserClient.EXPECT().GetUserInfoByUUID(ctx, userUUID).
+			Return(&model.ChatMemberParams{Nickname: "student"}, nil)
+		mockRepo.EXPECT().JoinByInvite(ctx, "abc", gomock.Any()).Return(&model.InviteJoin{}, nil)
+
+		_, err := s.JoinByInvite(ctx, &chat.JoinByInviteIn{Code: "abc"})
+
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), "failed to find active invite link")
+	})
+
+	t.Run("joined_concurrently", func(t *testing.T) {
+		mockLogger.EXPECT().AddFuncName("JoinByInvite")
+
+		mockRepo.EXPECT().GetActiveInviteLink(ctx, "abc").Return(link, nil)
+		mockRepo.EXPECT().GetChatMember(ctx, chatUUID, userUUID).Return(nil, nil)
+		mockRepo.EXPECT().IsMemberBanned(ctx, chatUUID, userUUID).Return(false, nil)
+		mockUserClient.EXPECT().GetUserInfoByUUID(ctx, userUUID).
+			Return(&model.ChatMemberParams{Nickname: "student"}, nil)
+		mockRepo.EXPECT().JoinByInvite(ctx, "abc", gomock.Any()).Return(&model.InviteJoin{LinkActive: true}, nil)
+
+		out, err := s.JoinByInvite(ctx, &chat.JoinByInviteIn{Code: "abc"})
+
+		assert.NoError(t, err)
+		assert.Equal(t, chatUUID, out.ChatUuid)
+		assert.False(t, out.Joined)
+	})
+
+	t.Run("empty_code", func(t *testing.T) {
+		mockLogger.EXPECT().AddFuncName("JoinByInvite")
+		mockLogger.EXPECT().Error("failed to join by invite: invalid code")
+
+		_, err := s.JoinByInvite(ctx, &chat.JoinByInviteIn{Code: "  "})
+
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), "failed to join by invite: invalid code")
+	})
 }
@@ -0,0 +1,106 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	logger_lib "github.com/s21platform/logger-lib"
+
+	"github.com/s21platform/chat-service/internal/config"
+	"github.com/s21platform/chat-service/pkg/chat"
+)
+
+const (
+	// состояние «печатает» сбрасывается, если клиент не обновил его за это время
+	typingTTL = 6 * time.Second
+	// минимальный интервал между учитываемыми обновлениями одного пользователя в чате
+	typingThrottle = time.Second
+
+	typingBufferSize = 64
+)
+
+func (s *Server) SetTyping(ctx context.Context, in *chat.SetTypingIn) (*chat.SetTypingOut, error) {
+	logger := logger_lib.FromContext(ctx, config.KeyLogger)
+	logger.AddFuncName("SetTyping")
+
+	userUUID, ok := ctx.Value(config.KeyUUID).(string)
+	if !ok {
+		logger.Error("failed to find uuid")
+		return nil, status.Error(codes.Internal, "failed to find uuid")
+	}
+
+	err := s.checkTypingAccess(ctx, in.ChatUuid, userUUID)
+	if err != nil {
+		logger.Error(status.Convert(err).Message())
+		return nil, err
+	}
+
+	return &chat.SetTypingOut{
+		Accepted: s.typing.Set(in.ChatUuid, userUUID, in.Typing),
+	}, nil
+}
+
+func (s *Server) WatchTyping(in *chat.WatchTypingIn, stream grpc.ServerStreamingServer[chat.TypingUpdate]) error {
+	ctx := stream.Context()
+	logger := logger_lib.FromContext(ctx, config.KeyLogger)
+	logger.AddFuncName("WatchTyping")
+
+	userUUID, ok := ctx.Value(config.KeyUUID).(string)
+	if !ok {
+		logger.Error("failed to find uuid")
+		return status.Error(codes.Internal, "failed to find uuid")
+	}
+
+	err := s.checkTypingAccess(ctx, in.ChatUuid, userUUID)
+	if err != nil {
+		logger.Error(status.Convert(err).Message())
+		return err
+	}
+
+	sub, typingUsers := s.typing.Watch(in.ChatUuid)
+	defer s.typing.Unwatch(sub)
+
+	for _, typingUser := range typingUsers {
+		err = stream.Send(&chat.TypingUpdate{ChatUuid: in.ChatUuid, UserUuid: typingUser, Typing: true})
+		if err != nil {
+			logger.Error(fmt.Sprintf("failed to send typing update: %v", err))
+			return status.Errorf(codes.Internal, "failed to send typing update: %v", err)
+		}
+	}
+
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case update, ok := <-sub.Events():
+			if !ok {
+				logger.Error("failed to deliver typing updates: subscriber is too slow")
+				return status.Error(codes.Unavailable, "failed to deliver typing updates: subscriber is too slow")
+			}
+
+			err = stream.Send(&chat.TypingUpdate{ChatUuid: update.ChatUUID, UserUuid: update.UserUUID, Typing: update.Typing})
+			if err != nil {
+				logger.Error(fmt.Sprintf("failed to send typing update: %v", err))
+				return status.Errorf(codes.Internal, "failed to send typing update: %v", err)
+			}
+		}
+	}
+}
+
+func (s *Server) checkTypingAccess(ctx context.Context, chatUUID, userUUID string) error {
+	isMember, err := s.repository.IsChatMember(ctx, chatUUID, userUUID)
+	if err != nil {
+		return status.Errorf(codes.Internal, "failed to check user in chat: %v", err)
+	}
+
+	if !isMember {
+		return status.Error(codes.PermissionDenied, "failed to user is not chat member")
+	}
+
+	return nil
+}
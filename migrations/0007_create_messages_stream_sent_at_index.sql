@@ -0,0 +1,5 @@
+-- +goose Up
+CREATE INDEX IF NOT EXISTS idx_messages_stream_sent_at ON messages (stream_id, sent_at, id);
+
+-- +goose Down
+DROP INDEX IF EXISTS idx_messages_stream_sent_at;
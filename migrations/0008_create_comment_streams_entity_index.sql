@@ -0,0 +1,7 @@
+-- +goose Up
+CREATE UNIQUE INDEX IF NOT EXISTS idx_streams_comment_entity
+    ON streams ((metadata ->> 'entity_type'), (metadata ->> 'entity_id'))
+    WHERE type = 'comment';
+
+-- +goose Down
+DROP INDEX IF EXISTS idx_streams_comment_entity;
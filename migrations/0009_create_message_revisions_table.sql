@@ -0,0 +1,16 @@
+-- +goose Up
+CREATE TABLE IF NOT EXISTS message_revisions
+(
+    id          UUID PRIMARY KEY   DEFAULT gen_random_uuid(),
+    message_id  UUID      NOT NULL,
+    content     TEXT,
+    written_at  TIMESTAMP NOT NULL,
+    replaced_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    FOREIGN KEY (message_id) REFERENCES messages (id)
+);
+
+CREATE INDEX IF NOT EXISTS idx_message_revisions_message_replaced_at
+    ON message_revisions (message_id, replaced_at);
+
+-- +goose Down
+DROP TABLE IF EXISTS message_revisions;
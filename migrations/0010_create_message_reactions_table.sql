@@ -0,0 +1,14 @@
+-- +goose Up
+CREATE TABLE IF NOT EXISTS message_reactions
+(
+    message_id UUID        NOT NULL,
+    user_id    UUID        NOT NULL,
+    emoji      VARCHAR(64) NOT NULL,
+    created_at TIMESTAMP   NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    PRIMARY KEY (message_id, user_id, emoji),
+    FOREIGN KEY (message_id) REFERENCES messages (id),
+    FOREIGN KEY (user_id) REFERENCES users (id)
+);
+
+-- +goose Down
+DROP TABLE IF EXISTS message_reactions;
@@ -0,0 +1,18 @@
+-- +goose Up
+CREATE TABLE IF NOT EXISTS pinned_messages
+(
+    stream_id  UUID      NOT NULL,
+    message_id UUID      NOT NULL,
+    pinned_by  UUID      NOT NULL,
+    pinned_at  TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    PRIMARY KEY (stream_id, message_id),
+    FOREIGN KEY (stream_id) REFERENCES streams (id),
+    FOREIGN KEY (message_id) REFERENCES messages (id),
+    FOREIGN KEY (pinned_by) REFERENCES users (id)
+);
+
+CREATE INDEX IF NOT EXISTS idx_pinned_messages_stream_pinned_at
+    ON pinned_messages (stream_id, pinned_at);
+
+-- +goose Down
+DROP TABLE IF EXISTS pinned_messages;
@@ -0,0 +1,7 @@
+-- +goose NO TRANSACTION
+-- +goose Up
+ALTER TYPE message_type ADD VALUE IF NOT EXISTS 'system';
+
+-- +goose Down
+-- значение enum нельзя удалить без пересоздания типа, поэтому откат не меняет message_type
+SELECT 1;
@@ -0,0 +1,11 @@
+-- +goose Up
+ALTER TABLE messages
+    ADD COLUMN IF NOT EXISTS forwarded_from_message_id UUID,
+    ADD COLUMN IF NOT EXISTS forwarded_from_sender_id  UUID,
+    ADD COLUMN IF NOT EXISTS forwarded_from_stream_id  UUID;
+
+-- +goose Down
+ALTER TABLE messages
+    DROP COLUMN IF EXISTS forwarded_from_message_id,
+    DROP COLUMN IF EXISTS forwarded_from_sender_id,
+    DROP COLUMN IF EXISTS forwarded_from_stream_id;
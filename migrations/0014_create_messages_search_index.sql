@@ -0,0 +1,13 @@
+-- +goose Up
+-- конфигурация russian стеммит кириллицу русским словарем, а латиницу английским
+ALTER TABLE messages
+    ADD COLUMN IF NOT EXISTS search_vector tsvector
+        GENERATED ALWAYS AS (to_tsvector('russian', COALESCE(content, ''))) STORED;
+
+CREATE INDEX IF NOT EXISTS idx_messages_search_vector
+    ON messages USING GIN (search_vector);
+
+-- +goose Down
+DROP INDEX IF EXISTS idx_messages_search_vector;
+ALTER TABLE messages
+    DROP COLUMN IF EXISTS search_vector;
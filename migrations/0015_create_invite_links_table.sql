@@ -0,0 +1,23 @@
+-- +goose Up
+CREATE TABLE IF NOT EXISTS invite_links
+(
+    id          UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    stream_id   UUID      NOT NULL,
+    code        TEXT      NOT NULL DEFAULT translate(encode(gen_random_bytes(12), 'base64'), '+/', '-_'),
+    created_by  UUID      NOT NULL,
+    created_at  TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    expires_at  TIMESTAMP,
+    usage_limit INTEGER,
+    usage_count INTEGER   NOT NULL DEFAULT 0,
+    revoked_at  TIMESTAMP,
+    FOREIGN KEY (stream_id) REFERENCES streams (id),
+    FOREIGN KEY (created_by) REFERENCES users (id),
+    CONSTRAINT unique_invite_link_code UNIQUE (code)
+);
+
+CREATE INDEX IF NOT EXISTS idx_invite_links_stream_active
+    ON invite_links (stream_id, created_at)
+    WHERE revoked_at IS NULL;
+
+-- +goose Down
+DROP TABLE IF EXISTS invite_links;
@@ -0,0 +1,9 @@
+-- +goose Up
+ALTER TABLE stream_members
+    ADD COLUMN IF NOT EXISTS muted_until   TIMESTAMP,
+    ADD COLUMN IF NOT EXISTS mentions_only BOOLEAN NOT NULL DEFAULT FALSE;
+
+-- +goose Down
+ALTER TABLE stream_members
+    DROP COLUMN IF EXISTS muted_until,
+    DROP COLUMN IF EXISTS mentions_only;
@@ -0,0 +1,7 @@
+-- +goose Up
+ALTER TABLE stream_members
+    ADD COLUMN IF NOT EXISTS history_from TIMESTAMP;
+
+-- +goose Down
+ALTER TABLE stream_members
+    DROP COLUMN IF EXISTS history_from;
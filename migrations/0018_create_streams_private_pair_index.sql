@@ -0,0 +1,20 @@
+-- +goose Up
+-- ключ пары проставляется самому старому личному чату каждой пары, более поздние дубликаты остаются без ключа
+UPDATE streams s
+SET metadata = COALESCE(s.metadata, '{}'::jsonb) || jsonb_build_object('pair_key', p.pair_key)
+FROM (SELECT DISTINCT ON (pairs.pair_key) pairs.stream_id, pairs.pair_key
+      FROM (SELECT sm.stream_id, string_agg(sm.user_id::text, ':' ORDER BY sm.user_id::text) AS pair_key
+            FROM stream_members sm
+                     JOIN streams ps ON ps.id = sm.stream_id AND ps.type = 'private'
+            GROUP BY sm.stream_id
+            HAVING COUNT(*) = 2) pairs
+               JOIN streams ps ON ps.id = pairs.stream_id
+      ORDER BY pairs.pair_key, ps.created_at) p
+WHERE s.id = p.stream_id;
+
+CREATE UNIQUE INDEX IF NOT EXISTS idx_streams_private_pair
+    ON streams ((metadata ->> 'pair_key'))
+    WHERE type = 'private';
+
+-- +goose Down
+DROP INDEX IF EXISTS idx_streams_private_pair;
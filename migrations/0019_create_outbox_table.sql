@@ -0,0 +1,17 @@
+-- +goose Up
+CREATE TABLE IF NOT EXISTS outbox
+(
+    id           BIGSERIAL PRIMARY KEY,
+    stream_id    UUID      NOT NULL,
+    event_type   TEXT      NOT NULL,
+    payload      JSONB     NOT NULL,
+    created_at   TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    published_at TIMESTAMP
+);
+
+CREATE INDEX IF NOT EXISTS idx_outbox_unpublished
+    ON outbox (id)
+    WHERE published_at IS NULL;
+
+-- +goose Down
+DROP TABLE IF EXISTS outbox;
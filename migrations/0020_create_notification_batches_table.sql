@@ -0,0 +1,18 @@
+-- +goose Up
+CREATE TABLE IF NOT EXISTS notification_batches
+(
+    recipient_id     UUID      NOT NULL,
+    stream_id        UUID      NOT NULL,
+    messages_count   BIGINT    NOT NULL DEFAULT 1,
+    last_message_id  UUID      NOT NULL,
+    last_sender_id   UUID      NOT NULL,
+    first_message_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    last_message_at  TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    PRIMARY KEY (recipient_id, stream_id)
+);
+
+CREATE INDEX IF NOT EXISTS idx_notification_batches_first_message_at
+    ON notification_batches (first_message_at);
+
+-- +goose Down
+DROP TABLE IF EXISTS notification_batches;
@@ -0,0 +1,55 @@
+-- +goose Up
+-- дубликаты личных чатов без ключа пары сливаются в чат с ключом: сообщения и закрепы переносятся, дубликат удаляется
+CREATE TEMP TABLE private_duplicates ON COMMIT DROP AS
+SELECT d.stream_id AS duplicate_id, k.id AS keyed_id
+FROM (SELECT sm.stream_id, string_agg(sm.user_id::text, ':' ORDER BY sm.user_id::text) AS pair_key
+      FROM stream_members sm
+               JOIN streams ps ON ps.id = sm.stream_id AND ps.type = 'private'
+      WHERE ps.metadata ->> 'pair_key' IS NULL
+      GROUP BY sm.stream_id
+      HAVING COUNT(*) = 2) d
+         JOIN streams k ON k.type = 'private' AND k.metadata ->> 'pair_key' = d.pair_key;
+
+UPDATE messages m
+SET stream_id = pd.keyed_id
+FROM private_duplicates pd
+WHERE m.stream_id = pd.duplicate_id;
+
+UPDATE messages m
+SET forwarded_from_stream_id = pd.keyed_id
+FROM private_duplicates pd
+WHERE m.forwarded_from_stream_id = pd.duplicate_id;
+
+UPDATE pinned_messages pm
+SET stream_id = pd.keyed_id
+FROM private_duplicates pd
+WHERE pm.stream_id = pd.duplicate_id;
+
+UPDATE outbox o
+SET stream_id = pd.keyed_id
+FROM private_duplicates pd
+WHERE o.stream_id = pd.duplicate_id;
+
+DELETE
+FROM notification_batches nb
+    USING private_duplicates pd
+WHERE nb.stream_id = pd.duplicate_id;
+
+DELETE
+FROM invite_links il
+    USING private_duplicates pd
+WHERE il.stream_id = pd.duplicate_id;
+
+DELETE
+FROM stream_members sm
+    USING private_duplicates pd
+WHERE sm.stream_id = pd.duplicate_id;
+
+DELETE
+FROM streams s
+    USING private_duplicates pd
+WHERE s.id = pd.duplicate_id;
+
+-- +goose Down
+-- слитые дубликаты восстановить нельзя, поэтому откат ничего не меняет
+SELECT 1;
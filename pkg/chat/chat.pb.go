@@ -71,7 +71,8 @@ type CreatePrivateChatOut struct {
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	NewChatUuid string `protobuf:"bytes,1,opt,name=new_chat_uuid,json=newChatUuid,proto3" json:"new_chat_uuid,omitempty"` // uuid созданного чата
+	NewChatUuid string `protobuf:"bytes,1,opt,name=new_chat_uuid,json=newChatUuid,proto3" json:"new_chat_uuid,omitempty"` // uuid созданного или уже существующего чата с собеседником
+	Created     bool   `protobuf:"varint,2,opt,name=created,proto3" json:"created,omitempty"`                             // true - чат создан, false - возвращен существующий
 }
 
 func (x *CreatePrivateChatOut) Reset() {
@@ -111,32 +112,37 @@ func (x *CreatePrivateChatOut) GetNewChatUuid() string {
 	return ""
 }
 
-type Chat struct {
+func (x *CreatePrivateChatOut) GetCreated() bool {
+	if x != nil {
+		return x.Created
+	}
+	return false
+}
+
+type CreateGroupChatIn struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	LastMessage          string `protobuf:"bytes,1,opt,name=last_message,json=lastMessage,proto3" json:"last_message,omitempty"`                              // Контент последнего сообщения
-	ChatName             string `protobuf:"bytes,2,opt,name=chat_name,json=chatName,proto3" json:"chat_name,omitempty"`                                       // Название чата
-	AvatarUrl            string `protobuf:"bytes,3,opt,name=avatar_url,json=avatarUrl,proto3" json:"avatar_url,omitempty"`                                    // Аватарка чата
-	LastMessageTimestamp string `protobuf:"bytes,4,opt,name=last_message_timestamp,json=lastMessageTimestamp,proto3" json:"last_message_timestamp,omitempty"` // Время отправки последнего сообщения
-	ChatUuid             string `protobuf:"bytes,5,opt,name=chat_uuid,json=chatUuid,proto3" json:"chat_uuid,omitempty"`                                       // UUID чата
+	Name        string   `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`                                  // название группового чата
+	AvatarUrl   string   `protobuf:"bytes,2,opt,name=avatar_url,json=avatarUrl,proto3" json:"avatar_url,omitempty"`       // аватарка группового чата
+	MemberUuids []string `protobuf:"bytes,3,rep,name=member_uuids,json=memberUuids,proto3" json:"member_uuids,omitempty"` // uuid пользователей, которых сразу добавляем в чат
 }
 
-func (x *Chat) Reset() {
-	*x = Chat{}
+func (x *CreateGroupChatIn) Reset() {
+	*x = CreateGroupChatIn{}
 	mi := &file_api_chat_proto_msgTypes[2]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *Chat) String() string {
+func (x *CreateGroupChatIn) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Chat) ProtoMessage() {}
+func (*CreateGroupChatIn) ProtoMessage() {}
 
-func (x *Chat) ProtoReflect() protoreflect.Message {
+func (x *CreateGroupChatIn) ProtoReflect() protoreflect.Message {
 	mi := &file_api_chat_proto_msgTypes[2]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -148,69 +154,5198 @@ func (x *Chat) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Chat.ProtoReflect.Descriptor instead.
-func (*Chat) Descriptor() ([]byte, []int) {
+// Deprecated: Use CreateGroupChatIn.ProtoReflect.Descriptor instead.
+func (*CreateGroupChatIn) Descriptor() ([]byte, []int) {
 	return file_api_chat_proto_rawDescGZIP(), []int{2}
 }
 
-func (x *Chat) GetLastMessage() string {
+func (x *CreateGroupChatIn) GetName() string {
 	if x != nil {
-		return x.LastMessage
+		return x.Name
 	}
 	return ""
 }
 
-func (x *Chat) GetChatName() string {
+func (x *CreateGroupChatIn) GetAvatarUrl() string {
 	if x != nil {
-		return x.ChatName
+		return x.AvatarUrl
+	}
+	return ""
+}
+
+func (x *CreateGroupChatIn) GetMemberUuids() []string {
+	if x != nil {
+		return x.MemberUuids
+	}
+	return nil
+}
+
+type CreateGroupChatOut struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	NewChatUuid string `protobuf:"bytes,1,opt,name=new_chat_uuid,json=newChatUuid,proto3" json:"new_chat_uuid,omitempty"` // uuid созданного чата
+}
+
+func (x *CreateGroupChatOut) Reset() {
+	*x = CreateGroupChatOut{}
+	mi := &file_api_chat_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateGroupChatOut) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateGroupChatOut) ProtoMessage() {}
+
+func (x *CreateGroupChatOut) ProtoReflect() protoreflect.Message {
+	mi := &file_api_chat_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateGroupChatOut.ProtoReflect.Descriptor instead.
+func (*CreateGroupChatOut) Descriptor() ([]byte, []int) {
+	return file_api_chat_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *CreateGroupChatOut) GetNewChatUuid() string {
+	if x != nil {
+		return x.NewChatUuid
+	}
+	return ""
+}
+
+type AddGroupMembersIn struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ChatUuid    string   `protobuf:"bytes,1,opt,name=chat_uuid,json=chatUuid,proto3" json:"chat_uuid,omitempty"`          // uuid группового чата
+	MemberUuids []string `protobuf:"bytes,2,rep,name=member_uuids,json=memberUuids,proto3" json:"member_uuids,omitempty"` // uuid добавляемых пользователей
+}
+
+func (x *AddGroupMembersIn) Reset() {
+	*x = AddGroupMembersIn{}
+	mi := &file_api_chat_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AddGroupMembersIn) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AddGroupMembersIn) ProtoMessage() {}
+
+func (x *AddGroupMembersIn) ProtoReflect() protoreflect.Message {
+	mi := &file_api_chat_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AddGroupMembersIn.ProtoReflect.Descriptor instead.
+func (*AddGroupMembersIn) Descriptor() ([]byte, []int) {
+	return file_api_chat_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *AddGroupMembersIn) GetChatUuid() string {
+	if x != nil {
+		return x.ChatUuid
+	}
+	return ""
+}
+
+func (x *AddGroupMembersIn) GetMemberUuids() []string {
+	if x != nil {
+		return x.MemberUuids
+	}
+	return nil
+}
+
+type AddGroupMembersOut struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	AddedUuids []string `protobuf:"bytes,1,rep,name=added_uuids,json=addedUuids,proto3" json:"added_uuids,omitempty"` // uuid пользователей, добавленных в чат
+}
+
+func (x *AddGroupMembersOut) Reset() {
+	*x = AddGroupMembersOut{}
+	mi := &file_api_chat_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AddGroupMembersOut) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AddGroupMembersOut) ProtoMessage() {}
+
+func (x *AddGroupMembersOut) ProtoReflect() protoreflect.Message {
+	mi := &file_api_chat_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AddGroupMembersOut.ProtoReflect.Descriptor instead.
+func (*AddGroupMembersOut) Descriptor() ([]byte, []int) {
+	return file_api_chat_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *AddGroupMembersOut) GetAddedUuids() []string {
+	if x != nil {
+		return x.AddedUuids
+	}
+	return nil
+}
+
+type RemoveGroupMemberIn struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ChatUuid   string `protobuf:"bytes,1,opt,name=chat_uuid,json=chatUuid,proto3" json:"chat_uuid,omitempty"`       // uuid группового чата
+	MemberUuid string `protobuf:"bytes,2,opt,name=member_uuid,json=memberUuid,proto3" json:"member_uuid,omitempty"` // uuid удаляемого участника
+}
+
+func (x *RemoveGroupMemberIn) Reset() {
+	*x = RemoveGroupMemberIn{}
+	mi := &file_api_chat_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RemoveGroupMemberIn) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RemoveGroupMemberIn) ProtoMessage() {}
+
+func (x *RemoveGroupMemberIn) ProtoReflect() protoreflect.Message {
+	mi := &file_api_chat_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RemoveGroupMemberIn.ProtoReflect.Descriptor instead.
+func (*RemoveGroupMemberIn) Descriptor() ([]byte, []int) {
+	return file_api_chat_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *RemoveGroupMemberIn) GetChatUuid() string {
+	if x != nil {
+		return x.ChatUuid
+	}
+	return ""
+}
+
+func (x *RemoveGroupMemberIn) GetMemberUuid() string {
+	if x != nil {
+		return x.MemberUuid
+	}
+	return ""
+}
+
+type RemoveGroupMemberOut struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	RemovalStatus bool `protobuf:"varint,1,opt,name=removal_status,json=removalStatus,proto3" json:"removal_status,omitempty"` // статус удаления
+}
+
+func (x *RemoveGroupMemberOut) Reset() {
+	*x = RemoveGroupMemberOut{}
+	mi := &file_api_chat_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RemoveGroupMemberOut) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RemoveGroupMemberOut) ProtoMessage() {}
+
+func (x *RemoveGroupMemberOut) ProtoReflect() protoreflect.Message {
+	mi := &file_api_chat_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RemoveGroupMemberOut.ProtoReflect.Descriptor instead.
+func (*RemoveGroupMemberOut) Descriptor() ([]byte, []int) {
+	return file_api_chat_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *RemoveGroupMemberOut) GetRemovalStatus() bool {
+	if x != nil {
+		return x.RemovalStatus
+	}
+	return false
+}
+
+type InviteLink struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Code       string `protobuf:"bytes,1,opt,name=code,proto3" json:"code,omitempty"`                                // код приглашения
+	ChatUuid   string `protobuf:"bytes,2,opt,name=chat_uuid,json=chatUuid,proto3" json:"chat_uuid,omitempty"`        // uuid группового чата
+	CreatedBy  string `protobuf:"bytes,3,opt,name=created_by,json=createdBy,proto3" json:"created_by,omitempty"`     // uuid создателя ссылки
+	CreatedAt  string `protobuf:"bytes,4,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`     // время создания
+	ExpiresAt  string `protobuf:"bytes,5,opt,name=expires_at,json=expiresAt,proto3" json:"expires_at,omitempty"`     // время истечения, пусто для бессрочной ссылки
+	UsageLimit int32  `protobuf:"varint,6,opt,name=usage_limit,json=usageLimit,proto3" json:"usage_limit,omitempty"` // максимальное число вступлений, 0 - без ограничения
+	UsageCount int32  `protobuf:"varint,7,opt,name=usage_count,json=usageCount,proto3" json:"usage_count,omitempty"` // число вступлений по ссылке
+}
+
+func (x *InviteLink) Reset() {
+	*x = InviteLink{}
+	mi := &file_api_chat_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *InviteLink) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*InviteLink) ProtoMessage() {}
+
+func (x *InviteLink) ProtoReflect() protoreflect.Message {
+	mi := &file_api_chat_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use InviteLink.ProtoReflect.Descriptor instead.
+func (*InviteLink) Descriptor() ([]byte, []int) {
+	return file_api_chat_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *InviteLink) GetCode() string {
+	if x != nil {
+		return x.Code
+	}
+	return ""
+}
+
+func (x *InviteLink) GetChatUuid() string {
+	if x != nil {
+		return x.ChatUuid
+	}
+	return ""
+}
+
+func (x *InviteLink) GetCreatedBy() string {
+	if x != nil {
+		return x.CreatedBy
+	}
+	return ""
+}
+
+func (x *InviteLink) GetCreatedAt() string {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return ""
+}
+
+func (x *InviteLink) GetExpiresAt() string {
+	if x != nil {
+		return x.ExpiresAt
+	}
+	return ""
+}
+
+func (x *InviteLink) GetUsageLimit() int32 {
+	if x != nil {
+		return x.UsageLimit
+	}
+	return 0
+}
+
+func (x *InviteLink) GetUsageCount() int32 {
+	if x != nil {
+		return x.UsageCount
+	}
+	return 0
+}
+
+type CreateInviteLinkIn struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ChatUuid         string `protobuf:"bytes,1,opt,name=chat_uuid,json=chatUuid,proto3" json:"chat_uuid,omitempty"`                            // uuid группового чата
+	ExpiresInSeconds int64  `protobuf:"varint,2,opt,name=expires_in_seconds,json=expiresInSeconds,proto3" json:"expires_in_seconds,omitempty"` // срок действия в секундах, 0 - бессрочно
+	UsageLimit       int32  `protobuf:"varint,3,opt,name=usage_limit,json=usageLimit,proto3" json:"usage_limit,omitempty"`                     // максимальное число вступлений, 0 - без ограничения
+}
+
+func (x *CreateInviteLinkIn) Reset() {
+	*x = CreateInviteLinkIn{}
+	mi := &file_api_chat_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateInviteLinkIn) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateInviteLinkIn) ProtoMessage() {}
+
+func (x *CreateInviteLinkIn) ProtoReflect() protoreflect.Message {
+	mi := &file_api_chat_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateInviteLinkIn.ProtoReflect.Descriptor instead.
+func (*CreateInviteLinkIn) Descriptor() ([]byte, []int) {
+	return file_api_chat_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *CreateInviteLinkIn) GetChatUuid() string {
+	if x != nil {
+		return x.ChatUuid
+	}
+	return ""
+}
+
+func (x *CreateInviteLinkIn) GetExpiresInSeconds() int64 {
+	if x != nil {
+		return x.ExpiresInSeconds
+	}
+	return 0
+}
+
+func (x *CreateInviteLinkIn) GetUsageLimit() int32 {
+	if x != nil {
+		return x.UsageLimit
+	}
+	return 0
+}
+
+type CreateInviteLinkOut struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Link *InviteLink `protobuf:"bytes,1,opt,name=link,proto3" json:"link,omitempty"` // созданная ссылка
+}
+
+func (x *CreateInviteLinkOut) Reset() {
+	*x = CreateInviteLinkOut{}
+	mi := &file_api_chat_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateInviteLinkOut) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateInviteLinkOut) ProtoMessage() {}
+
+func (x *CreateInviteLinkOut) ProtoReflect() protoreflect.Message {
+	mi := &file_api_chat_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateInviteLinkOut.ProtoReflect.Descriptor instead.
+func (*CreateInviteLinkOut) Descriptor() ([]byte, []int) {
+	return file_api_chat_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *CreateInviteLinkOut) GetLink() *InviteLink {
+	if x != nil {
+		return x.Link
+	}
+	return nil
+}
+
+type RevokeInviteLinkIn struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ChatUuid string `protobuf:"bytes,1,opt,name=chat_uuid,json=chatUuid,proto3" json:"chat_uuid,omitempty"` // uuid группового чата
+	Code     string `protobuf:"bytes,2,opt,name=code,proto3" json:"code,omitempty"`                         // код приглашения
+}
+
+func (x *RevokeInviteLinkIn) Reset() {
+	*x = RevokeInviteLinkIn{}
+	mi := &file_api_chat_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RevokeInviteLinkIn) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RevokeInviteLinkIn) ProtoMessage() {}
+
+func (x *RevokeInviteLinkIn) ProtoReflect() protoreflect.Message {
+	mi := &file_api_chat_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RevokeInviteLinkIn.ProtoReflect.Descriptor instead.
+func (*RevokeInviteLinkIn) Descriptor() ([]byte, []int) {
+	return file_api_chat_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *RevokeInviteLinkIn) GetChatUuid() string {
+	if x != nil {
+		return x.ChatUuid
+	}
+	return ""
+}
+
+func (x *RevokeInviteLinkIn) GetCode() string {
+	if x != nil {
+		return x.Code
+	}
+	return ""
+}
+
+type RevokeInviteLinkOut struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	RevokeStatus bool `protobuf:"varint,1,opt,name=revoke_status,json=revokeStatus,proto3" json:"revoke_status,omitempty"` // статус отзыва
+}
+
+func (x *RevokeInviteLinkOut) Reset() {
+	*x = RevokeInviteLinkOut{}
+	mi := &file_api_chat_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RevokeInviteLinkOut) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RevokeInviteLinkOut) ProtoMessage() {}
+
+func (x *RevokeInviteLinkOut) ProtoReflect() protoreflect.Message {
+	mi := &file_api_chat_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RevokeInviteLinkOut.ProtoReflect.Descriptor instead.
+func (*RevokeInviteLinkOut) Descriptor() ([]byte, []int) {
+	return file_api_chat_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *RevokeInviteLinkOut) GetRevokeStatus() bool {
+	if x != nil {
+		return x.RevokeStatus
+	}
+	return false
+}
+
+type ListInviteLinksIn struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ChatUuid string `protobuf:"bytes,1,opt,name=chat_uuid,json=chatUuid,proto3" json:"chat_uuid,omitempty"` // uuid группового чата
+}
+
+func (x *ListInviteLinksIn) Reset() {
+	*x = ListInviteLinksIn{}
+	mi := &file_api_chat_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListInviteLinksIn) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListInviteLinksIn) ProtoMessage() {}
+
+func (x *ListInviteLinksIn) ProtoReflect() protoreflect.Message {
+	mi := &file_api_chat_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListInviteLinksIn.ProtoReflect.Descriptor instead.
+func (*ListInviteLinksIn) Descriptor() ([]byte, []int) {
+	return file_api_chat_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *ListInviteLinksIn) GetChatUuid() string {
+	if x != nil {
+		return x.ChatUuid
+	}
+	return ""
+}
+
+type ListInviteLinksOut struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Links []*InviteLink `protobuf:"bytes,1,rep,name=links,proto3" json:"links,omitempty"` // действующие ссылки, новые первыми
+}
+
+func (x *ListInviteLinksOut) Reset() {
+	*x = ListInviteLinksOut{}
+	mi := &file_api_chat_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListInviteLinksOut) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListInviteLinksOut) ProtoMessage() {}
+
+func (x *ListInviteLinksOut) ProtoReflect() protoreflect.Message {
+	mi := &file_api_chat_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListInviteLinksOut.ProtoReflect.Descriptor instead.
+func (*ListInviteLinksOut) Descriptor() ([]byte, []int) {
+	return file_api_chat_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *ListInviteLinksOut) GetLinks() []*InviteLink {
+	if x != nil {
+		return x.Links
+	}
+	return nil
+}
+
+type JoinByInviteIn struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Code string `protobuf:"bytes,1,opt,name=code,proto3" json:"code,omitempty"` // код приглашения
+}
+
+func (x *JoinByInviteIn) Reset() {
+	*x = JoinByInviteIn{}
+	mi := &file_api_chat_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *JoinByInviteIn) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*JoinByInviteIn) ProtoMessage() {}
+
+func (x *JoinByInviteIn) ProtoReflect() protoreflect.Message {
+	mi := &file_api_chat_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use JoinByInviteIn.ProtoReflect.Descriptor instead.
+func (*JoinByInviteIn) Descriptor() ([]byte, []int) {
+	return file_api_chat_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *JoinByInviteIn) GetCode() string {
+	if x != nil {
+		return x.Code
+	}
+	return ""
+}
+
+type JoinByInviteOut struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ChatUuid string `protobuf:"bytes,1,opt,name=chat_uuid,json=chatUuid,proto3" json:"chat_uuid,omitempty"` // uuid чата, в который вступил пользователь
+	Joined   bool   `protobuf:"varint,2,opt,name=joined,proto3" json:"joined,omitempty"`                    // false, если пользователь уже состоял в чате
+}
+
+func (x *JoinByInviteOut) Reset() {
+	*x = JoinByInviteOut{}
+	mi := &file_api_chat_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *JoinByInviteOut) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*JoinByInviteOut) ProtoMessage() {}
+
+func (x *JoinByInviteOut) ProtoReflect() protoreflect.Message {
+	mi := &file_api_chat_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use JoinByInviteOut.ProtoReflect.Descriptor instead.
+func (*JoinByInviteOut) Descriptor() ([]byte, []int) {
+	return file_api_chat_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *JoinByInviteOut) GetChatUuid() string {
+	if x != nil {
+		return x.ChatUuid
+	}
+	return ""
+}
+
+func (x *JoinByInviteOut) GetJoined() bool {
+	if x != nil {
+		return x.Joined
+	}
+	return false
+}
+
+type CreateChannelIn struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Name      string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`                            // название канала
+	AvatarUrl string `protobuf:"bytes,2,opt,name=avatar_url,json=avatarUrl,proto3" json:"avatar_url,omitempty"` // аватарка канала
+}
+
+func (x *CreateChannelIn) Reset() {
+	*x = CreateChannelIn{}
+	mi := &file_api_chat_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateChannelIn) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateChannelIn) ProtoMessage() {}
+
+func (x *CreateChannelIn) ProtoReflect() protoreflect.Message {
+	mi := &file_api_chat_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateChannelIn.ProtoReflect.Descriptor instead.
+func (*CreateChannelIn) Descriptor() ([]byte, []int) {
+	return file_api_chat_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *CreateChannelIn) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *CreateChannelIn) GetAvatarUrl() string {
+	if x != nil {
+		return x.AvatarUrl
+	}
+	return ""
+}
+
+type CreateChannelOut struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	NewChatUuid string `protobuf:"bytes,1,opt,name=new_chat_uuid,json=newChatUuid,proto3" json:"new_chat_uuid,omitempty"` // uuid созданного канала
+}
+
+func (x *CreateChannelOut) Reset() {
+	*x = CreateChannelOut{}
+	mi := &file_api_chat_proto_msgTypes[18]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateChannelOut) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateChannelOut) ProtoMessage() {}
+
+func (x *CreateChannelOut) ProtoReflect() protoreflect.Message {
+	mi := &file_api_chat_proto_msgTypes[18]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateChannelOut.ProtoReflect.Descriptor instead.
+func (*CreateChannelOut) Descriptor() ([]byte, []int) {
+	return file_api_chat_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *CreateChannelOut) GetNewChatUuid() string {
+	if x != nil {
+		return x.NewChatUuid
+	}
+	return ""
+}
+
+type SubscribeChannelIn struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ChatUuid string `protobuf:"bytes,1,opt,name=chat_uuid,json=chatUuid,proto3" json:"chat_uuid,omitempty"` // uuid канала
+}
+
+func (x *SubscribeChannelIn) Reset() {
+	*x = SubscribeChannelIn{}
+	mi := &file_api_chat_proto_msgTypes[19]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SubscribeChannelIn) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SubscribeChannelIn) ProtoMessage() {}
+
+func (x *SubscribeChannelIn) ProtoReflect() protoreflect.Message {
+	mi := &file_api_chat_proto_msgTypes[19]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SubscribeChannelIn.ProtoReflect.Descriptor instead.
+func (*SubscribeChannelIn) Descriptor() ([]byte, []int) {
+	return file_api_chat_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *SubscribeChannelIn) GetChatUuid() string {
+	if x != nil {
+		return x.ChatUuid
+	}
+	return ""
+}
+
+type SubscribeChannelOut struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	SubscriptionStatus bool `protobuf:"varint,1,opt,name=subscription_status,json=subscriptionStatus,proto3" json:"subscription_status,omitempty"` // статус подписки
+}
+
+func (x *SubscribeChannelOut) Reset() {
+	*x = SubscribeChannelOut{}
+	mi := &file_api_chat_proto_msgTypes[20]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SubscribeChannelOut) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SubscribeChannelOut) ProtoMessage() {}
+
+func (x *SubscribeChannelOut) ProtoReflect() protoreflect.Message {
+	mi := &file_api_chat_proto_msgTypes[20]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SubscribeChannelOut.ProtoReflect.Descriptor instead.
+func (*SubscribeChannelOut) Descriptor() ([]byte, []int) {
+	return file_api_chat_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *SubscribeChannelOut) GetSubscriptionStatus() bool {
+	if x != nil {
+		return x.SubscriptionStatus
+	}
+	return false
+}
+
+type UnsubscribeChannelIn struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ChatUuid string `protobuf:"bytes,1,opt,name=chat_uuid,json=chatUuid,proto3" json:"chat_uuid,omitempty"` // uuid канала
+}
+
+func (x *UnsubscribeChannelIn) Reset() {
+	*x = UnsubscribeChannelIn{}
+	mi := &file_api_chat_proto_msgTypes[21]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UnsubscribeChannelIn) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UnsubscribeChannelIn) ProtoMessage() {}
+
+func (x *UnsubscribeChannelIn) ProtoReflect() protoreflect.Message {
+	mi := &file_api_chat_proto_msgTypes[21]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UnsubscribeChannelIn.ProtoReflect.Descriptor instead.
+func (*UnsubscribeChannelIn) Descriptor() ([]byte, []int) {
+	return file_api_chat_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *UnsubscribeChannelIn) GetChatUuid() string {
+	if x != nil {
+		return x.ChatUuid
+	}
+	return ""
+}
+
+type UnsubscribeChannelOut struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	UnsubscriptionStatus bool `protobuf:"varint,1,opt,name=unsubscription_status,json=unsubscriptionStatus,proto3" json:"unsubscription_status,omitempty"` // статус отписки
+}
+
+func (x *UnsubscribeChannelOut) Reset() {
+	*x = UnsubscribeChannelOut{}
+	mi := &file_api_chat_proto_msgTypes[22]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UnsubscribeChannelOut) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UnsubscribeChannelOut) ProtoMessage() {}
+
+func (x *UnsubscribeChannelOut) ProtoReflect() protoreflect.Message {
+	mi := &file_api_chat_proto_msgTypes[22]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UnsubscribeChannelOut.ProtoReflect.Descriptor instead.
+func (*UnsubscribeChannelOut) Descriptor() ([]byte, []int) {
+	return file_api_chat_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *UnsubscribeChannelOut) GetUnsubscriptionStatus() bool {
+	if x != nil {
+		return x.UnsubscriptionStatus
+	}
+	return false
+}
+
+type PublishToChannelIn struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ChatUuid string `protobuf:"bytes,1,opt,name=chat_uuid,json=chatUuid,proto3" json:"chat_uuid,omitempty"` // uuid канала
+	Content  string `protobuf:"bytes,2,opt,name=content,proto3" json:"content,omitempty"`                   // текст публикации
+}
+
+func (x *PublishToChannelIn) Reset() {
+	*x = PublishToChannelIn{}
+	mi := &file_api_chat_proto_msgTypes[23]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PublishToChannelIn) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PublishToChannelIn) ProtoMessage() {}
+
+func (x *PublishToChannelIn) ProtoReflect() protoreflect.Message {
+	mi := &file_api_chat_proto_msgTypes[23]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PublishToChannelIn.ProtoReflect.Descriptor instead.
+func (*PublishToChannelIn) Descriptor() ([]byte, []int) {
+	return file_api_chat_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *PublishToChannelIn) GetChatUuid() string {
+	if x != nil {
+		return x.ChatUuid
+	}
+	return ""
+}
+
+func (x *PublishToChannelIn) GetContent() string {
+	if x != nil {
+		return x.Content
+	}
+	return ""
+}
+
+type PublishToChannelOut struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Message *Message `protobuf:"bytes,1,opt,name=message,proto3" json:"message,omitempty"` // сохраненная публикация
+}
+
+func (x *PublishToChannelOut) Reset() {
+	*x = PublishToChannelOut{}
+	mi := &file_api_chat_proto_msgTypes[24]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PublishToChannelOut) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PublishToChannelOut) ProtoMessage() {}
+
+func (x *PublishToChannelOut) ProtoReflect() protoreflect.Message {
+	mi := &file_api_chat_proto_msgTypes[24]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PublishToChannelOut.ProtoReflect.Descriptor instead.
+func (*PublishToChannelOut) Descriptor() ([]byte, []int) {
+	return file_api_chat_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *PublishToChannelOut) GetMessage() *Message {
+	if x != nil {
+		return x.Message
+	}
+	return nil
+}
+
+type BanMemberIn struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ChatUuid        string `protobuf:"bytes,1,opt,name=chat_uuid,json=chatUuid,proto3" json:"chat_uuid,omitempty"`                       // uuid группового чата или канала
+	MemberUuid      string `protobuf:"bytes,2,opt,name=member_uuid,json=memberUuid,proto3" json:"member_uuid,omitempty"`                 // uuid банимого пользователя
+	Reason          string `protobuf:"bytes,3,opt,name=reason,proto3" json:"reason,omitempty"`                                           // причина бана
+	DurationSeconds int64  `protobuf:"varint,4,opt,name=duration_seconds,json=durationSeconds,proto3" json:"duration_seconds,omitempty"` // длительность бана в секундах, 0 - бессрочно
+}
+
+func (x *BanMemberIn) Reset() {
+	*x = BanMemberIn{}
+	mi := &file_api_chat_proto_msgTypes[25]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BanMemberIn) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BanMemberIn) ProtoMessage() {}
+
+func (x *BanMemberIn) ProtoReflect() protoreflect.Message {
+	mi := &file_api_chat_proto_msgTypes[25]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BanMemberIn.ProtoReflect.Descriptor instead.
+func (*BanMemberIn) Descriptor() ([]byte, []int) {
+	return file_api_chat_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *BanMemberIn) GetChatUuid() string {
+	if x != nil {
+		return x.ChatUuid
+	}
+	return ""
+}
+
+func (x *BanMemberIn) GetMemberUuid() string {
+	if x != nil {
+		return x.MemberUuid
+	}
+	return ""
+}
+
+func (x *BanMemberIn) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+func (x *BanMemberIn) GetDurationSeconds() int64 {
+	if x != nil {
+		return x.DurationSeconds
+	}
+	return 0
+}
+
+type BanMemberOut struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	BannedAt string `protobuf:"bytes,1,opt,name=banned_at,json=bannedAt,proto3" json:"banned_at,omitempty"` // время бана
+	BanUntil string `protobuf:"bytes,2,opt,name=ban_until,json=banUntil,proto3" json:"ban_until,omitempty"` // время автоматического снятия бана, пусто для бессрочного
+}
+
+func (x *BanMemberOut) Reset() {
+	*x = BanMemberOut{}
+	mi := &file_api_chat_proto_msgTypes[26]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BanMemberOut) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BanMemberOut) ProtoMessage() {}
+
+func (x *BanMemberOut) ProtoReflect() protoreflect.Message {
+	mi := &file_api_chat_proto_msgTypes[26]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BanMemberOut.ProtoReflect.Descriptor instead.
+func (*BanMemberOut) Descriptor() ([]byte, []int) {
+	return file_api_chat_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *BanMemberOut) GetBannedAt() string {
+	if x != nil {
+		return x.BannedAt
+	}
+	return ""
+}
+
+func (x *BanMemberOut) GetBanUntil() string {
+	if x != nil {
+		return x.BanUntil
+	}
+	return ""
+}
+
+type UnbanMemberIn struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ChatUuid   string `protobuf:"bytes,1,opt,name=chat_uuid,json=chatUuid,proto3" json:"chat_uuid,omitempty"`       // uuid группового чата или канала
+	MemberUuid string `protobuf:"bytes,2,opt,name=member_uuid,json=memberUuid,proto3" json:"member_uuid,omitempty"` // uuid разбаниваемого пользователя
+}
+
+func (x *UnbanMemberIn) Reset() {
+	*x = UnbanMemberIn{}
+	mi := &file_api_chat_proto_msgTypes[27]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UnbanMemberIn) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UnbanMemberIn) ProtoMessage() {}
+
+func (x *UnbanMemberIn) ProtoReflect() protoreflect.Message {
+	mi := &file_api_chat_proto_msgTypes[27]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UnbanMemberIn.ProtoReflect.Descriptor instead.
+func (*UnbanMemberIn) Descriptor() ([]byte, []int) {
+	return file_api_chat_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *UnbanMemberIn) GetChatUuid() string {
+	if x != nil {
+		return x.ChatUuid
+	}
+	return ""
+}
+
+func (x *UnbanMemberIn) GetMemberUuid() string {
+	if x != nil {
+		return x.MemberUuid
+	}
+	return ""
+}
+
+type UnbanMemberOut struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	UnbanStatus bool `protobuf:"varint,1,opt,name=unban_status,json=unbanStatus,proto3" json:"unban_status,omitempty"` // статус снятия бана
+}
+
+func (x *UnbanMemberOut) Reset() {
+	*x = UnbanMemberOut{}
+	mi := &file_api_chat_proto_msgTypes[28]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UnbanMemberOut) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UnbanMemberOut) ProtoMessage() {}
+
+func (x *UnbanMemberOut) ProtoReflect() protoreflect.Message {
+	mi := &file_api_chat_proto_msgTypes[28]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UnbanMemberOut.ProtoReflect.Descriptor instead.
+func (*UnbanMemberOut) Descriptor() ([]byte, []int) {
+	return file_api_chat_proto_rawDescGZIP(), []int{28}
+}
+
+func (x *UnbanMemberOut) GetUnbanStatus() bool {
+	if x != nil {
+		return x.UnbanStatus
+	}
+	return false
+}
+
+type KickMemberIn struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ChatUuid   string `protobuf:"bytes,1,opt,name=chat_uuid,json=chatUuid,proto3" json:"chat_uuid,omitempty"`       // uuid группового чата или канала
+	MemberUuid string `protobuf:"bytes,2,opt,name=member_uuid,json=memberUuid,proto3" json:"member_uuid,omitempty"` // uuid исключаемого участника или подписчика
+}
+
+func (x *KickMemberIn) Reset() {
+	*x = KickMemberIn{}
+	mi := &file_api_chat_proto_msgTypes[29]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *KickMemberIn) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*KickMemberIn) ProtoMessage() {}
+
+func (x *KickMemberIn) ProtoReflect() protoreflect.Message {
+	mi := &file_api_chat_proto_msgTypes[29]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use KickMemberIn.ProtoReflect.Descriptor instead.
+func (*KickMemberIn) Descriptor() ([]byte, []int) {
+	return file_api_chat_proto_rawDescGZIP(), []int{29}
+}
+
+func (x *KickMemberIn) GetChatUuid() string {
+	if x != nil {
+		return x.ChatUuid
+	}
+	return ""
+}
+
+func (x *KickMemberIn) GetMemberUuid() string {
+	if x != nil {
+		return x.MemberUuid
+	}
+	return ""
+}
+
+type KickMemberOut struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	KickStatus bool `protobuf:"varint,1,opt,name=kick_status,json=kickStatus,proto3" json:"kick_status,omitempty"` // статус исключения
+}
+
+func (x *KickMemberOut) Reset() {
+	*x = KickMemberOut{}
+	mi := &file_api_chat_proto_msgTypes[30]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *KickMemberOut) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*KickMemberOut) ProtoMessage() {}
+
+func (x *KickMemberOut) ProtoReflect() protoreflect.Message {
+	mi := &file_api_chat_proto_msgTypes[30]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use KickMemberOut.ProtoReflect.Descriptor instead.
+func (*KickMemberOut) Descriptor() ([]byte, []int) {
+	return file_api_chat_proto_rawDescGZIP(), []int{30}
+}
+
+func (x *KickMemberOut) GetKickStatus() bool {
+	if x != nil {
+		return x.KickStatus
+	}
+	return false
+}
+
+type GetOrCreateCommentStreamIn struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	EntityType string `protobuf:"bytes,1,opt,name=entity_type,json=entityType,proto3" json:"entity_type,omitempty"` // тип сущности платформы, например post или project
+	EntityId   string `protobuf:"bytes,2,opt,name=entity_id,json=entityId,proto3" json:"entity_id,omitempty"`       // идентификатор сущности в сервисе-владельце
+}
+
+func (x *GetOrCreateCommentStreamIn) Reset() {
+	*x = GetOrCreateCommentStreamIn{}
+	mi := &file_api_chat_proto_msgTypes[31]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetOrCreateCommentStreamIn) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetOrCreateCommentStreamIn) ProtoMessage() {}
+
+func (x *GetOrCreateCommentStreamIn) ProtoReflect() protoreflect.Message {
+	mi := &file_api_chat_proto_msgTypes[31]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetOrCreateCommentStreamIn.ProtoReflect.Descriptor instead.
+func (*GetOrCreateCommentStreamIn) Descriptor() ([]byte, []int) {
+	return file_api_chat_proto_rawDescGZIP(), []int{31}
+}
+
+func (x *GetOrCreateCommentStreamIn) GetEntityType() string {
+	if x != nil {
+		return x.EntityType
+	}
+	return ""
+}
+
+func (x *GetOrCreateCommentStreamIn) GetEntityId() string {
+	if x != nil {
+		return x.EntityId
+	}
+	return ""
+}
+
+type GetOrCreateCommentStreamOut struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ChatUuid string `protobuf:"bytes,1,opt,name=chat_uuid,json=chatUuid,proto3" json:"chat_uuid,omitempty"` // uuid ленты комментариев
+	Created  bool   `protobuf:"varint,2,opt,name=created,proto3" json:"created,omitempty"`                  // лента создана этим запросом
+}
+
+func (x *GetOrCreateCommentStreamOut) Reset() {
+	*x = GetOrCreateCommentStreamOut{}
+	mi := &file_api_chat_proto_msgTypes[32]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetOrCreateCommentStreamOut) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetOrCreateCommentStreamOut) ProtoMessage() {}
+
+func (x *GetOrCreateCommentStreamOut) ProtoReflect() protoreflect.Message {
+	mi := &file_api_chat_proto_msgTypes[32]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetOrCreateCommentStreamOut.ProtoReflect.Descriptor instead.
+func (*GetOrCreateCommentStreamOut) Descriptor() ([]byte, []int) {
+	return file_api_chat_proto_rawDescGZIP(), []int{32}
+}
+
+func (x *GetOrCreateCommentStreamOut) GetChatUuid() string {
+	if x != nil {
+		return x.ChatUuid
+	}
+	return ""
+}
+
+func (x *GetOrCreateCommentStreamOut) GetCreated() bool {
+	if x != nil {
+		return x.Created
+	}
+	return false
+}
+
+type GetCommentsIn struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ChatUuid string `protobuf:"bytes,1,opt,name=chat_uuid,json=chatUuid,proto3" json:"chat_uuid,omitempty"` // uuid ленты комментариев
+	Limit    int32  `protobuf:"varint,2,opt,name=limit,proto3" json:"limit,omitempty"`                      // размер страницы, по умолчанию 15, максимум 100
+	Cursor   string `protobuf:"bytes,3,opt,name=cursor,proto3" json:"cursor,omitempty"`                     // курсор из next_cursor предыдущего ответа
+}
+
+func (x *GetCommentsIn) Reset() {
+	*x = GetCommentsIn{}
+	mi := &file_api_chat_proto_msgTypes[33]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetCommentsIn) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetCommentsIn) ProtoMessage() {}
+
+func (x *GetCommentsIn) ProtoReflect() protoreflect.Message {
+	mi := &file_api_chat_proto_msgTypes[33]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetCommentsIn.ProtoReflect.Descriptor instead.
+func (*GetCommentsIn) Descriptor() ([]byte, []int) {
+	return file_api_chat_proto_rawDescGZIP(), []int{33}
+}
+
+func (x *GetCommentsIn) GetChatUuid() string {
+	if x != nil {
+		return x.ChatUuid
+	}
+	return ""
+}
+
+func (x *GetCommentsIn) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+func (x *GetCommentsIn) GetCursor() string {
+	if x != nil {
+		return x.Cursor
+	}
+	return ""
+}
+
+type GetCommentsOut struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Comments   []*Message `protobuf:"bytes,1,rep,name=comments,proto3" json:"comments,omitempty"`                       // комментарии от новых к старым
+	NextCursor string     `protobuf:"bytes,2,opt,name=next_cursor,json=nextCursor,proto3" json:"next_cursor,omitempty"` // курсор следующей страницы, пустой если комментариев больше нет
+}
+
+func (x *GetCommentsOut) Reset() {
+	*x = GetCommentsOut{}
+	mi := &file_api_chat_proto_msgTypes[34]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetCommentsOut) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetCommentsOut) ProtoMessage() {}
+
+func (x *GetCommentsOut) ProtoReflect() protoreflect.Message {
+	mi := &file_api_chat_proto_msgTypes[34]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetCommentsOut.ProtoReflect.Descriptor instead.
+func (*GetCommentsOut) Descriptor() ([]byte, []int) {
+	return file_api_chat_proto_rawDescGZIP(), []int{34}
+}
+
+func (x *GetCommentsOut) GetComments() []*Message {
+	if x != nil {
+		return x.Comments
+	}
+	return nil
+}
+
+func (x *GetCommentsOut) GetNextCursor() string {
+	if x != nil {
+		return x.NextCursor
+	}
+	return ""
+}
+
+type PostCommentIn struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ChatUuid   string `protobuf:"bytes,1,opt,name=chat_uuid,json=chatUuid,proto3" json:"chat_uuid,omitempty"`       // uuid ленты комментариев
+	Content    string `protobuf:"bytes,2,opt,name=content,proto3" json:"content,omitempty"`                         // текст комментария
+	RootUuid   string `protobuf:"bytes,3,opt,name=root_uuid,json=rootUuid,proto3" json:"root_uuid,omitempty"`       // uuid корневого комментария (необязательно)
+	ParentUuid string `protobuf:"bytes,4,opt,name=parent_uuid,json=parentUuid,proto3" json:"parent_uuid,omitempty"` // uuid комментария, на который идет прямой ответ (необязательно)
+}
+
+func (x *PostCommentIn) Reset() {
+	*x = PostCommentIn{}
+	mi := &file_api_chat_proto_msgTypes[35]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PostCommentIn) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PostCommentIn) ProtoMessage() {}
+
+func (x *PostCommentIn) ProtoReflect() protoreflect.Message {
+	mi := &file_api_chat_proto_msgTypes[35]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PostCommentIn.ProtoReflect.Descriptor instead.
+func (*PostCommentIn) Descriptor() ([]byte, []int) {
+	return file_api_chat_proto_rawDescGZIP(), []int{35}
+}
+
+func (x *PostCommentIn) GetChatUuid() string {
+	if x != nil {
+		return x.ChatUuid
+	}
+	return ""
+}
+
+func (x *PostCommentIn) GetContent() string {
+	if x != nil {
+		return x.Content
+	}
+	return ""
+}
+
+func (x *PostCommentIn) GetRootUuid() string {
+	if x != nil {
+		return x.RootUuid
+	}
+	return ""
+}
+
+func (x *PostCommentIn) GetParentUuid() string {
+	if x != nil {
+		return x.ParentUuid
+	}
+	return ""
+}
+
+type PostCommentOut struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Comment *Message `protobuf:"bytes,1,opt,name=comment,proto3" json:"comment,omitempty"` // сохраненный комментарий
+}
+
+func (x *PostCommentOut) Reset() {
+	*x = PostCommentOut{}
+	mi := &file_api_chat_proto_msgTypes[36]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PostCommentOut) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PostCommentOut) ProtoMessage() {}
+
+func (x *PostCommentOut) ProtoReflect() protoreflect.Message {
+	mi := &file_api_chat_proto_msgTypes[36]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PostCommentOut.ProtoReflect.Descriptor instead.
+func (*PostCommentOut) Descriptor() ([]byte, []int) {
+	return file_api_chat_proto_rawDescGZIP(), []int{36}
+}
+
+func (x *PostCommentOut) GetComment() *Message {
+	if x != nil {
+		return x.Comment
+	}
+	return nil
+}
+
+type MarkMessagesReadIn struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ChatUuid        string `protobuf:"bytes,1,opt,name=chat_uuid,json=chatUuid,proto3" json:"chat_uuid,omitempty"`                          // uuid чата
+	UpToMessageUuid string `protobuf:"bytes,2,opt,name=up_to_message_uuid,json=upToMessageUuid,proto3" json:"up_to_message_uuid,omitempty"` // uuid последнего прочитанного сообщения, все более ранние тоже считаются прочитанными
+}
+
+func (x *MarkMessagesReadIn) Reset() {
+	*x = MarkMessagesReadIn{}
+	mi := &file_api_chat_proto_msgTypes[37]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MarkMessagesReadIn) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MarkMessagesReadIn) ProtoMessage() {}
+
+func (x *MarkMessagesReadIn) ProtoReflect() protoreflect.Message {
+	mi := &file_api_chat_proto_msgTypes[37]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MarkMessagesReadIn.ProtoReflect.Descriptor instead.
+func (*MarkMessagesReadIn) Descriptor() ([]byte, []int) {
+	return file_api_chat_proto_rawDescGZIP(), []int{37}
+}
+
+func (x *MarkMessagesReadIn) GetChatUuid() string {
+	if x != nil {
+		return x.ChatUuid
+	}
+	return ""
+}
+
+func (x *MarkMessagesReadIn) GetUpToMessageUuid() string {
+	if x != nil {
+		return x.UpToMessageUuid
+	}
+	return ""
+}
+
+type MarkMessagesReadOut struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	MarkedCount int64 `protobuf:"varint,1,opt,name=marked_count,json=markedCount,proto3" json:"marked_count,omitempty"` // количество сообщений, впервые отмеченных прочитанными
+}
+
+func (x *MarkMessagesReadOut) Reset() {
+	*x = MarkMessagesReadOut{}
+	mi := &file_api_chat_proto_msgTypes[38]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MarkMessagesReadOut) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MarkMessagesReadOut) ProtoMessage() {}
+
+func (x *MarkMessagesReadOut) ProtoReflect() protoreflect.Message {
+	mi := &file_api_chat_proto_msgTypes[38]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MarkMessagesReadOut.ProtoReflect.Descriptor instead.
+func (*MarkMessagesReadOut) Descriptor() ([]byte, []int) {
+	return file_api_chat_proto_rawDescGZIP(), []int{38}
+}
+
+func (x *MarkMessagesReadOut) GetMarkedCount() int64 {
+	if x != nil {
+		return x.MarkedCount
+	}
+	return 0
+}
+
+type GetMessageReadersIn struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ChatUuid    string `protobuf:"bytes,1,opt,name=chat_uuid,json=chatUuid,proto3" json:"chat_uuid,omitempty"`          // uuid чата
+	MessageUuid string `protobuf:"bytes,2,opt,name=message_uuid,json=messageUuid,proto3" json:"message_uuid,omitempty"` // uuid сообщения
+}
+
+func (x *GetMessageReadersIn) Reset() {
+	*x = GetMessageReadersIn{}
+	mi := &file_api_chat_proto_msgTypes[39]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetMessageReadersIn) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetMessageReadersIn) ProtoMessage() {}
+
+func (x *GetMessageReadersIn) ProtoReflect() protoreflect.Message {
+	mi := &file_api_chat_proto_msgTypes[39]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetMessageReadersIn.ProtoReflect.Descriptor instead.
+func (*GetMessageReadersIn) Descriptor() ([]byte, []int) {
+	return file_api_chat_proto_rawDescGZIP(), []int{39}
+}
+
+func (x *GetMessageReadersIn) GetChatUuid() string {
+	if x != nil {
+		return x.ChatUuid
+	}
+	return ""
+}
+
+func (x *GetMessageReadersIn) GetMessageUuid() string {
+	if x != nil {
+		return x.MessageUuid
+	}
+	return ""
+}
+
+type MessageReader struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	UserUuid  string `protobuf:"bytes,1,opt,name=user_uuid,json=userUuid,proto3" json:"user_uuid,omitempty"`    // uuid прочитавшего пользователя
+	Nickname  string `protobuf:"bytes,2,opt,name=nickname,proto3" json:"nickname,omitempty"`                    // никнейм пользователя
+	AvatarUrl string `protobuf:"bytes,3,opt,name=avatar_url,json=avatarUrl,proto3" json:"avatar_url,omitempty"` // аватарка пользователя
+	ReadAt    string `protobuf:"bytes,4,opt,name=read_at,json=readAt,proto3" json:"read_at,omitempty"`          // время прочтения
+}
+
+func (x *MessageReader) Reset() {
+	*x = MessageReader{}
+	mi := &file_api_chat_proto_msgTypes[40]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MessageReader) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MessageReader) ProtoMessage() {}
+
+func (x *MessageReader) ProtoReflect() protoreflect.Message {
+	mi := &file_api_chat_proto_msgTypes[40]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MessageReader.ProtoReflect.Descriptor instead.
+func (*MessageReader) Descriptor() ([]byte, []int) {
+	return file_api_chat_proto_rawDescGZIP(), []int{40}
+}
+
+func (x *MessageReader) GetUserUuid() string {
+	if x != nil {
+		return x.UserUuid
+	}
+	return ""
+}
+
+func (x *MessageReader) GetNickname() string {
+	if x != nil {
+		return x.Nickname
+	}
+	return ""
+}
+
+func (x *MessageReader) GetAvatarUrl() string {
+	if x != nil {
+		return x.AvatarUrl
+	}
+	return ""
+}
+
+func (x *MessageReader) GetReadAt() string {
+	if x != nil {
+		return x.ReadAt
+	}
+	return ""
+}
+
+type GetMessageReadersOut struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Readers []*MessageReader `protobuf:"bytes,1,rep,name=readers,proto3" json:"readers,omitempty"` // пользователи, прочитавшие сообщение
+}
+
+func (x *GetMessageReadersOut) Reset() {
+	*x = GetMessageReadersOut{}
+	mi := &file_api_chat_proto_msgTypes[41]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetMessageReadersOut) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetMessageReadersOut) ProtoMessage() {}
+
+func (x *GetMessageReadersOut) ProtoReflect() protoreflect.Message {
+	mi := &file_api_chat_proto_msgTypes[41]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetMessageReadersOut.ProtoReflect.Descriptor instead.
+func (*GetMessageReadersOut) Descriptor() ([]byte, []int) {
+	return file_api_chat_proto_rawDescGZIP(), []int{41}
+}
+
+func (x *GetMessageReadersOut) GetReaders() []*MessageReader {
+	if x != nil {
+		return x.Readers
+	}
+	return nil
+}
+
+type AddReactionIn struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ChatUuid    string `protobuf:"bytes,1,opt,name=chat_uuid,json=chatUuid,proto3" json:"chat_uuid,omitempty"`          // uuid чата
+	MessageUuid string `protobuf:"bytes,2,opt,name=message_uuid,json=messageUuid,proto3" json:"message_uuid,omitempty"` // uuid сообщения
+	Emoji       string `protobuf:"bytes,3,opt,name=emoji,proto3" json:"emoji,omitempty"`                                // эмодзи реакции
+}
+
+func (x *AddReactionIn) Reset() {
+	*x = AddReactionIn{}
+	mi := &file_api_chat_proto_msgTypes[42]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AddReactionIn) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AddReactionIn) ProtoMessage() {}
+
+func (x *AddReactionIn) ProtoReflect() protoreflect.Message {
+	mi := &file_api_chat_proto_msgTypes[42]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AddReactionIn.ProtoReflect.Descriptor instead.
+func (*AddReactionIn) Descriptor() ([]byte, []int) {
+	return file_api_chat_proto_rawDescGZIP(), []int{42}
+}
+
+func (x *AddReactionIn) GetChatUuid() string {
+	if x != nil {
+		return x.ChatUuid
+	}
+	return ""
+}
+
+func (x *AddReactionIn) GetMessageUuid() string {
+	if x != nil {
+		return x.MessageUuid
+	}
+	return ""
+}
+
+func (x *AddReactionIn) GetEmoji() string {
+	if x != nil {
+		return x.Emoji
+	}
+	return ""
+}
+
+type AddReactionOut struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Reactions []*Reaction `protobuf:"bytes,1,rep,name=reactions,proto3" json:"reactions,omitempty"` // реакции на сообщение после изменения
+}
+
+func (x *AddReactionOut) Reset() {
+	*x = AddReactionOut{}
+	mi := &file_api_chat_proto_msgTypes[43]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AddReactionOut) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AddReactionOut) ProtoMessage() {}
+
+func (x *AddReactionOut) ProtoReflect() protoreflect.Message {
+	mi := &file_api_chat_proto_msgTypes[43]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AddReactionOut.ProtoReflect.Descriptor instead.
+func (*AddReactionOut) Descriptor() ([]byte, []int) {
+	return file_api_chat_proto_rawDescGZIP(), []int{43}
+}
+
+func (x *AddReactionOut) GetReactions() []*Reaction {
+	if x != nil {
+		return x.Reactions
+	}
+	return nil
+}
+
+type RemoveReactionIn struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ChatUuid    string `protobuf:"bytes,1,opt,name=chat_uuid,json=chatUuid,proto3" json:"chat_uuid,omitempty"`          // uuid чата
+	MessageUuid string `protobuf:"bytes,2,opt,name=message_uuid,json=messageUuid,proto3" json:"message_uuid,omitempty"` // uuid сообщения
+	Emoji       string `protobuf:"bytes,3,opt,name=emoji,proto3" json:"emoji,omitempty"`                                // эмодзи реакции
+}
+
+func (x *RemoveReactionIn) Reset() {
+	*x = RemoveReactionIn{}
+	mi := &file_api_chat_proto_msgTypes[44]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RemoveReactionIn) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RemoveReactionIn) ProtoMessage() {}
+
+func (x *RemoveReactionIn) ProtoReflect() protoreflect.Message {
+	mi := &file_api_chat_proto_msgTypes[44]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RemoveReactionIn.ProtoReflect.Descriptor instead.
+func (*RemoveReactionIn) Descriptor() ([]byte, []int) {
+	return file_api_chat_proto_rawDescGZIP(), []int{44}
+}
+
+func (x *RemoveReactionIn) GetChatUuid() string {
+	if x != nil {
+		return x.ChatUuid
+	}
+	return ""
+}
+
+func (x *RemoveReactionIn) GetMessageUuid() string {
+	if x != nil {
+		return x.MessageUuid
+	}
+	return ""
+}
+
+func (x *RemoveReactionIn) GetEmoji() string {
+	if x != nil {
+		return x.Emoji
+	}
+	return ""
+}
+
+type RemoveReactionOut struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Reactions []*Reaction `protobuf:"bytes,1,rep,name=reactions,proto3" json:"reactions,omitempty"` // реакции на сообщение после изменения
+}
+
+func (x *RemoveReactionOut) Reset() {
+	*x = RemoveReactionOut{}
+	mi := &file_api_chat_proto_msgTypes[45]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RemoveReactionOut) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RemoveReactionOut) ProtoMessage() {}
+
+func (x *RemoveReactionOut) ProtoReflect() protoreflect.Message {
+	mi := &file_api_chat_proto_msgTypes[45]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RemoveReactionOut.ProtoReflect.Descriptor instead.
+func (*RemoveReactionOut) Descriptor() ([]byte, []int) {
+	return file_api_chat_proto_rawDescGZIP(), []int{45}
+}
+
+func (x *RemoveReactionOut) GetReactions() []*Reaction {
+	if x != nil {
+		return x.Reactions
+	}
+	return nil
+}
+
+type PinMessageIn struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ChatUuid    string `protobuf:"bytes,1,opt,name=chat_uuid,json=chatUuid,proto3" json:"chat_uuid,omitempty"`          // uuid чата
+	MessageUuid string `protobuf:"bytes,2,opt,name=message_uuid,json=messageUuid,proto3" json:"message_uuid,omitempty"` // uuid закрепляемого сообщения
+}
+
+func (x *PinMessageIn) Reset() {
+	*x = PinMessageIn{}
+	mi := &file_api_chat_proto_msgTypes[46]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PinMessageIn) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PinMessageIn) ProtoMessage() {}
+
+func (x *PinMessageIn) ProtoReflect() protoreflect.Message {
+	mi := &file_api_chat_proto_msgTypes[46]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PinMessageIn.ProtoReflect.Descriptor instead.
+func (*PinMessageIn) Descriptor() ([]byte, []int) {
+	return file_api_chat_proto_rawDescGZIP(), []int{46}
+}
+
+func (x *PinMessageIn) GetChatUuid() string {
+	if x != nil {
+		return x.ChatUuid
+	}
+	return ""
+}
+
+func (x *PinMessageIn) GetMessageUuid() string {
+	if x != nil {
+		return x.MessageUuid
+	}
+	return ""
+}
+
+type PinMessageOut struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	PinStatus bool `protobuf:"varint,1,opt,name=pin_status,json=pinStatus,proto3" json:"pin_status,omitempty"` // true, если сообщение закреплено этим запросом
+}
+
+func (x *PinMessageOut) Reset() {
+	*x = PinMessageOut{}
+	mi := &file_api_chat_proto_msgTypes[47]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PinMessageOut) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PinMessageOut) ProtoMessage() {}
+
+func (x *PinMessageOut) ProtoReflect() protoreflect.Message {
+	mi := &file_api_chat_proto_msgTypes[47]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PinMessageOut.ProtoReflect.Descriptor instead.
+func (*PinMessageOut) Descriptor() ([]byte, []int) {
+	return file_api_chat_proto_rawDescGZIP(), []int{47}
+}
+
+func (x *PinMessageOut) GetPinStatus() bool {
+	if x != nil {
+		return x.PinStatus
+	}
+	return false
+}
+
+type UnpinMessageIn struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ChatUuid    string `protobuf:"bytes,1,opt,name=chat_uuid,json=chatUuid,proto3" json:"chat_uuid,omitempty"`          // uuid чата
+	MessageUuid string `protobuf:"bytes,2,opt,name=message_uuid,json=messageUuid,proto3" json:"message_uuid,omitempty"` // uuid открепляемого сообщения
+}
+
+func (x *UnpinMessageIn) Reset() {
+	*x = UnpinMessageIn{}
+	mi := &file_api_chat_proto_msgTypes[48]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UnpinMessageIn) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UnpinMessageIn) ProtoMessage() {}
+
+func (x *UnpinMessageIn) ProtoReflect() protoreflect.Message {
+	mi := &file_api_chat_proto_msgTypes[48]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UnpinMessageIn.ProtoReflect.Descriptor instead.
+func (*UnpinMessageIn) Descriptor() ([]byte, []int) {
+	return file_api_chat_proto_rawDescGZIP(), []int{48}
+}
+
+func (x *UnpinMessageIn) GetChatUuid() string {
+	if x != nil {
+		return x.ChatUuid
+	}
+	return ""
+}
+
+func (x *UnpinMessageIn) GetMessageUuid() string {
+	if x != nil {
+		return x.MessageUuid
+	}
+	return ""
+}
+
+type UnpinMessageOut struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	UnpinStatus bool `protobuf:"varint,1,opt,name=unpin_status,json=unpinStatus,proto3" json:"unpin_status,omitempty"` // true, если сообщение было закреплено и откреплено этим запросом
+}
+
+func (x *UnpinMessageOut) Reset() {
+	*x = UnpinMessageOut{}
+	mi := &file_api_chat_proto_msgTypes[49]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UnpinMessageOut) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UnpinMessageOut) ProtoMessage() {}
+
+func (x *UnpinMessageOut) ProtoReflect() protoreflect.Message {
+	mi := &file_api_chat_proto_msgTypes[49]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UnpinMessageOut.ProtoReflect.Descriptor instead.
+func (*UnpinMessageOut) Descriptor() ([]byte, []int) {
+	return file_api_chat_proto_rawDescGZIP(), []int{49}
+}
+
+func (x *UnpinMessageOut) GetUnpinStatus() bool {
+	if x != nil {
+		return x.UnpinStatus
+	}
+	return false
+}
+
+type ListPinnedMessagesIn struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ChatUuid string `protobuf:"bytes,1,opt,name=chat_uuid,json=chatUuid,proto3" json:"chat_uuid,omitempty"` // uuid чата
+}
+
+func (x *ListPinnedMessagesIn) Reset() {
+	*x = ListPinnedMessagesIn{}
+	mi := &file_api_chat_proto_msgTypes[50]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListPinnedMessagesIn) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListPinnedMessagesIn) ProtoMessage() {}
+
+func (x *ListPinnedMessagesIn) ProtoReflect() protoreflect.Message {
+	mi := &file_api_chat_proto_msgTypes[50]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListPinnedMessagesIn.ProtoReflect.Descriptor instead.
+func (*ListPinnedMessagesIn) Descriptor() ([]byte, []int) {
+	return file_api_chat_proto_rawDescGZIP(), []int{50}
+}
+
+func (x *ListPinnedMessagesIn) GetChatUuid() string {
+	if x != nil {
+		return x.ChatUuid
+	}
+	return ""
+}
+
+type PinnedMessage struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Message  *Message `protobuf:"bytes,1,opt,name=message,proto3" json:"message,omitempty"`                   // закрепленное сообщение
+	PinnedBy string   `protobuf:"bytes,2,opt,name=pinned_by,json=pinnedBy,proto3" json:"pinned_by,omitempty"` // uuid пользователя, закрепившего сообщение
+	PinnedAt string   `protobuf:"bytes,3,opt,name=pinned_at,json=pinnedAt,proto3" json:"pinned_at,omitempty"` // время закрепления
+}
+
+func (x *PinnedMessage) Reset() {
+	*x = PinnedMessage{}
+	mi := &file_api_chat_proto_msgTypes[51]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PinnedMessage) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PinnedMessage) ProtoMessage() {}
+
+func (x *PinnedMessage) ProtoReflect() protoreflect.Message {
+	mi := &file_api_chat_proto_msgTypes[51]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PinnedMessage.ProtoReflect.Descriptor instead.
+func (*PinnedMessage) Descriptor() ([]byte, []int) {
+	return file_api_chat_proto_rawDescGZIP(), []int{51}
+}
+
+func (x *PinnedMessage) GetMessage() *Message {
+	if x != nil {
+		return x.Message
+	}
+	return nil
+}
+
+func (x *PinnedMessage) GetPinnedBy() string {
+	if x != nil {
+		return x.PinnedBy
+	}
+	return ""
+}
+
+func (x *PinnedMessage) GetPinnedAt() string {
+	if x != nil {
+		return x.PinnedAt
+	}
+	return ""
+}
+
+type ListPinnedMessagesOut struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	PinnedMessages []*PinnedMessage `protobuf:"bytes,1,rep,name=pinned_messages,json=pinnedMessages,proto3" json:"pinned_messages,omitempty"` // закрепленные сообщения, от новых к старым
+}
+
+func (x *ListPinnedMessagesOut) Reset() {
+	*x = ListPinnedMessagesOut{}
+	mi := &file_api_chat_proto_msgTypes[52]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListPinnedMessagesOut) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListPinnedMessagesOut) ProtoMessage() {}
+
+func (x *ListPinnedMessagesOut) ProtoReflect() protoreflect.Message {
+	mi := &file_api_chat_proto_msgTypes[52]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListPinnedMessagesOut.ProtoReflect.Descriptor instead.
+func (*ListPinnedMessagesOut) Descriptor() ([]byte, []int) {
+	return file_api_chat_proto_rawDescGZIP(), []int{52}
+}
+
+func (x *ListPinnedMessagesOut) GetPinnedMessages() []*PinnedMessage {
+	if x != nil {
+		return x.PinnedMessages
+	}
+	return nil
+}
+
+type ForwardMessagesIn struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	SourceChatUuid string   `protobuf:"bytes,1,opt,name=source_chat_uuid,json=sourceChatUuid,proto3" json:"source_chat_uuid,omitempty"` // uuid чата, из которого пересылаются сообщения
+	MessageUuids   []string `protobuf:"bytes,2,rep,name=message_uuids,json=messageUuids,proto3" json:"message_uuids,omitempty"`         // uuid пересылаемых сообщений
+	TargetChatUuid string   `protobuf:"bytes,3,opt,name=target_chat_uuid,json=targetChatUuid,proto3" json:"target_chat_uuid,omitempty"` // uuid чата, в который пересылаются сообщения
+}
+
+func (x *ForwardMessagesIn) Reset() {
+	*x = ForwardMessagesIn{}
+	mi := &file_api_chat_proto_msgTypes[53]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ForwardMessagesIn) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ForwardMessagesIn) ProtoMessage() {}
+
+func (x *ForwardMessagesIn) ProtoReflect() protoreflect.Message {
+	mi := &file_api_chat_proto_msgTypes[53]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ForwardMessagesIn.ProtoReflect.Descriptor instead.
+func (*ForwardMessagesIn) Descriptor() ([]byte, []int) {
+	return file_api_chat_proto_rawDescGZIP(), []int{53}
+}
+
+func (x *ForwardMessagesIn) GetSourceChatUuid() string {
+	if x != nil {
+		return x.SourceChatUuid
+	}
+	return ""
+}
+
+func (x *ForwardMessagesIn) GetMessageUuids() []string {
+	if x != nil {
+		return x.MessageUuids
+	}
+	return nil
+}
+
+func (x *ForwardMessagesIn) GetTargetChatUuid() string {
+	if x != nil {
+		return x.TargetChatUuid
+	}
+	return ""
+}
+
+type ForwardMessagesOut struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Messages []*Message `protobuf:"bytes,1,rep,name=messages,proto3" json:"messages,omitempty"` // новые сообщения в целевом чате, в исходном порядке
+}
+
+func (x *ForwardMessagesOut) Reset() {
+	*x = ForwardMessagesOut{}
+	mi := &file_api_chat_proto_msgTypes[54]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ForwardMessagesOut) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ForwardMessagesOut) ProtoMessage() {}
+
+func (x *ForwardMessagesOut) ProtoReflect() protoreflect.Message {
+	mi := &file_api_chat_proto_msgTypes[54]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ForwardMessagesOut.ProtoReflect.Descriptor instead.
+func (*ForwardMessagesOut) Descriptor() ([]byte, []int) {
+	return file_api_chat_proto_rawDescGZIP(), []int{54}
+}
+
+func (x *ForwardMessagesOut) GetMessages() []*Message {
+	if x != nil {
+		return x.Messages
+	}
+	return nil
+}
+
+type SearchMessagesIn struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Query    string `protobuf:"bytes,1,opt,name=query,proto3" json:"query,omitempty"`                       // поисковый запрос
+	ChatUuid string `protobuf:"bytes,2,opt,name=chat_uuid,json=chatUuid,proto3" json:"chat_uuid,omitempty"` // uuid чата для поиска внутри одного чата (необязательно)
+	Limit    int32  `protobuf:"varint,3,opt,name=limit,proto3" json:"limit,omitempty"`                      // размер страницы, по умолчанию 15, максимум 100
+	Cursor   string `protobuf:"bytes,4,opt,name=cursor,proto3" json:"cursor,omitempty"`                     // курсор из next_cursor предыдущей страницы
+}
+
+func (x *SearchMessagesIn) Reset() {
+	*x = SearchMessagesIn{}
+	mi := &file_api_chat_proto_msgTypes[55]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SearchMessagesIn) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SearchMessagesIn) ProtoMessage() {}
+
+func (x *SearchMessagesIn) ProtoReflect() protoreflect.Message {
+	mi := &file_api_chat_proto_msgTypes[55]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SearchMessagesIn.ProtoReflect.Descriptor instead.
+func (*SearchMessagesIn) Descriptor() ([]byte, []int) {
+	return file_api_chat_proto_rawDescGZIP(), []int{55}
+}
+
+func (x *SearchMessagesIn) GetQuery() string {
+	if x != nil {
+		return x.Query
+	}
+	return ""
+}
+
+func (x *SearchMessagesIn) GetChatUuid() string {
+	if x != nil {
+		return x.ChatUuid
+	}
+	return ""
+}
+
+func (x *SearchMessagesIn) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+func (x *SearchMessagesIn) GetCursor() string {
+	if x != nil {
+		return x.Cursor
+	}
+	return ""
+}
+
+type SearchResult struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Message  *Message `protobuf:"bytes,1,opt,name=message,proto3" json:"message,omitempty"`                   // найденное сообщение
+	ChatUuid string   `protobuf:"bytes,2,opt,name=chat_uuid,json=chatUuid,proto3" json:"chat_uuid,omitempty"` // uuid чата, в котором найдено сообщение
+	Snippet  string   `protobuf:"bytes,3,opt,name=snippet,proto3" json:"snippet,omitempty"`                   // фрагмент текста, совпадения выделены тегами <b></b>
+}
+
+func (x *SearchResult) Reset() {
+	*x = SearchResult{}
+	mi := &file_api_chat_proto_msgTypes[56]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SearchResult) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SearchResult) ProtoMessage() {}
+
+func (x *SearchResult) ProtoReflect() protoreflect.Message {
+	mi := &file_api_chat_proto_msgTypes[56]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SearchResult.ProtoReflect.Descriptor instead.
+func (*SearchResult) Descriptor() ([]byte, []int) {
+	return file_api_chat_proto_rawDescGZIP(), []int{56}
+}
+
+func (x *SearchResult) GetMessage() *Message {
+	if x != nil {
+		return x.Message
+	}
+	return nil
+}
+
+func (x *SearchResult) GetChatUuid() string {
+	if x != nil {
+		return x.ChatUuid
+	}
+	return ""
+}
+
+func (x *SearchResult) GetSnippet() string {
+	if x != nil {
+		return x.Snippet
+	}
+	return ""
+}
+
+type SearchMessagesOut struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Results    []*SearchResult `protobuf:"bytes,1,rep,name=results,proto3" json:"results,omitempty"`                         // найденные сообщения, от новых к старым
+	NextCursor string          `protobuf:"bytes,2,opt,name=next_cursor,json=nextCursor,proto3" json:"next_cursor,omitempty"` // курсор следующей страницы, пустой если страниц больше нет
+}
+
+func (x *SearchMessagesOut) Reset() {
+	*x = SearchMessagesOut{}
+	mi := &file_api_chat_proto_msgTypes[57]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SearchMessagesOut) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SearchMessagesOut) ProtoMessage() {}
+
+func (x *SearchMessagesOut) ProtoReflect() protoreflect.Message {
+	mi := &file_api_chat_proto_msgTypes[57]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SearchMessagesOut.ProtoReflect.Descriptor instead.
+func (*SearchMessagesOut) Descriptor() ([]byte, []int) {
+	return file_api_chat_proto_rawDescGZIP(), []int{57}
+}
+
+func (x *SearchMessagesOut) GetResults() []*SearchResult {
+	if x != nil {
+		return x.Results
+	}
+	return nil
+}
+
+func (x *SearchMessagesOut) GetNextCursor() string {
+	if x != nil {
+		return x.NextCursor
+	}
+	return ""
+}
+
+type MessageEdited struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	MessageUuid string `protobuf:"bytes,1,opt,name=message_uuid,json=messageUuid,proto3" json:"message_uuid,omitempty"` // uuid измененного сообщения
+	NewContent  string `protobuf:"bytes,2,opt,name=new_content,json=newContent,proto3" json:"new_content,omitempty"`    // новый текст сообщения
+	UpdatedAt   string `protobuf:"bytes,3,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`       // время изменения
+}
+
+func (x *MessageEdited) Reset() {
+	*x = MessageEdited{}
+	mi := &file_api_chat_proto_msgTypes[58]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MessageEdited) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MessageEdited) ProtoMessage() {}
+
+func (x *MessageEdited) ProtoReflect() protoreflect.Message {
+	mi := &file_api_chat_proto_msgTypes[58]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MessageEdited.ProtoReflect.Descriptor instead.
+func (*MessageEdited) Descriptor() ([]byte, []int) {
+	return file_api_chat_proto_rawDescGZIP(), []int{58}
+}
+
+func (x *MessageEdited) GetMessageUuid() string {
+	if x != nil {
+		return x.MessageUuid
+	}
+	return ""
+}
+
+func (x *MessageEdited) GetNewContent() string {
+	if x != nil {
+		return x.NewContent
+	}
+	return ""
+}
+
+func (x *MessageEdited) GetUpdatedAt() string {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return ""
+}
+
+type MessageDeleted struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	MessageUuid string `protobuf:"bytes,1,opt,name=message_uuid,json=messageUuid,proto3" json:"message_uuid,omitempty"` // uuid удаленного сообщения
+	Mode        string `protobuf:"bytes,2,opt,name=mode,proto3" json:"mode,omitempty"`                                  // режим удаления: self или all
+}
+
+func (x *MessageDeleted) Reset() {
+	*x = MessageDeleted{}
+	mi := &file_api_chat_proto_msgTypes[59]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MessageDeleted) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MessageDeleted) ProtoMessage() {}
+
+func (x *MessageDeleted) ProtoReflect() protoreflect.Message {
+	mi := &file_api_chat_proto_msgTypes[59]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MessageDeleted.ProtoReflect.Descriptor instead.
+func (*MessageDeleted) Descriptor() ([]byte, []int) {
+	return file_api_chat_proto_rawDescGZIP(), []int{59}
+}
+
+func (x *MessageDeleted) GetMessageUuid() string {
+	if x != nil {
+		return x.MessageUuid
+	}
+	return ""
+}
+
+func (x *MessageDeleted) GetMode() string {
+	if x != nil {
+		return x.Mode
+	}
+	return ""
+}
+
+type ReadReceipt struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	UserUuid        string `protobuf:"bytes,1,opt,name=user_uuid,json=userUuid,proto3" json:"user_uuid,omitempty"`                          // uuid прочитавшего пользователя
+	UpToMessageUuid string `protobuf:"bytes,2,opt,name=up_to_message_uuid,json=upToMessageUuid,proto3" json:"up_to_message_uuid,omitempty"` // uuid последнего прочитанного сообщения
+}
+
+func (x *ReadReceipt) Reset() {
+	*x = ReadReceipt{}
+	mi := &file_api_chat_proto_msgTypes[60]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReadReceipt) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReadReceipt) ProtoMessage() {}
+
+func (x *ReadReceipt) ProtoReflect() protoreflect.Message {
+	mi := &file_api_chat_proto_msgTypes[60]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReadReceipt.ProtoReflect.Descriptor instead.
+func (*ReadReceipt) Descriptor() ([]byte, []int) {
+	return file_api_chat_proto_rawDescGZIP(), []int{60}
+}
+
+func (x *ReadReceipt) GetUserUuid() string {
+	if x != nil {
+		return x.UserUuid
+	}
+	return ""
+}
+
+func (x *ReadReceipt) GetUpToMessageUuid() string {
+	if x != nil {
+		return x.UpToMessageUuid
+	}
+	return ""
+}
+
+type MembershipChanged struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	UserUuid string `protobuf:"bytes,1,opt,name=user_uuid,json=userUuid,proto3" json:"user_uuid,omitempty"` // uuid участника, состав которого изменился
+	Action   string `protobuf:"bytes,2,opt,name=action,proto3" json:"action,omitempty"`                     // действие: added, removed, joined, left, subscribed, unsubscribed, banned, unbanned или kicked
+}
+
+func (x *MembershipChanged) Reset() {
+	*x = MembershipChanged{}
+	mi := &file_api_chat_proto_msgTypes[61]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MembershipChanged) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MembershipChanged) ProtoMessage() {}
+
+func (x *MembershipChanged) ProtoReflect() protoreflect.Message {
+	mi := &file_api_chat_proto_msgTypes[61]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MembershipChanged.ProtoReflect.Descriptor instead.
+func (*MembershipChanged) Descriptor() ([]byte, []int) {
+	return file_api_chat_proto_rawDescGZIP(), []int{61}
+}
+
+func (x *MembershipChanged) GetUserUuid() string {
+	if x != nil {
+		return x.UserUuid
+	}
+	return ""
+}
+
+func (x *MembershipChanged) GetAction() string {
+	if x != nil {
+		return x.Action
+	}
+	return ""
+}
+
+type Event struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ChatUuid   string `protobuf:"bytes,1,opt,name=chat_uuid,json=chatUuid,proto3" json:"chat_uuid,omitempty"`       // uuid чата, к которому относится событие
+	OccurredAt string `protobuf:"bytes,2,opt,name=occurred_at,json=occurredAt,proto3" json:"occurred_at,omitempty"` // время события
+	// Types that are assignable to Payload:
+	//	*Event_MessageSent
+	//	*Event_MessageEdited
+	//	*Event_MessageDeleted
+	//	*Event_ReadReceipt
+	//	*Event_MembershipChanged
+	Payload isEvent_Payload `protobuf_oneof:"payload"`
+	Notify  bool            `protobuf:"varint,8,opt,name=notify,proto3" json:"notify,omitempty"` // получателю нужно показать уведомление с учетом его настроек чата
+}
+
+func (x *Event) Reset() {
+	*x = Event{}
+	mi := &file_api_chat_proto_msgTypes[62]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Event) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Event) ProtoMessage() {}
+
+func (x *Event) ProtoReflect() protoreflect.Message {
+	mi := &file_api_chat_proto_msgTypes[62]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Event.ProtoReflect.Descriptor instead.
+func (*Event) Descriptor() ([]byte, []int) {
+	return file_api_chat_proto_rawDescGZIP(), []int{62}
+}
+
+func (x *Event) GetChatUuid() string {
+	if x != nil {
+		return x.ChatUuid
+	}
+	return ""
+}
+
+func (x *Event) GetOccurredAt() string {
+	if x != nil {
+		return x.OccurredAt
+	}
+	return ""
+}
+
+func (m *Event) GetPayload() isEvent_Payload {
+	if m != nil {
+		return m.Payload
+	}
+	return nil
+}
+
+func (x *Event) GetMessageSent() *Message {
+	if x, ok := x.GetPayload().(*Event_MessageSent); ok {
+		return x.MessageSent
+	}
+	return nil
+}
+
+func (x *Event) GetMessageEdited() *MessageEdited {
+	if x, ok := x.GetPayload().(*Event_MessageEdited); ok {
+		return x.MessageEdited
+	}
+	return nil
+}
+
+func (x *Event) GetMessageDeleted() *MessageDeleted {
+	if x, ok := x.GetPayload().(*Event_MessageDeleted); ok {
+		return x.MessageDeleted
+	}
+	return nil
+}
+
+func (x *Event) GetReadReceipt() *ReadReceipt {
+	if x, ok := x.GetPayload().(*Event_ReadReceipt); ok {
+		return x.ReadReceipt
+	}
+	return nil
+}
+
+func (x *Event) GetMembershipChanged() *MembershipChanged {
+	if x, ok := x.GetPayload().(*Event_MembershipChanged); ok {
+		return x.MembershipChanged
+	}
+	return nil
+}
+
+func (x *Event) GetNotify() bool {
+	if x != nil {
+		return x.Notify
+	}
+	return false
+}
+
+type isEvent_Payload interface {
+	isEvent_Payload()
+}
+
+type Event_MessageSent struct {
+	MessageSent *Message `protobuf:"bytes,3,opt,name=message_sent,json=messageSent,proto3,oneof"`
+}
+
+type Event_MessageEdited struct {
+	MessageEdited *MessageEdited `protobuf:"bytes,4,opt,name=message_edited,json=messageEdited,proto3,oneof"`
+}
+
+type Event_MessageDeleted struct {
+	MessageDeleted *MessageDeleted `protobuf:"bytes,5,opt,name=message_deleted,json=messageDeleted,proto3,oneof"`
+}
+
+type Event_ReadReceipt struct {
+	ReadReceipt *ReadReceipt `protobuf:"bytes,6,opt,name=read_receipt,json=readReceipt,proto3,oneof"`
+}
+
+type Event_MembershipChanged struct {
+	MembershipChanged *MembershipChanged `protobuf:"bytes,7,opt,name=membership_changed,json=membershipChanged,proto3,oneof"`
+}
+
+func (*Event_MessageSent) isEvent_Payload() {}
+
+func (*Event_MessageEdited) isEvent_Payload() {}
+
+func (*Event_MessageDeleted) isEvent_Payload() {}
+
+func (*Event_ReadReceipt) isEvent_Payload() {}
+
+func (*Event_MembershipChanged) isEvent_Payload() {}
+
+type ChatCreated struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ChatType    string   `protobuf:"bytes,1,opt,name=chat_type,json=chatType,proto3" json:"chat_type,omitempty"`          // тип чата: private, group или channel
+	CreatedBy   string   `protobuf:"bytes,2,opt,name=created_by,json=createdBy,proto3" json:"created_by,omitempty"`       // uuid создателя чата
+	MemberUuids []string `protobuf:"bytes,3,rep,name=member_uuids,json=memberUuids,proto3" json:"member_uuids,omitempty"` // uuid участников на момент создания, включая создателя
+}
+
+func (x *ChatCreated) Reset() {
+	*x = ChatCreated{}
+	mi := &file_api_chat_proto_msgTypes[63]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ChatCreated) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ChatCreated) ProtoMessage() {}
+
+func (x *ChatCreated) ProtoReflect() protoreflect.Message {
+	mi := &file_api_chat_proto_msgTypes[63]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ChatCreated.ProtoReflect.Descriptor instead.
+func (*ChatCreated) Descriptor() ([]byte, []int) {
+	return file_api_chat_proto_rawDescGZIP(), []int{63}
+}
+
+func (x *ChatCreated) GetChatType() string {
+	if x != nil {
+		return x.ChatType
+	}
+	return ""
+}
+
+func (x *ChatCreated) GetCreatedBy() string {
+	if x != nil {
+		return x.CreatedBy
+	}
+	return ""
+}
+
+func (x *ChatCreated) GetMemberUuids() []string {
+	if x != nil {
+		return x.MemberUuids
+	}
+	return nil
+}
+
+// ChatDomainEvent публикуется в kafka из outbox в формате protojson, ключ сообщения - uuid чата
+type ChatDomainEvent struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id         int64  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`                                  // возрастающий номер события: повтор при доставке имеет тот же id, порядок внутри чата совпадает с порядком id
+	ChatUuid   string `protobuf:"bytes,2,opt,name=chat_uuid,json=chatUuid,proto3" json:"chat_uuid,omitempty"`       // uuid чата, к которому относится событие
+	OccurredAt string `protobuf:"bytes,3,opt,name=occurred_at,json=occurredAt,proto3" json:"occurred_at,omitempty"` // время события
+	// Types that are assignable to Payload:
+	//	*ChatDomainEvent_MessageSent
+	//	*ChatDomainEvent_MessageEdited
+	//	*ChatDomainEvent_MessageDeleted
+	//	*ChatDomainEvent_ChatCreated
+	Payload isChatDomainEvent_Payload `protobuf_oneof:"payload"`
+}
+
+func (x *ChatDomainEvent) Reset() {
+	*x = ChatDomainEvent{}
+	mi := &file_api_chat_proto_msgTypes[64]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ChatDomainEvent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ChatDomainEvent) ProtoMessage() {}
+
+func (x *ChatDomainEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_api_chat_proto_msgTypes[64]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ChatDomainEvent.ProtoReflect.Descriptor instead.
+func (*ChatDomainEvent) Descriptor() ([]byte, []int) {
+	return file_api_chat_proto_rawDescGZIP(), []int{64}
+}
+
+func (x *ChatDomainEvent) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *ChatDomainEvent) GetChatUuid() string {
+	if x != nil {
+		return x.ChatUuid
+	}
+	return ""
+}
+
+func (x *ChatDomainEvent) GetOccurredAt() string {
+	if x != nil {
+		return x.OccurredAt
+	}
+	return ""
+}
+
+func (m *ChatDomainEvent) GetPayload() isChatDomainEvent_Payload {
+	if m != nil {
+		return m.Payload
+	}
+	return nil
+}
+
+func (x *ChatDomainEvent) GetMessageSent() *Message {
+	if x, ok := x.GetPayload().(*ChatDomainEvent_MessageSent); ok {
+		return x.MessageSent
+	}
+	return nil
+}
+
+func (x *ChatDomainEvent) GetMessageEdited() *MessageEdited {
+	if x, ok := x.GetPayload().(*ChatDomainEvent_MessageEdited); ok {
+		return x.MessageEdited
+	}
+	return nil
+}
+
+func (x *ChatDomainEvent) GetMessageDeleted() *MessageDeleted {
+	if x, ok := x.GetPayload().(*ChatDomainEvent_MessageDeleted); ok {
+		return x.MessageDeleted
+	}
+	return nil
+}
+
+func (x *ChatDomainEvent) GetChatCreated() *ChatCreated {
+	if x, ok := x.GetPayload().(*ChatDomainEvent_ChatCreated); ok {
+		return x.ChatCreated
+	}
+	return nil
+}
+
+type isChatDomainEvent_Payload interface {
+	isChatDomainEvent_Payload()
+}
+
+type ChatDomainEvent_MessageSent struct {
+	MessageSent *Message `protobuf:"bytes,4,opt,name=message_sent,json=messageSent,proto3,oneof"`
+}
+
+type ChatDomainEvent_MessageEdited struct {
+	MessageEdited *MessageEdited `protobuf:"bytes,5,opt,name=message_edited,json=messageEdited,proto3,oneof"`
+}
+
+type ChatDomainEvent_MessageDeleted struct {
+	MessageDeleted *MessageDeleted `protobuf:"bytes,6,opt,name=message_deleted,json=messageDeleted,proto3,oneof"`
+}
+
+type ChatDomainEvent_ChatCreated struct {
+	ChatCreated *ChatCreated `protobuf:"bytes,7,opt,name=chat_created,json=chatCreated,proto3,oneof"`
+}
+
+func (*ChatDomainEvent_MessageSent) isChatDomainEvent_Payload() {}
+
+func (*ChatDomainEvent_MessageEdited) isChatDomainEvent_Payload() {}
+
+func (*ChatDomainEvent_MessageDeleted) isChatDomainEvent_Payload() {}
+
+func (*ChatDomainEvent_ChatCreated) isChatDomainEvent_Payload() {}
+
+// NotificationRequested публикуется в kafka для сервиса уведомлений, ключ сообщения - uuid получателя.
+// Сообщения одного чата, пришедшие получателю за окно группировки, собираются в одно событие
+type NotificationRequested struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	RecipientUuid   string `protobuf:"bytes,1,opt,name=recipient_uuid,json=recipientUuid,proto3" json:"recipient_uuid,omitempty"`         // uuid получателя: не в сети и не выключил уведомления чата
+	ChatUuid        string `protobuf:"bytes,2,opt,name=chat_uuid,json=chatUuid,proto3" json:"chat_uuid,omitempty"`                        // uuid чата с новыми сообщениями
+	MessagesCount   int64  `protobuf:"varint,3,opt,name=messages_count,json=messagesCount,proto3" json:"messages_count,omitempty"`        // количество новых сообщений за окно группировки
+	LastMessageUuid string `protobuf:"bytes,4,opt,name=last_message_uuid,json=lastMessageUuid,proto3" json:"last_message_uuid,omitempty"` // uuid последнего из них
+	LastSenderUuid  string `protobuf:"bytes,5,opt,name=last_sender_uuid,json=lastSenderUuid,proto3" json:"last_sender_uuid,omitempty"`    // uuid автора последнего сообщения
+	FirstMessageAt  string `protobuf:"bytes,6,opt,name=first_message_at,json=firstMessageAt,proto3" json:"first_message_at,omitempty"`    // время первого сообщения в группе
+	LastMessageAt   string `protobuf:"bytes,7,opt,name=last_message_at,json=lastMessageAt,proto3" json:"last_message_at,omitempty"`       // время последнего сообщения в группе
+}
+
+func (x *NotificationRequested) Reset() {
+	*x = NotificationRequested{}
+	mi := &file_api_chat_proto_msgTypes[65]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *NotificationRequested) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*NotificationRequested) ProtoMessage() {}
+
+func (x *NotificationRequested) ProtoReflect() protoreflect.Message {
+	mi := &file_api_chat_proto_msgTypes[65]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use NotificationRequested.ProtoReflect.Descriptor instead.
+func (*NotificationRequested) Descriptor() ([]byte, []int) {
+	return file_api_chat_proto_rawDescGZIP(), []int{65}
+}
+
+func (x *NotificationRequested) GetRecipientUuid() string {
+	if x != nil {
+		return x.RecipientUuid
+	}
+	return ""
+}
+
+func (x *NotificationRequested) GetChatUuid() string {
+	if x != nil {
+		return x.ChatUuid
+	}
+	return ""
+}
+
+func (x *NotificationRequested) GetMessagesCount() int64 {
+	if x != nil {
+		return x.MessagesCount
+	}
+	return 0
+}
+
+func (x *NotificationRequested) GetLastMessageUuid() string {
+	if x != nil {
+		return x.LastMessageUuid
+	}
+	return ""
+}
+
+func (x *NotificationRequested) GetLastSenderUuid() string {
+	if x != nil {
+		return x.LastSenderUuid
+	}
+	return ""
+}
+
+func (x *NotificationRequested) GetFirstMessageAt() string {
+	if x != nil {
+		return x.FirstMessageAt
+	}
+	return ""
+}
+
+func (x *NotificationRequested) GetLastMessageAt() string {
+	if x != nil {
+		return x.LastMessageAt
+	}
+	return ""
+}
+
+type SetTypingIn struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ChatUuid string `protobuf:"bytes,1,opt,name=chat_uuid,json=chatUuid,proto3" json:"chat_uuid,omitempty"` // uuid чата
+	Typing   bool   `protobuf:"varint,2,opt,name=typing,proto3" json:"typing,omitempty"`                    // true - пользователь печатает, false - перестал
+}
+
+func (x *SetTypingIn) Reset() {
+	*x = SetTypingIn{}
+	mi := &file_api_chat_proto_msgTypes[66]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetTypingIn) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetTypingIn) ProtoMessage() {}
+
+func (x *SetTypingIn) ProtoReflect() protoreflect.Message {
+	mi := &file_api_chat_proto_msgTypes[66]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetTypingIn.ProtoReflect.Descriptor instead.
+func (*SetTypingIn) Descriptor() ([]byte, []int) {
+	return file_api_chat_proto_rawDescGZIP(), []int{66}
+}
+
+func (x *SetTypingIn) GetChatUuid() string {
+	if x != nil {
+		return x.ChatUuid
+	}
+	return ""
+}
+
+func (x *SetTypingIn) GetTyping() bool {
+	if x != nil {
+		return x.Typing
+	}
+	return false
+}
+
+type SetTypingOut struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Accepted bool `protobuf:"varint,1,opt,name=accepted,proto3" json:"accepted,omitempty"` // false, если обновление отброшено из-за слишком частых запросов
+}
+
+func (x *SetTypingOut) Reset() {
+	*x = SetTypingOut{}
+	mi := &file_api_chat_proto_msgTypes[67]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetTypingOut) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetTypingOut) ProtoMessage() {}
+
+func (x *SetTypingOut) ProtoReflect() protoreflect.Message {
+	mi := &file_api_chat_proto_msgTypes[67]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetTypingOut.ProtoReflect.Descriptor instead.
+func (*SetTypingOut) Descriptor() ([]byte, []int) {
+	return file_api_chat_proto_rawDescGZIP(), []int{67}
+}
+
+func (x *SetTypingOut) GetAccepted() bool {
+	if x != nil {
+		return x.Accepted
+	}
+	return false
+}
+
+type WatchTypingIn struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ChatUuid string `protobuf:"bytes,1,opt,name=chat_uuid,json=chatUuid,proto3" json:"chat_uuid,omitempty"` // uuid чата
+}
+
+func (x *WatchTypingIn) Reset() {
+	*x = WatchTypingIn{}
+	mi := &file_api_chat_proto_msgTypes[68]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *WatchTypingIn) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WatchTypingIn) ProtoMessage() {}
+
+func (x *WatchTypingIn) ProtoReflect() protoreflect.Message {
+	mi := &file_api_chat_proto_msgTypes[68]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WatchTypingIn.ProtoReflect.Descriptor instead.
+func (*WatchTypingIn) Descriptor() ([]byte, []int) {
+	return file_api_chat_proto_rawDescGZIP(), []int{68}
+}
+
+func (x *WatchTypingIn) GetChatUuid() string {
+	if x != nil {
+		return x.ChatUuid
+	}
+	return ""
+}
+
+type TypingUpdate struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ChatUuid string `protobuf:"bytes,1,opt,name=chat_uuid,json=chatUuid,proto3" json:"chat_uuid,omitempty"` // uuid чата
+	UserUuid string `protobuf:"bytes,2,opt,name=user_uuid,json=userUuid,proto3" json:"user_uuid,omitempty"` // uuid пользователя
+	Typing   bool   `protobuf:"varint,3,opt,name=typing,proto3" json:"typing,omitempty"`                    // пользователь печатает или перестал
+}
+
+func (x *TypingUpdate) Reset() {
+	*x = TypingUpdate{}
+	mi := &file_api_chat_proto_msgTypes[69]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TypingUpdate) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TypingUpdate) ProtoMessage() {}
+
+func (x *TypingUpdate) ProtoReflect() protoreflect.Message {
+	mi := &file_api_chat_proto_msgTypes[69]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TypingUpdate.ProtoReflect.Descriptor instead.
+func (*TypingUpdate) Descriptor() ([]byte, []int) {
+	return file_api_chat_proto_rawDescGZIP(), []int{69}
+}
+
+func (x *TypingUpdate) GetChatUuid() string {
+	if x != nil {
+		return x.ChatUuid
+	}
+	return ""
+}
+
+func (x *TypingUpdate) GetUserUuid() string {
+	if x != nil {
+		return x.UserUuid
+	}
+	return ""
+}
+
+func (x *TypingUpdate) GetTyping() bool {
+	if x != nil {
+		return x.Typing
+	}
+	return false
+}
+
+type HeartbeatOut struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	TimeoutSeconds int64 `protobuf:"varint,1,opt,name=timeout_seconds,json=timeoutSeconds,proto3" json:"timeout_seconds,omitempty"` // через сколько секунд без heartbeat пользователь станет офлайн
+}
+
+func (x *HeartbeatOut) Reset() {
+	*x = HeartbeatOut{}
+	mi := &file_api_chat_proto_msgTypes[70]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *HeartbeatOut) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*HeartbeatOut) ProtoMessage() {}
+
+func (x *HeartbeatOut) ProtoReflect() protoreflect.Message {
+	mi := &file_api_chat_proto_msgTypes[70]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use HeartbeatOut.ProtoReflect.Descriptor instead.
+func (*HeartbeatOut) Descriptor() ([]byte, []int) {
+	return file_api_chat_proto_rawDescGZIP(), []int{70}
+}
+
+func (x *HeartbeatOut) GetTimeoutSeconds() int64 {
+	if x != nil {
+		return x.TimeoutSeconds
+	}
+	return 0
+}
+
+type GetPresenceIn struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	UserUuids []string `protobuf:"bytes,1,rep,name=user_uuids,json=userUuids,proto3" json:"user_uuids,omitempty"` // uuid пользователей
+}
+
+func (x *GetPresenceIn) Reset() {
+	*x = GetPresenceIn{}
+	mi := &file_api_chat_proto_msgTypes[71]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetPresenceIn) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetPresenceIn) ProtoMessage() {}
+
+func (x *GetPresenceIn) ProtoReflect() protoreflect.Message {
+	mi := &file_api_chat_proto_msgTypes[71]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetPresenceIn.ProtoReflect.Descriptor instead.
+func (*GetPresenceIn) Descriptor() ([]byte, []int) {
+	return file_api_chat_proto_rawDescGZIP(), []int{71}
+}
+
+func (x *GetPresenceIn) GetUserUuids() []string {
+	if x != nil {
+		return x.UserUuids
+	}
+	return nil
+}
+
+type Presence struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	UserUuid   string `protobuf:"bytes,1,opt,name=user_uuid,json=userUuid,proto3" json:"user_uuid,omitempty"`       // uuid пользователя
+	Online     bool   `protobuf:"varint,2,opt,name=online,proto3" json:"online,omitempty"`                          // пользователь сейчас онлайн
+	LastOnline string `protobuf:"bytes,3,opt,name=last_online,json=lastOnline,proto3" json:"last_online,omitempty"` // время последней активности, пусто, если пользователь не заходил
+}
+
+func (x *Presence) Reset() {
+	*x = Presence{}
+	mi := &file_api_chat_proto_msgTypes[72]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Presence) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Presence) ProtoMessage() {}
+
+func (x *Presence) ProtoReflect() protoreflect.Message {
+	mi := &file_api_chat_proto_msgTypes[72]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Presence.ProtoReflect.Descriptor instead.
+func (*Presence) Descriptor() ([]byte, []int) {
+	return file_api_chat_proto_rawDescGZIP(), []int{72}
+}
+
+func (x *Presence) GetUserUuid() string {
+	if x != nil {
+		return x.UserUuid
+	}
+	return ""
+}
+
+func (x *Presence) GetOnline() bool {
+	if x != nil {
+		return x.Online
+	}
+	return false
+}
+
+func (x *Presence) GetLastOnline() string {
+	if x != nil {
+		return x.LastOnline
+	}
+	return ""
+}
+
+type GetPresenceOut struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Presences []*Presence `protobuf:"bytes,1,rep,name=presences,proto3" json:"presences,omitempty"` // статусы в порядке запроса
+}
+
+func (x *GetPresenceOut) Reset() {
+	*x = GetPresenceOut{}
+	mi := &file_api_chat_proto_msgTypes[73]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetPresenceOut) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetPresenceOut) ProtoMessage() {}
+
+func (x *GetPresenceOut) ProtoReflect() protoreflect.Message {
+	mi := &file_api_chat_proto_msgTypes[73]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetPresenceOut.ProtoReflect.Descriptor instead.
+func (*GetPresenceOut) Descriptor() ([]byte, []int) {
+	return file_api_chat_proto_rawDescGZIP(), []int{73}
+}
+
+func (x *GetPresenceOut) GetPresences() []*Presence {
+	if x != nil {
+		return x.Presences
+	}
+	return nil
+}
+
+type SetChatNotificationsIn struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ChatUuid     string `protobuf:"bytes,1,opt,name=chat_uuid,json=chatUuid,proto3" json:"chat_uuid,omitempty"`              // uuid чата
+	Muted        bool   `protobuf:"varint,2,opt,name=muted,proto3" json:"muted,omitempty"`                                   // true - выключить уведомления, false - включить
+	MutedUntil   string `protobuf:"bytes,3,opt,name=muted_until,json=mutedUntil,proto3" json:"muted_until,omitempty"`        // время включения уведомлений в RFC3339, пусто - выключить бессрочно
+	MentionsOnly bool   `protobuf:"varint,4,opt,name=mentions_only,json=mentionsOnly,proto3" json:"mentions_only,omitempty"` // уведомлять об упоминаниях, учитывается только при muted
+}
+
+func (x *SetChatNotificationsIn) Reset() {
+	*x = SetChatNotificationsIn{}
+	mi := &file_api_chat_proto_msgTypes[74]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetChatNotificationsIn) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetChatNotificationsIn) ProtoMessage() {}
+
+func (x *SetChatNotificationsIn) ProtoReflect() protoreflect.Message {
+	mi := &file_api_chat_proto_msgTypes[74]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetChatNotificationsIn.ProtoReflect.Descriptor instead.
+func (*SetChatNotificationsIn) Descriptor() ([]byte, []int) {
+	return file_api_chat_proto_rawDescGZIP(), []int{74}
+}
+
+func (x *SetChatNotificationsIn) GetChatUuid() string {
+	if x != nil {
+		return x.ChatUuid
+	}
+	return ""
+}
+
+func (x *SetChatNotificationsIn) GetMuted() bool {
+	if x != nil {
+		return x.Muted
+	}
+	return false
+}
+
+func (x *SetChatNotificationsIn) GetMutedUntil() string {
+	if x != nil {
+		return x.MutedUntil
+	}
+	return ""
+}
+
+func (x *SetChatNotificationsIn) GetMentionsOnly() bool {
+	if x != nil {
+		return x.MentionsOnly
+	}
+	return false
+}
+
+type SetChatNotificationsOut struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Muted        bool   `protobuf:"varint,1,opt,name=muted,proto3" json:"muted,omitempty"`                                   // уведомления чата выключены
+	MutedUntil   string `protobuf:"bytes,2,opt,name=muted_until,json=mutedUntil,proto3" json:"muted_until,omitempty"`        // время включения уведомлений, пусто для бессрочного
+	MentionsOnly bool   `protobuf:"varint,3,opt,name=mentions_only,json=mentionsOnly,proto3" json:"mentions_only,omitempty"` // уведомления только об упоминаниях
+}
+
+func (x *SetChatNotificationsOut) Reset() {
+	*x = SetChatNotificationsOut{}
+	mi := &file_api_chat_proto_msgTypes[75]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetChatNotificationsOut) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetChatNotificationsOut) ProtoMessage() {}
+
+func (x *SetChatNotificationsOut) ProtoReflect() protoreflect.Message {
+	mi := &file_api_chat_proto_msgTypes[75]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetChatNotificationsOut.ProtoReflect.Descriptor instead.
+func (*SetChatNotificationsOut) Descriptor() ([]byte, []int) {
+	return file_api_chat_proto_rawDescGZIP(), []int{75}
+}
+
+func (x *SetChatNotificationsOut) GetMuted() bool {
+	if x != nil {
+		return x.Muted
+	}
+	return false
+}
+
+func (x *SetChatNotificationsOut) GetMutedUntil() string {
+	if x != nil {
+		return x.MutedUntil
+	}
+	return ""
+}
+
+func (x *SetChatNotificationsOut) GetMentionsOnly() bool {
+	if x != nil {
+		return x.MentionsOnly
+	}
+	return false
+}
+
+type LeaveChatIn struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ChatUuid string `protobuf:"bytes,1,opt,name=chat_uuid,json=chatUuid,proto3" json:"chat_uuid,omitempty"` // uuid личного или группового чата
+}
+
+func (x *LeaveChatIn) Reset() {
+	*x = LeaveChatIn{}
+	mi := &file_api_chat_proto_msgTypes[76]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *LeaveChatIn) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LeaveChatIn) ProtoMessage() {}
+
+func (x *LeaveChatIn) ProtoReflect() protoreflect.Message {
+	mi := &file_api_chat_proto_msgTypes[76]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LeaveChatIn.ProtoReflect.Descriptor instead.
+func (*LeaveChatIn) Descriptor() ([]byte, []int) {
+	return file_api_chat_proto_rawDescGZIP(), []int{76}
+}
+
+func (x *LeaveChatIn) GetChatUuid() string {
+	if x != nil {
+		return x.ChatUuid
+	}
+	return ""
+}
+
+type LeaveChatOut struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	LeaveStatus bool `protobuf:"varint,1,opt,name=leave_status,json=leaveStatus,proto3" json:"leave_status,omitempty"` // статус выхода из чата
+}
+
+func (x *LeaveChatOut) Reset() {
+	*x = LeaveChatOut{}
+	mi := &file_api_chat_proto_msgTypes[77]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *LeaveChatOut) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LeaveChatOut) ProtoMessage() {}
+
+func (x *LeaveChatOut) ProtoReflect() protoreflect.Message {
+	mi := &file_api_chat_proto_msgTypes[77]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LeaveChatOut.ProtoReflect.Descriptor instead.
+func (*LeaveChatOut) Descriptor() ([]byte, []int) {
+	return file_api_chat_proto_rawDescGZIP(), []int{77}
+}
+
+func (x *LeaveChatOut) GetLeaveStatus() bool {
+	if x != nil {
+		return x.LeaveStatus
+	}
+	return false
+}
+
+type Chat struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	LastMessage          string `protobuf:"bytes,1,opt,name=last_message,json=lastMessage,proto3" json:"last_message,omitempty"`                              // Контент последнего сообщения
+	ChatName             string `protobuf:"bytes,2,opt,name=chat_name,json=chatName,proto3" json:"chat_name,omitempty"`                                       // Название чата
+	AvatarUrl            string `protobuf:"bytes,3,opt,name=avatar_url,json=avatarUrl,proto3" json:"avatar_url,omitempty"`                                    // Аватарка чата
+	LastMessageTimestamp string `protobuf:"bytes,4,opt,name=last_message_timestamp,json=lastMessageTimestamp,proto3" json:"last_message_timestamp,omitempty"` // Время отправки последнего сообщения
+	ChatUuid             string `protobuf:"bytes,5,opt,name=chat_uuid,json=chatUuid,proto3" json:"chat_uuid,omitempty"`                                       // UUID чата
+	ChatType             string `protobuf:"bytes,6,opt,name=chat_type,json=chatType,proto3" json:"chat_type,omitempty"`                                       // Тип чата: private, group или channel
+	UnreadCount          int64  `protobuf:"varint,7,opt,name=unread_count,json=unreadCount,proto3" json:"unread_count,omitempty"`                             // Количество непрочитанных сообщений
+	PinnedMessage        string `protobuf:"bytes,8,opt,name=pinned_message,json=pinnedMessage,proto3" json:"pinned_message,omitempty"`                        // Контент последнего закрепленного сообщения
+	PinnedMessageUuid    string `protobuf:"bytes,9,opt,name=pinned_message_uuid,json=pinnedMessageUuid,proto3" json:"pinned_message_uuid,omitempty"`          // UUID последнего закрепленного сообщения
+	CompanionUuid        string `protobuf:"bytes,10,opt,name=companion_uuid,json=companionUuid,proto3" json:"companion_uuid,omitempty"`                       // UUID собеседника, только для private
+	CompanionOnline      bool   `protobuf:"varint,11,opt,name=companion_online,json=companionOnline,proto3" json:"companion_online,omitempty"`                // Собеседник сейчас онлайн, только для private
+	CompanionLastOnline  string `protobuf:"bytes,12,opt,name=companion_last_online,json=companionLastOnline,proto3" json:"companion_last_online,omitempty"`   // Время последней активности собеседника, только для private
+	Muted                bool   `protobuf:"varint,13,opt,name=muted,proto3" json:"muted,omitempty"`                                                           // Уведомления чата выключены
+	MutedUntil           string `protobuf:"bytes,14,opt,name=muted_until,json=mutedUntil,proto3" json:"muted_until,omitempty"`                                // Время автоматического включения уведомлений, пусто для бессрочного
+	MentionsOnly         bool   `protobuf:"varint,15,opt,name=mentions_only,json=mentionsOnly,proto3" json:"mentions_only,omitempty"`                         // При выключенных уведомлениях уведомлять об упоминаниях
+}
+
+func (x *Chat) Reset() {
+	*x = Chat{}
+	mi := &file_api_chat_proto_msgTypes[78]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Chat) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Chat) ProtoMessage() {}
+
+func (x *Chat) ProtoReflect() protoreflect.Message {
+	mi := &file_api_chat_proto_msgTypes[78]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Chat.ProtoReflect.Descriptor instead.
+func (*Chat) Descriptor() ([]byte, []int) {
+	return file_api_chat_proto_rawDescGZIP(), []int{78}
+}
+
+func (x *Chat) GetLastMessage() string {
+	if x != nil {
+		return x.LastMessage
+	}
+	return ""
+}
+
+func (x *Chat) GetChatName() string {
+	if x != nil {
+		return x.ChatName
+	}
+	return ""
+}
+
+func (x *Chat) GetAvatarUrl() string {
+	if x != nil {
+		return x.AvatarUrl
+	}
+	return ""
+}
+
+func (x *Chat) GetLastMessageTimestamp() string {
+	if x != nil {
+		return x.LastMessageTimestamp
+	}
+	return ""
+}
+
+func (x *Chat) GetChatUuid() string {
+	if x != nil {
+		return x.ChatUuid
+	}
+	return ""
+}
+
+func (x *Chat) GetChatType() string {
+	if x != nil {
+		return x.ChatType
+	}
+	return ""
+}
+
+func (x *Chat) GetUnreadCount() int64 {
+	if x != nil {
+		return x.UnreadCount
+	}
+	return 0
+}
+
+func (x *Chat) GetPinnedMessage() string {
+	if x != nil {
+		return x.PinnedMessage
+	}
+	return ""
+}
+
+func (x *Chat) GetPinnedMessageUuid() string {
+	if x != nil {
+		return x.PinnedMessageUuid
+	}
+	return ""
+}
+
+func (x *Chat) GetCompanionUuid() string {
+	if x != nil {
+		return x.CompanionUuid
+	}
+	return ""
+}
+
+func (x *Chat) GetCompanionOnline() bool {
+	if x != nil {
+		return x.CompanionOnline
+	}
+	return false
+}
+
+func (x *Chat) GetCompanionLastOnline() string {
+	if x != nil {
+		return x.CompanionLastOnline
+	}
+	return ""
+}
+
+func (x *Chat) GetMuted() bool {
+	if x != nil {
+		return x.Muted
+	}
+	return false
+}
+
+func (x *Chat) GetMutedUntil() string {
+	if x != nil {
+		return x.MutedUntil
+	}
+	return ""
+}
+
+func (x *Chat) GetMentionsOnly() bool {
+	if x != nil {
+		return x.MentionsOnly
+	}
+	return false
+}
+
+type GetChatsOut struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Chats []*Chat `protobuf:"bytes,1,rep,name=chats,proto3" json:"chats,omitempty"` // Список чатов
+}
+
+func (x *GetChatsOut) Reset() {
+	*x = GetChatsOut{}
+	mi := &file_api_chat_proto_msgTypes[79]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetChatsOut) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetChatsOut) ProtoMessage() {}
+
+func (x *GetChatsOut) ProtoReflect() protoreflect.Message {
+	mi := &file_api_chat_proto_msgTypes[79]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetChatsOut.ProtoReflect.Descriptor instead.
+func (*GetChatsOut) Descriptor() ([]byte, []int) {
+	return file_api_chat_proto_rawDescGZIP(), []int{79}
+}
+
+func (x *GetChatsOut) GetChats() []*Chat {
+	if x != nil {
+		return x.Chats
+	}
+	return nil
+}
+
+type Message struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Uuid          string         `protobuf:"bytes,1,opt,name=uuid,proto3" json:"uuid,omitempty"`                                         // uuid пользователя
+	Content       string         `protobuf:"bytes,2,opt,name=content,proto3" json:"content,omitempty"`                                   // само сообщение
+	SentAt        string         `protobuf:"bytes,3,opt,name=sent_at,json=sentAt,proto3" json:"sent_at,omitempty"`                       // время отправки
+	UpdatedAt     string         `protobuf:"bytes,4,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`              // время обновления
+	RootUuid      string         `protobuf:"bytes,5,opt,name=root_uuid,json=rootUuid,proto3" json:"root_uuid,omitempty"`                 // uuid корневого сообщения
+	ParentUuid    string         `protobuf:"bytes,6,opt,name=parent_uuid,json=parentUuid,proto3" json:"parent_uuid,omitempty"`           // uuid сообщения, на которое идет прямой ответ
+	MessageUuid   string         `protobuf:"bytes,7,opt,name=message_uuid,json=messageUuid,proto3" json:"message_uuid,omitempty"`        // uuid сообщения
+	Type          string         `protobuf:"bytes,8,opt,name=type,proto3" json:"type,omitempty"`                                         // тип сообщения: text, image, video, file, speech, circle или system
+	Attachment    *Attachment    `protobuf:"bytes,9,opt,name=attachment,proto3" json:"attachment,omitempty"`                             // вложение, заполняется для всех типов, кроме text
+	Edited        bool           `protobuf:"varint,10,opt,name=edited,proto3" json:"edited,omitempty"`                                   // сообщение было изменено
+	Reactions     []*Reaction    `protobuf:"bytes,11,rep,name=reactions,proto3" json:"reactions,omitempty"`                              // реакции на сообщение
+	ForwardedFrom *ForwardedFrom `protobuf:"bytes,12,opt,name=forwarded_from,json=forwardedFrom,proto3" json:"forwarded_from,omitempty"` // источник пересланного сообщения, пусто для обычных сообщений
+}
+
+func (x *Message) Reset() {
+	*x = Message{}
+	mi := &file_api_chat_proto_msgTypes[80]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Message) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Message) ProtoMessage() {}
+
+func (x *Message) ProtoReflect() protoreflect.Message {
+	mi := &file_api_chat_proto_msgTypes[80]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Message.ProtoReflect.Descriptor instead.
+func (*Message) Descriptor() ([]byte, []int) {
+	return file_api_chat_proto_rawDescGZIP(), []int{80}
+}
+
+func (x *Message) GetUuid() string {
+	if x != nil {
+		return x.Uuid
+	}
+	return ""
+}
+
+func (x *Message) GetContent() string {
+	if x != nil {
+		return x.Content
+	}
+	return ""
+}
+
+func (x *Message) GetSentAt() string {
+	if x != nil {
+		return x.SentAt
+	}
+	return ""
+}
+
+func (x *Message) GetUpdatedAt() string {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return ""
+}
+
+func (x *Message) GetRootUuid() string {
+	if x != nil {
+		return x.RootUuid
+	}
+	return ""
+}
+
+func (x *Message) GetParentUuid() string {
+	if x != nil {
+		return x.ParentUuid
+	}
+	return ""
+}
+
+func (x *Message) GetMessageUuid() string {
+	if x != nil {
+		return x.MessageUuid
+	}
+	return ""
+}
+
+func (x *Message) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *Message) GetAttachment() *Attachment {
+	if x != nil {
+		return x.Attachment
+	}
+	return nil
+}
+
+func (x *Message) GetEdited() bool {
+	if x != nil {
+		return x.Edited
+	}
+	return false
+}
+
+func (x *Message) GetReactions() []*Reaction {
+	if x != nil {
+		return x.Reactions
+	}
+	return nil
+}
+
+func (x *Message) GetForwardedFrom() *ForwardedFrom {
+	if x != nil {
+		return x.ForwardedFrom
+	}
+	return nil
+}
+
+type ForwardedFrom struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	SenderUuid  string `protobuf:"bytes,1,opt,name=sender_uuid,json=senderUuid,proto3" json:"sender_uuid,omitempty"`    // uuid автора исходного сообщения
+	ChatUuid    string `protobuf:"bytes,2,opt,name=chat_uuid,json=chatUuid,proto3" json:"chat_uuid,omitempty"`          // uuid чата исходного сообщения
+	MessageUuid string `protobuf:"bytes,3,opt,name=message_uuid,json=messageUuid,proto3" json:"message_uuid,omitempty"` // uuid исходного сообщения
+}
+
+func (x *ForwardedFrom) Reset() {
+	*x = ForwardedFrom{}
+	mi := &file_api_chat_proto_msgTypes[81]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ForwardedFrom) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ForwardedFrom) ProtoMessage() {}
+
+func (x *ForwardedFrom) ProtoReflect() protoreflect.Message {
+	mi := &file_api_chat_proto_msgTypes[81]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ForwardedFrom.ProtoReflect.Descriptor instead.
+func (*ForwardedFrom) Descriptor() ([]byte, []int) {
+	return file_api_chat_proto_rawDescGZIP(), []int{81}
+}
+
+func (x *ForwardedFrom) GetSenderUuid() string {
+	if x != nil {
+		return x.SenderUuid
+	}
+	return ""
+}
+
+func (x *ForwardedFrom) GetChatUuid() string {
+	if x != nil {
+		return x.ChatUuid
+	}
+	return ""
+}
+
+func (x *ForwardedFrom) GetMessageUuid() string {
+	if x != nil {
+		return x.MessageUuid
+	}
+	return ""
+}
+
+type Reaction struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Emoji       string `protobuf:"bytes,1,opt,name=emoji,proto3" json:"emoji,omitempty"`                                   // эмодзи реакции
+	Count       int64  `protobuf:"varint,2,opt,name=count,proto3" json:"count,omitempty"`                                  // сколько пользователей поставили реакцию
+	ReactedByMe bool   `protobuf:"varint,3,opt,name=reacted_by_me,json=reactedByMe,proto3" json:"reacted_by_me,omitempty"` // реакция поставлена текущим пользователем
+}
+
+func (x *Reaction) Reset() {
+	*x = Reaction{}
+	mi := &file_api_chat_proto_msgTypes[82]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Reaction) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Reaction) ProtoMessage() {}
+
+func (x *Reaction) ProtoReflect() protoreflect.Message {
+	mi := &file_api_chat_proto_msgTypes[82]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Reaction.ProtoReflect.Descriptor instead.
+func (*Reaction) Descriptor() ([]byte, []int) {
+	return file_api_chat_proto_rawDescGZIP(), []int{82}
+}
+
+func (x *Reaction) GetEmoji() string {
+	if x != nil {
+		return x.Emoji
+	}
+	return ""
+}
+
+func (x *Reaction) GetCount() int64 {
+	if x != nil {
+		return x.Count
+	}
+	return 0
+}
+
+func (x *Reaction) GetReactedByMe() bool {
+	if x != nil {
+		return x.ReactedByMe
+	}
+	return false
+}
+
+type MediaFile struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	FileName   string `protobuf:"bytes,1,opt,name=file_name,json=fileName,proto3" json:"file_name,omitempty"`       // имя файла
+	MimeType   string `protobuf:"bytes,2,opt,name=mime_type,json=mimeType,proto3" json:"mime_type,omitempty"`       // MIME-тип файла
+	Size       int64  `protobuf:"varint,3,opt,name=size,proto3" json:"size,omitempty"`                              // размер файла в байтах
+	StorageKey string `protobuf:"bytes,4,opt,name=storage_key,json=storageKey,proto3" json:"storage_key,omitempty"` // ключ файла в хранилище
+}
+
+func (x *MediaFile) Reset() {
+	*x = MediaFile{}
+	mi := &file_api_chat_proto_msgTypes[83]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MediaFile) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MediaFile) ProtoMessage() {}
+
+func (x *MediaFile) ProtoReflect() protoreflect.Message {
+	mi := &file_api_chat_proto_msgTypes[83]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MediaFile.ProtoReflect.Descriptor instead.
+func (*MediaFile) Descriptor() ([]byte, []int) {
+	return file_api_chat_proto_rawDescGZIP(), []int{83}
+}
+
+func (x *MediaFile) GetFileName() string {
+	if x != nil {
+		return x.FileName
+	}
+	return ""
+}
+
+func (x *MediaFile) GetMimeType() string {
+	if x != nil {
+		return x.MimeType
+	}
+	return ""
+}
+
+func (x *MediaFile) GetSize() int64 {
+	if x != nil {
+		return x.Size
+	}
+	return 0
+}
+
+func (x *MediaFile) GetStorageKey() string {
+	if x != nil {
+		return x.StorageKey
+	}
+	return ""
+}
+
+type ImageAttachment struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	File   *MediaFile `protobuf:"bytes,1,opt,name=file,proto3" json:"file,omitempty"`      // файл изображения
+	Width  int32      `protobuf:"varint,2,opt,name=width,proto3" json:"width,omitempty"`   // ширина в пикселях
+	Height int32      `protobuf:"varint,3,opt,name=height,proto3" json:"height,omitempty"` // высота в пикселях
+}
+
+func (x *ImageAttachment) Reset() {
+	*x = ImageAttachment{}
+	mi := &file_api_chat_proto_msgTypes[84]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ImageAttachment) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ImageAttachment) ProtoMessage() {}
+
+func (x *ImageAttachment) ProtoReflect() protoreflect.Message {
+	mi := &file_api_chat_proto_msgTypes[84]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ImageAttachment.ProtoReflect.Descriptor instead.
+func (*ImageAttachment) Descriptor() ([]byte, []int) {
+	return file_api_chat_proto_rawDescGZIP(), []int{84}
+}
+
+func (x *ImageAttachment) GetFile() *MediaFile {
+	if x != nil {
+		return x.File
+	}
+	return nil
+}
+
+func (x *ImageAttachment) GetWidth() int32 {
+	if x != nil {
+		return x.Width
+	}
+	return 0
+}
+
+func (x *ImageAttachment) GetHeight() int32 {
+	if x != nil {
+		return x.Height
+	}
+	return 0
+}
+
+type VideoAttachment struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	File       *MediaFile `protobuf:"bytes,1,opt,name=file,proto3" json:"file,omitempty"`                                // файл видео
+	Width      int32      `protobuf:"varint,2,opt,name=width,proto3" json:"width,omitempty"`                             // ширина в пикселях
+	Height     int32      `protobuf:"varint,3,opt,name=height,proto3" json:"height,omitempty"`                           // высота в пикселях
+	DurationMs int32      `protobuf:"varint,4,opt,name=duration_ms,json=durationMs,proto3" json:"duration_ms,omitempty"` // длительность в миллисекундах
+}
+
+func (x *VideoAttachment) Reset() {
+	*x = VideoAttachment{}
+	mi := &file_api_chat_proto_msgTypes[85]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *VideoAttachment) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*VideoAttachment) ProtoMessage() {}
+
+func (x *VideoAttachment) ProtoReflect() protoreflect.Message {
+	mi := &file_api_chat_proto_msgTypes[85]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use VideoAttachment.ProtoReflect.Descriptor instead.
+func (*VideoAttachment) Descriptor() ([]byte, []int) {
+	return file_api_chat_proto_rawDescGZIP(), []int{85}
+}
+
+func (x *VideoAttachment) GetFile() *MediaFile {
+	if x != nil {
+		return x.File
+	}
+	return nil
+}
+
+func (x *VideoAttachment) GetWidth() int32 {
+	if x != nil {
+		return x.Width
+	}
+	return 0
+}
+
+func (x *VideoAttachment) GetHeight() int32 {
+	if x != nil {
+		return x.Height
+	}
+	return 0
+}
+
+func (x *VideoAttachment) GetDurationMs() int32 {
+	if x != nil {
+		return x.DurationMs
+	}
+	return 0
+}
+
+type FileAttachment struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	File *MediaFile `protobuf:"bytes,1,opt,name=file,proto3" json:"file,omitempty"` // произвольный файл
+}
+
+func (x *FileAttachment) Reset() {
+	*x = FileAttachment{}
+	mi := &file_api_chat_proto_msgTypes[86]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *FileAttachment) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FileAttachment) ProtoMessage() {}
+
+func (x *FileAttachment) ProtoReflect() protoreflect.Message {
+	mi := &file_api_chat_proto_msgTypes[86]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FileAttachment.ProtoReflect.Descriptor instead.
+func (*FileAttachment) Descriptor() ([]byte, []int) {
+	return file_api_chat_proto_rawDescGZIP(), []int{86}
+}
+
+func (x *FileAttachment) GetFile() *MediaFile {
+	if x != nil {
+		return x.File
+	}
+	return nil
+}
+
+type SpeechAttachment struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	File       *MediaFile `protobuf:"bytes,1,opt,name=file,proto3" json:"file,omitempty"`                                // файл голосового сообщения
+	DurationMs int32      `protobuf:"varint,2,opt,name=duration_ms,json=durationMs,proto3" json:"duration_ms,omitempty"` // длительность в миллисекундах
+}
+
+func (x *SpeechAttachment) Reset() {
+	*x = SpeechAttachment{}
+	mi := &file_api_chat_proto_msgTypes[87]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SpeechAttachment) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SpeechAttachment) ProtoMessage() {}
+
+func (x *SpeechAttachment) ProtoReflect() protoreflect.Message {
+	mi := &file_api_chat_proto_msgTypes[87]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SpeechAttachment.ProtoReflect.Descriptor instead.
+func (*SpeechAttachment) Descriptor() ([]byte, []int) {
+	return file_api_chat_proto_rawDescGZIP(), []int{87}
+}
+
+func (x *SpeechAttachment) GetFile() *MediaFile {
+	if x != nil {
+		return x.File
+	}
+	return nil
+}
+
+func (x *SpeechAttachment) GetDurationMs() int32 {
+	if x != nil {
+		return x.DurationMs
+	}
+	return 0
+}
+
+type CircleAttachment struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	File       *MediaFile `protobuf:"bytes,1,opt,name=file,proto3" json:"file,omitempty"`                                // файл видеосообщения
+	Diameter   int32      `protobuf:"varint,2,opt,name=diameter,proto3" json:"diameter,omitempty"`                       // диаметр в пикселях
+	DurationMs int32      `protobuf:"varint,3,opt,name=duration_ms,json=durationMs,proto3" json:"duration_ms,omitempty"` // длительность в миллисекундах
+}
+
+func (x *CircleAttachment) Reset() {
+	*x = CircleAttachment{}
+	mi := &file_api_chat_proto_msgTypes[88]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CircleAttachment) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CircleAttachment) ProtoMessage() {}
+
+func (x *CircleAttachment) ProtoReflect() protoreflect.Message {
+	mi := &file_api_chat_proto_msgTypes[88]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CircleAttachment.ProtoReflect.Descriptor instead.
+func (*CircleAttachment) Descriptor() ([]byte, []int) {
+	return file_api_chat_proto_rawDescGZIP(), []int{88}
+}
+
+func (x *CircleAttachment) GetFile() *MediaFile {
+	if x != nil {
+		return x.File
+	}
+	return nil
+}
+
+func (x *CircleAttachment) GetDiameter() int32 {
+	if x != nil {
+		return x.Diameter
+	}
+	return 0
+}
+
+func (x *CircleAttachment) GetDurationMs() int32 {
+	if x != nil {
+		return x.DurationMs
+	}
+	return 0
+}
+
+type Attachment struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Types that are assignable to Payload:
+	//	*Attachment_Image
+	//	*Attachment_Video
+	//	*Attachment_File
+	//	*Attachment_Speech
+	//	*Attachment_Circle
+	Payload isAttachment_Payload `protobuf_oneof:"payload"`
+}
+
+func (x *Attachment) Reset() {
+	*x = Attachment{}
+	mi := &file_api_chat_proto_msgTypes[89]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Attachment) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Attachment) ProtoMessage() {}
+
+func (x *Attachment) ProtoReflect() protoreflect.Message {
+	mi := &file_api_chat_proto_msgTypes[89]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Attachment.ProtoReflect.Descriptor instead.
+func (*Attachment) Descriptor() ([]byte, []int) {
+	return file_api_chat_proto_rawDescGZIP(), []int{89}
+}
+
+func (m *Attachment) GetPayload() isAttachment_Payload {
+	if m != nil {
+		return m.Payload
+	}
+	return nil
+}
+
+func (x *Attachment) GetImage() *ImageAttachment {
+	if x, ok := x.GetPayload().(*Attachment_Image); ok {
+		return x.Image
+	}
+	return nil
+}
+
+func (x *Attachment) GetVideo() *VideoAttachment {
+	if x, ok := x.GetPayload().(*Attachment_Video); ok {
+		return x.Video
+	}
+	return nil
+}
+
+func (x *Attachment) GetFile() *FileAttachment {
+	if x, ok := x.GetPayload().(*Attachment_File); ok {
+		return x.File
+	}
+	return nil
+}
+
+func (x *Attachment) GetSpeech() *SpeechAttachment {
+	if x, ok := x.GetPayload().(*Attachment_Speech); ok {
+		return x.Speech
+	}
+	return nil
+}
+
+func (x *Attachment) GetCircle() *CircleAttachment {
+	if x, ok := x.GetPayload().(*Attachment_Circle); ok {
+		return x.Circle
+	}
+	return nil
+}
+
+type isAttachment_Payload interface {
+	isAttachment_Payload()
+}
+
+type Attachment_Image struct {
+	Image *ImageAttachment `protobuf:"bytes,1,opt,name=image,proto3,oneof"`
+}
+
+type Attachment_Video struct {
+	Video *VideoAttachment `protobuf:"bytes,2,opt,name=video,proto3,oneof"`
+}
+
+type Attachment_File struct {
+	File *FileAttachment `protobuf:"bytes,3,opt,name=file,proto3,oneof"`
+}
+
+type Attachment_Speech struct {
+	Speech *SpeechAttachment `protobuf:"bytes,4,opt,name=speech,proto3,oneof"`
+}
+
+type Attachment_Circle struct {
+	Circle *CircleAttachment `protobuf:"bytes,5,opt,name=circle,proto3,oneof"`
+}
+
+func (*Attachment_Image) isAttachment_Payload() {}
+
+func (*Attachment_Video) isAttachment_Payload() {}
+
+func (*Attachment_File) isAttachment_Payload() {}
+
+func (*Attachment_Speech) isAttachment_Payload() {}
+
+func (*Attachment_Circle) isAttachment_Payload() {}
+
+type GetPrivateRecentMessagesIn struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ChatUuid         string `protobuf:"bytes,1,opt,name=chat_uuid,json=chatUuid,proto3" json:"chat_uuid,omitempty"`                           // uuid чата, из которого достаем сообщения
+	Limit            int32  `protobuf:"varint,2,opt,name=limit,proto3" json:"limit,omitempty"`                                                // размер страницы, по умолчанию 15, максимум 100
+	Cursor           string `protobuf:"bytes,3,opt,name=cursor,proto3" json:"cursor,omitempty"`                                               // курсор из next_cursor предыдущего ответа
+	AfterMessageUuid string `protobuf:"bytes,4,opt,name=after_message_uuid,json=afterMessageUuid,proto3" json:"after_message_uuid,omitempty"` // uuid сообщения, после которого нужно получить новые сообщения
+}
+
+func (x *GetPrivateRecentMessagesIn) Reset() {
+	*x = GetPrivateRecentMessagesIn{}
+	mi := &file_api_chat_proto_msgTypes[90]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetPrivateRecentMessagesIn) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetPrivateRecentMessagesIn) ProtoMessage() {}
+
+func (x *GetPrivateRecentMessagesIn) ProtoReflect() protoreflect.Message {
+	mi := &file_api_chat_proto_msgTypes[90]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetPrivateRecentMessagesIn.ProtoReflect.Descriptor instead.
+func (*GetPrivateRecentMessagesIn) Descriptor() ([]byte, []int) {
+	return file_api_chat_proto_rawDescGZIP(), []int{90}
+}
+
+func (x *GetPrivateRecentMessagesIn) GetChatUuid() string {
+	if x != nil {
+		return x.ChatUuid
 	}
 	return ""
 }
 
-func (x *Chat) GetAvatarUrl() string {
+func (x *GetPrivateRecentMessagesIn) GetLimit() int32 {
 	if x != nil {
-		return x.AvatarUrl
+		return x.Limit
 	}
-	return ""
+	return 0
 }
 
-func (x *Chat) GetLastMessageTimestamp() string {
+func (x *GetPrivateRecentMessagesIn) GetCursor() string {
 	if x != nil {
-		return x.LastMessageTimestamp
+		return x.Cursor
 	}
 	return ""
 }
 
-func (x *Chat) GetChatUuid() string {
+func (x *GetPrivateRecentMessagesIn) GetAfterMessageUuid() string {
 	if x != nil {
-		return x.ChatUuid
+		return x.AfterMessageUuid
 	}
 	return ""
 }
 
-type GetChatsOut struct {
+type GetPrivateRecentMessagesOut struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Chats []*Chat `protobuf:"bytes,1,rep,name=chats,proto3" json:"chats,omitempty"` // Список чатов
+	Messages   []*Message `protobuf:"bytes,1,rep,name=messages,proto3" json:"messages,omitempty"`                       // список сообщений: от новых к старым, при листании вперед — от старых к новым
+	NextCursor string     `protobuf:"bytes,2,opt,name=next_cursor,json=nextCursor,proto3" json:"next_cursor,omitempty"` // курсор следующей страницы, пустой если сообщений больше нет
 }
 
-func (x *GetChatsOut) Reset() {
-	*x = GetChatsOut{}
-	mi := &file_api_chat_proto_msgTypes[3]
+func (x *GetPrivateRecentMessagesOut) Reset() {
+	*x = GetPrivateRecentMessagesOut{}
+	mi := &file_api_chat_proto_msgTypes[91]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetChatsOut) String() string {
+func (x *GetPrivateRecentMessagesOut) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetChatsOut) ProtoMessage() {}
+func (*GetPrivateRecentMessagesOut) ProtoMessage() {}
 
-func (x *GetChatsOut) ProtoReflect() protoreflect.Message {
-	mi := &file_api_chat_proto_msgTypes[3]
+func (x *GetPrivateRecentMessagesOut) ProtoReflect() protoreflect.Message {
+	mi := &file_api_chat_proto_msgTypes[91]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -221,46 +5356,52 @@ func (x *GetChatsOut) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetChatsOut.ProtoReflect.Descriptor instead.
-func (*GetChatsOut) Descriptor() ([]byte, []int) {
-	return file_api_chat_proto_rawDescGZIP(), []int{3}
+// Deprecated: Use GetPrivateRecentMessagesOut.ProtoReflect.Descriptor instead.
+func (*GetPrivateRecentMessagesOut) Descriptor() ([]byte, []int) {
+	return file_api_chat_proto_rawDescGZIP(), []int{91}
 }
 
-func (x *GetChatsOut) GetChats() []*Chat {
+func (x *GetPrivateRecentMessagesOut) GetMessages() []*Message {
 	if x != nil {
-		return x.Chats
+		return x.Messages
 	}
 	return nil
 }
 
-type Message struct {
+func (x *GetPrivateRecentMessagesOut) GetNextCursor() string {
+	if x != nil {
+		return x.NextCursor
+	}
+	return ""
+}
+
+type SendPrivateMessageIn struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Uuid       string `protobuf:"bytes,1,opt,name=uuid,proto3" json:"uuid,omitempty"`                               // uuid пользователя
-	Content    string `protobuf:"bytes,2,opt,name=content,proto3" json:"content,omitempty"`                         // само сообщение
-	SentAt     string `protobuf:"bytes,3,opt,name=sent_at,json=sentAt,proto3" json:"sent_at,omitempty"`             // время отправки
-	UpdatedAt  string `protobuf:"bytes,4,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`    // время обновления
-	RootUuid   string `protobuf:"bytes,5,opt,name=root_uuid,json=rootUuid,proto3" json:"root_uuid,omitempty"`       // uuid корневого сообщения
-	ParentUuid string `protobuf:"bytes,6,opt,name=parent_uuid,json=parentUuid,proto3" json:"parent_uuid,omitempty"` // uuid сообщения, на которое идет прямой ответ
+	ChatUuid   string      `protobuf:"bytes,1,opt,name=chat_uuid,json=chatUuid,proto3" json:"chat_uuid,omitempty"`       // uuid чата, в который отправляется сообщение
+	Content    string      `protobuf:"bytes,2,opt,name=content,proto3" json:"content,omitempty"`                         // текст сообщения
+	RootUuid   string      `protobuf:"bytes,3,opt,name=root_uuid,json=rootUuid,proto3" json:"root_uuid,omitempty"`       // uuid корневого сообщения (необязательно)
+	ParentUuid string      `protobuf:"bytes,4,opt,name=parent_uuid,json=parentUuid,proto3" json:"parent_uuid,omitempty"` // uuid сообщения, на которое идет прямой ответ (необязательно)
+	Attachment *Attachment `protobuf:"bytes,5,opt,name=attachment,proto3" json:"attachment,omitempty"`                   // вложение (необязательно), для медиа-сообщений content служит подписью
 }
 
-func (x *Message) Reset() {
-	*x = Message{}
-	mi := &file_api_chat_proto_msgTypes[4]
+func (x *SendPrivateMessageIn) Reset() {
+	*x = SendPrivateMessageIn{}
+	mi := &file_api_chat_proto_msgTypes[92]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *Message) String() string {
+func (x *SendPrivateMessageIn) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Message) ProtoMessage() {}
+func (*SendPrivateMessageIn) ProtoMessage() {}
 
-func (x *Message) ProtoReflect() protoreflect.Message {
-	mi := &file_api_chat_proto_msgTypes[4]
+func (x *SendPrivateMessageIn) ProtoReflect() protoreflect.Message {
+	mi := &file_api_chat_proto_msgTypes[92]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -271,121 +5412,69 @@ func (x *Message) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Message.ProtoReflect.Descriptor instead.
-func (*Message) Descriptor() ([]byte, []int) {
-	return file_api_chat_proto_rawDescGZIP(), []int{4}
+// Deprecated: Use SendPrivateMessageIn.ProtoReflect.Descriptor instead.
+func (*SendPrivateMessageIn) Descriptor() ([]byte, []int) {
+	return file_api_chat_proto_rawDescGZIP(), []int{92}
 }
 
-func (x *Message) GetUuid() string {
+func (x *SendPrivateMessageIn) GetChatUuid() string {
 	if x != nil {
-		return x.Uuid
+		return x.ChatUuid
 	}
 	return ""
 }
 
-func (x *Message) GetContent() string {
+func (x *SendPrivateMessageIn) GetContent() string {
 	if x != nil {
 		return x.Content
 	}
 	return ""
 }
 
-func (x *Message) GetSentAt() string {
-	if x != nil {
-		return x.SentAt
-	}
-	return ""
-}
-
-func (x *Message) GetUpdatedAt() string {
-	if x != nil {
-		return x.UpdatedAt
-	}
-	return ""
-}
-
-func (x *Message) GetRootUuid() string {
+func (x *SendPrivateMessageIn) GetRootUuid() string {
 	if x != nil {
 		return x.RootUuid
 	}
 	return ""
 }
 
-func (x *Message) GetParentUuid() string {
+func (x *SendPrivateMessageIn) GetParentUuid() string {
 	if x != nil {
 		return x.ParentUuid
 	}
 	return ""
 }
 
-type GetPrivateRecentMessagesIn struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
-
-	ChatUuid string `protobuf:"bytes,1,opt,name=chat_uuid,json=chatUuid,proto3" json:"chat_uuid,omitempty"` // uuid чата, из которого достаем сообщения
-}
-
-func (x *GetPrivateRecentMessagesIn) Reset() {
-	*x = GetPrivateRecentMessagesIn{}
-	mi := &file_api_chat_proto_msgTypes[5]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
-}
-
-func (x *GetPrivateRecentMessagesIn) String() string {
-	return protoimpl.X.MessageStringOf(x)
-}
-
-func (*GetPrivateRecentMessagesIn) ProtoMessage() {}
-
-func (x *GetPrivateRecentMessagesIn) ProtoReflect() protoreflect.Message {
-	mi := &file_api_chat_proto_msgTypes[5]
-	if x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
-	}
-	return mi.MessageOf(x)
-}
-
-// Deprecated: Use GetPrivateRecentMessagesIn.ProtoReflect.Descriptor instead.
-func (*GetPrivateRecentMessagesIn) Descriptor() ([]byte, []int) {
-	return file_api_chat_proto_rawDescGZIP(), []int{5}
-}
-
-func (x *GetPrivateRecentMessagesIn) GetChatUuid() string {
+func (x *SendPrivateMessageIn) GetAttachment() *Attachment {
 	if x != nil {
-		return x.ChatUuid
+		return x.Attachment
 	}
-	return ""
+	return nil
 }
 
-type GetPrivateRecentMessagesOut struct {
+type SendPrivateMessageOut struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Messages []*Message `protobuf:"bytes,1,rep,name=messages,proto3" json:"messages,omitempty"` // список сообщений
+	Message *Message `protobuf:"bytes,1,opt,name=message,proto3" json:"message,omitempty"` // сохраненное сообщение
 }
 
-func (x *GetPrivateRecentMessagesOut) Reset() {
-	*x = GetPrivateRecentMessagesOut{}
-	mi := &file_api_chat_proto_msgTypes[6]
+func (x *SendPrivateMessageOut) Reset() {
+	*x = SendPrivateMessageOut{}
+	mi := &file_api_chat_proto_msgTypes[93]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetPrivateRecentMessagesOut) String() string {
+func (x *SendPrivateMessageOut) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetPrivateRecentMessagesOut) ProtoMessage() {}
+func (*SendPrivateMessageOut) ProtoMessage() {}
 
-func (x *GetPrivateRecentMessagesOut) ProtoReflect() protoreflect.Message {
-	mi := &file_api_chat_proto_msgTypes[6]
+func (x *SendPrivateMessageOut) ProtoReflect() protoreflect.Message {
+	mi := &file_api_chat_proto_msgTypes[93]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -396,14 +5485,14 @@ func (x *GetPrivateRecentMessagesOut) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetPrivateRecentMessagesOut.ProtoReflect.Descriptor instead.
-func (*GetPrivateRecentMessagesOut) Descriptor() ([]byte, []int) {
-	return file_api_chat_proto_rawDescGZIP(), []int{6}
+// Deprecated: Use SendPrivateMessageOut.ProtoReflect.Descriptor instead.
+func (*SendPrivateMessageOut) Descriptor() ([]byte, []int) {
+	return file_api_chat_proto_rawDescGZIP(), []int{93}
 }
 
-func (x *GetPrivateRecentMessagesOut) GetMessages() []*Message {
+func (x *SendPrivateMessageOut) GetMessage() *Message {
 	if x != nil {
-		return x.Messages
+		return x.Message
 	}
 	return nil
 }
@@ -420,7 +5509,7 @@ type DeletePrivateMessageIn struct {
 
 func (x *DeletePrivateMessageIn) Reset() {
 	*x = DeletePrivateMessageIn{}
-	mi := &file_api_chat_proto_msgTypes[7]
+	mi := &file_api_chat_proto_msgTypes[94]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -432,7 +5521,7 @@ func (x *DeletePrivateMessageIn) String() string {
 func (*DeletePrivateMessageIn) ProtoMessage() {}
 
 func (x *DeletePrivateMessageIn) ProtoReflect() protoreflect.Message {
-	mi := &file_api_chat_proto_msgTypes[7]
+	mi := &file_api_chat_proto_msgTypes[94]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -445,7 +5534,7 @@ func (x *DeletePrivateMessageIn) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DeletePrivateMessageIn.ProtoReflect.Descriptor instead.
 func (*DeletePrivateMessageIn) Descriptor() ([]byte, []int) {
-	return file_api_chat_proto_rawDescGZIP(), []int{7}
+	return file_api_chat_proto_rawDescGZIP(), []int{94}
 }
 
 func (x *DeletePrivateMessageIn) GetChatUuid() string {
@@ -479,7 +5568,7 @@ type DeletePrivateMessageOut struct {
 
 func (x *DeletePrivateMessageOut) Reset() {
 	*x = DeletePrivateMessageOut{}
-	mi := &file_api_chat_proto_msgTypes[8]
+	mi := &file_api_chat_proto_msgTypes[95]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -491,7 +5580,7 @@ func (x *DeletePrivateMessageOut) String() string {
 func (*DeletePrivateMessageOut) ProtoMessage() {}
 
 func (x *DeletePrivateMessageOut) ProtoReflect() protoreflect.Message {
-	mi := &file_api_chat_proto_msgTypes[8]
+	mi := &file_api_chat_proto_msgTypes[95]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -504,7 +5593,7 @@ func (x *DeletePrivateMessageOut) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DeletePrivateMessageOut.ProtoReflect.Descriptor instead.
 func (*DeletePrivateMessageOut) Descriptor() ([]byte, []int) {
-	return file_api_chat_proto_rawDescGZIP(), []int{8}
+	return file_api_chat_proto_rawDescGZIP(), []int{95}
 }
 
 func (x *DeletePrivateMessageOut) GetDeletionStatus() bool {
@@ -526,7 +5615,7 @@ type EditPrivateMessageIn struct {
 
 func (x *EditPrivateMessageIn) Reset() {
 	*x = EditPrivateMessageIn{}
-	mi := &file_api_chat_proto_msgTypes[9]
+	mi := &file_api_chat_proto_msgTypes[96]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -538,7 +5627,7 @@ func (x *EditPrivateMessageIn) String() string {
 func (*EditPrivateMessageIn) ProtoMessage() {}
 
 func (x *EditPrivateMessageIn) ProtoReflect() protoreflect.Message {
-	mi := &file_api_chat_proto_msgTypes[9]
+	mi := &file_api_chat_proto_msgTypes[96]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -551,7 +5640,7 @@ func (x *EditPrivateMessageIn) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use EditPrivateMessageIn.ProtoReflect.Descriptor instead.
 func (*EditPrivateMessageIn) Descriptor() ([]byte, []int) {
-	return file_api_chat_proto_rawDescGZIP(), []int{9}
+	return file_api_chat_proto_rawDescGZIP(), []int{96}
 }
 
 func (x *EditPrivateMessageIn) GetChatUuid() string {
@@ -587,7 +5676,7 @@ type EditPrivateMessageOut struct {
 
 func (x *EditPrivateMessageOut) Reset() {
 	*x = EditPrivateMessageOut{}
-	mi := &file_api_chat_proto_msgTypes[10]
+	mi := &file_api_chat_proto_msgTypes[97]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -599,7 +5688,7 @@ func (x *EditPrivateMessageOut) String() string {
 func (*EditPrivateMessageOut) ProtoMessage() {}
 
 func (x *EditPrivateMessageOut) ProtoReflect() protoreflect.Message {
-	mi := &file_api_chat_proto_msgTypes[10]
+	mi := &file_api_chat_proto_msgTypes[97]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -612,7 +5701,7 @@ func (x *EditPrivateMessageOut) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use EditPrivateMessageOut.ProtoReflect.Descriptor instead.
 func (*EditPrivateMessageOut) Descriptor() ([]byte, []int) {
-	return file_api_chat_proto_rawDescGZIP(), []int{10}
+	return file_api_chat_proto_rawDescGZIP(), []int{97}
 }
 
 func (x *EditPrivateMessageOut) GetMessageUuid() string {
@@ -636,6 +5725,165 @@ func (x *EditPrivateMessageOut) GetUpdatedAt() string {
 	return ""
 }
 
+type GetMessageRevisionsIn struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ChatUuid    string `protobuf:"bytes,1,opt,name=chat_uuid,json=chatUuid,proto3" json:"chat_uuid,omitempty"`          // uuid чата
+	MessageUuid string `protobuf:"bytes,2,opt,name=message_uuid,json=messageUuid,proto3" json:"message_uuid,omitempty"` // uuid сообщения
+}
+
+func (x *GetMessageRevisionsIn) Reset() {
+	*x = GetMessageRevisionsIn{}
+	mi := &file_api_chat_proto_msgTypes[98]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetMessageRevisionsIn) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetMessageRevisionsIn) ProtoMessage() {}
+
+func (x *GetMessageRevisionsIn) ProtoReflect() protoreflect.Message {
+	mi := &file_api_chat_proto_msgTypes[98]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetMessageRevisionsIn.ProtoReflect.Descriptor instead.
+func (*GetMessageRevisionsIn) Descriptor() ([]byte, []int) {
+	return file_api_chat_proto_rawDescGZIP(), []int{98}
+}
+
+func (x *GetMessageRevisionsIn) GetChatUuid() string {
+	if x != nil {
+		return x.ChatUuid
+	}
+	return ""
+}
+
+func (x *GetMessageRevisionsIn) GetMessageUuid() string {
+	if x != nil {
+		return x.MessageUuid
+	}
+	return ""
+}
+
+type MessageRevision struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Content    string `protobuf:"bytes,1,opt,name=content,proto3" json:"content,omitempty"`                         // текст сообщения до изменения
+	WrittenAt  string `protobuf:"bytes,2,opt,name=written_at,json=writtenAt,proto3" json:"written_at,omitempty"`    // время, когда был написан этот текст
+	ReplacedAt string `protobuf:"bytes,3,opt,name=replaced_at,json=replacedAt,proto3" json:"replaced_at,omitempty"` // время, когда текст был заменен
+}
+
+func (x *MessageRevision) Reset() {
+	*x = MessageRevision{}
+	mi := &file_api_chat_proto_msgTypes[99]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MessageRevision) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MessageRevision) ProtoMessage() {}
+
+func (x *MessageRevision) ProtoReflect() protoreflect.Message {
+	mi := &file_api_chat_proto_msgTypes[99]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MessageRevision.ProtoReflect.Descriptor instead.
+func (*MessageRevision) Descriptor() ([]byte, []int) {
+	return file_api_chat_proto_rawDescGZIP(), []int{99}
+}
+
+func (x *MessageRevision) GetContent() string {
+	if x != nil {
+		return x.Content
+	}
+	return ""
+}
+
+func (x *MessageRevision) GetWrittenAt() string {
+	if x != nil {
+		return x.WrittenAt
+	}
+	return ""
+}
+
+func (x *MessageRevision) GetReplacedAt() string {
+	if x != nil {
+		return x.ReplacedAt
+	}
+	return ""
+}
+
+type GetMessageRevisionsOut struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Revisions []*MessageRevision `protobuf:"bytes,1,rep,name=revisions,proto3" json:"revisions,omitempty"` // предыдущие версии сообщения, от старых к новым
+}
+
+func (x *GetMessageRevisionsOut) Reset() {
+	*x = GetMessageRevisionsOut{}
+	mi := &file_api_chat_proto_msgTypes[100]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetMessageRevisionsOut) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetMessageRevisionsOut) ProtoMessage() {}
+
+func (x *GetMessageRevisionsOut) ProtoReflect() protoreflect.Message {
+	mi := &file_api_chat_proto_msgTypes[100]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetMessageRevisionsOut.ProtoReflect.Descriptor instead.
+func (*GetMessageRevisionsOut) Descriptor() ([]byte, []int) {
+	return file_api_chat_proto_rawDescGZIP(), []int{100}
+}
+
+func (x *GetMessageRevisionsOut) GetRevisions() []*MessageRevision {
+	if x != nil {
+		return x.Revisions
+	}
+	return nil
+}
+
 var File_api_chat_proto protoreflect.FileDescriptor
 
 var file_api_chat_proto_rawDesc = []byte{
@@ -645,95 +5893,802 @@ var file_api_chat_proto_rawDesc = []byte{
 	0x13, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x50, 0x72, 0x69, 0x76, 0x61, 0x74, 0x65, 0x43, 0x68,
 	0x61, 0x74, 0x49, 0x6e, 0x12, 0x25, 0x0a, 0x0e, 0x63, 0x6f, 0x6d, 0x70, 0x61, 0x6e, 0x69, 0x6f,
 	0x6e, 0x5f, 0x75, 0x75, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x63, 0x6f,
-	0x6d, 0x70, 0x61, 0x6e, 0x69, 0x6f, 0x6e, 0x55, 0x75, 0x69, 0x64, 0x22, 0x3a, 0x0a, 0x14, 0x43,
+	0x6d, 0x70, 0x61, 0x6e, 0x69, 0x6f, 0x6e, 0x55, 0x75, 0x69, 0x64, 0x22, 0x54, 0x0a, 0x14, 0x43,
 	0x72, 0x65, 0x61, 0x74, 0x65, 0x50, 0x72, 0x69, 0x76, 0x61, 0x74, 0x65, 0x43, 0x68, 0x61, 0x74,
 	0x4f, 0x75, 0x74, 0x12, 0x22, 0x0a, 0x0d, 0x6e, 0x65, 0x77, 0x5f, 0x63, 0x68, 0x61, 0x74, 0x5f,
 	0x75, 0x75, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x6e, 0x65, 0x77, 0x43,
-	0x68, 0x61, 0x74, 0x55, 0x75, 0x69, 0x64, 0x22, 0xb8, 0x01, 0x0a, 0x04, 0x43, 0x68, 0x61, 0x74,
-	0x12, 0x21, 0x0a, 0x0c, 0x6c, 0x61, 0x73, 0x74, 0x5f, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x6c, 0x61, 0x73, 0x74, 0x4d, 0x65, 0x73, 0x73,
-	0x61, 0x67, 0x65, 0x12, 0x1b, 0x0a, 0x09, 0x63, 0x68, 0x61, 0x74, 0x5f, 0x6e, 0x61, 0x6d, 0x65,
-	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x63, 0x68, 0x61, 0x74, 0x4e, 0x61, 0x6d, 0x65,
-	0x12, 0x1d, 0x0a, 0x0a, 0x61, 0x76, 0x61, 0x74, 0x61, 0x72, 0x5f, 0x75, 0x72, 0x6c, 0x18, 0x03,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x61, 0x76, 0x61, 0x74, 0x61, 0x72, 0x55, 0x72, 0x6c, 0x12,
-	0x34, 0x0a, 0x16, 0x6c, 0x61, 0x73, 0x74, 0x5f, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x5f,
-	0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x14, 0x6c, 0x61, 0x73, 0x74, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x54, 0x69, 0x6d, 0x65,
-	0x73, 0x74, 0x61, 0x6d, 0x70, 0x12, 0x1b, 0x0a, 0x09, 0x63, 0x68, 0x61, 0x74, 0x5f, 0x75, 0x75,
-	0x69, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x63, 0x68, 0x61, 0x74, 0x55, 0x75,
-	0x69, 0x64, 0x22, 0x2a, 0x0a, 0x0b, 0x47, 0x65, 0x74, 0x43, 0x68, 0x61, 0x74, 0x73, 0x4f, 0x75,
-	0x74, 0x12, 0x1b, 0x0a, 0x05, 0x63, 0x68, 0x61, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b,
-	0x32, 0x05, 0x2e, 0x43, 0x68, 0x61, 0x74, 0x52, 0x05, 0x63, 0x68, 0x61, 0x74, 0x73, 0x22, 0xad,
-	0x01, 0x0a, 0x07, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x75, 0x75,
-	0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x75, 0x75, 0x69, 0x64, 0x12, 0x18,
-	0x0a, 0x07, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x07, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x12, 0x17, 0x0a, 0x07, 0x73, 0x65, 0x6e, 0x74,
-	0x5f, 0x61, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x65, 0x6e, 0x74, 0x41,
-	0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18,
-	0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74,
-	0x12, 0x1b, 0x0a, 0x09, 0x72, 0x6f, 0x6f, 0x74, 0x5f, 0x75, 0x75, 0x69, 0x64, 0x18, 0x05, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x08, 0x72, 0x6f, 0x6f, 0x74, 0x55, 0x75, 0x69, 0x64, 0x12, 0x1f, 0x0a,
-	0x0b, 0x70, 0x61, 0x72, 0x65, 0x6e, 0x74, 0x5f, 0x75, 0x75, 0x69, 0x64, 0x18, 0x06, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x0a, 0x70, 0x61, 0x72, 0x65, 0x6e, 0x74, 0x55, 0x75, 0x69, 0x64, 0x22, 0x39,
-	0x0a, 0x1a, 0x47, 0x65, 0x74, 0x50, 0x72, 0x69, 0x76, 0x61, 0x74, 0x65, 0x52, 0x65, 0x63, 0x65,
-	0x6e, 0x74, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x49, 0x6e, 0x12, 0x1b, 0x0a, 0x09,
+	0x68, 0x61, 0x74, 0x55, 0x75, 0x69, 0x64, 0x12, 0x18, 0x0a, 0x07, 0x63, 0x72, 0x65, 0x61, 0x74,
+	0x65, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65,
+	0x64, 0x22, 0x69, 0x0a, 0x11, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x47, 0x72, 0x6f, 0x75, 0x70,
+	0x43, 0x68, 0x61, 0x74, 0x49, 0x6e, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x61, 0x76,
+	0x61, 0x74, 0x61, 0x72, 0x5f, 0x75, 0x72, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09,
+	0x61, 0x76, 0x61, 0x74, 0x61, 0x72, 0x55, 0x72, 0x6c, 0x12, 0x21, 0x0a, 0x0c, 0x6d, 0x65, 0x6d,
+	0x62, 0x65, 0x72, 0x5f, 0x75, 0x75, 0x69, 0x64, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x09, 0x52,
+	0x0b, 0x6d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x55, 0x75, 0x69, 0x64, 0x73, 0x22, 0x38, 0x0a, 0x12,
+	0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x43, 0x68, 0x61, 0x74, 0x4f,
+	0x75, 0x74, 0x12, 0x22, 0x0a, 0x0d, 0x6e, 0x65, 0x77, 0x5f, 0x63, 0x68, 0x61, 0x74, 0x5f, 0x75,
+	0x75, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x6e, 0x65, 0x77, 0x43, 0x68,
+	0x61, 0x74, 0x55, 0x75, 0x69, 0x64, 0x22, 0x53, 0x0a, 0x11, 0x41, 0x64, 0x64, 0x47, 0x72, 0x6f,
+	0x75, 0x70, 0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x73, 0x49, 0x6e, 0x12, 0x1b, 0x0a, 0x09, 0x63,
+	0x68, 0x61, 0x74, 0x5f, 0x75, 0x75, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08,
+	0x63, 0x68, 0x61, 0x74, 0x55, 0x75, 0x69, 0x64, 0x12, 0x21, 0x0a, 0x0c, 0x6d, 0x65, 0x6d, 0x62,
+	0x65, 0x72, 0x5f, 0x75, 0x75, 0x69, 0x64, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0b,
+	0x6d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x55, 0x75, 0x69, 0x64, 0x73, 0x22, 0x35, 0x0a, 0x12, 0x41,
+	0x64, 0x64, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x73, 0x4f, 0x75,
+	0x74, 0x12, 0x1f, 0x0a, 0x0b, 0x61, 0x64, 0x64, 0x65, 0x64, 0x5f, 0x75, 0x75, 0x69, 0x64, 0x73,
+	0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0a, 0x61, 0x64, 0x64, 0x65, 0x64, 0x55, 0x75, 0x69,
+	0x64, 0x73, 0x22, 0x53, 0x0a, 0x13, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x47, 0x72, 0x6f, 0x75,
+	0x70, 0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x49, 0x6e, 0x12, 0x1b, 0x0a, 0x09, 0x63, 0x68, 0x61,
+	0x74, 0x5f, 0x75, 0x75, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x63, 0x68,
+	0x61, 0x74, 0x55, 0x75, 0x69, 0x64, 0x12, 0x1f, 0x0a, 0x0b, 0x6d, 0x65, 0x6d, 0x62, 0x65, 0x72,
+	0x5f, 0x75, 0x75, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x6d, 0x65, 0x6d,
+	0x62, 0x65, 0x72, 0x55, 0x75, 0x69, 0x64, 0x22, 0x3d, 0x0a, 0x14, 0x52, 0x65, 0x6d, 0x6f, 0x76,
+	0x65, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x4f, 0x75, 0x74, 0x12,
+	0x25, 0x0a, 0x0e, 0x72, 0x65, 0x6d, 0x6f, 0x76, 0x61, 0x6c, 0x5f, 0x73, 0x74, 0x61, 0x74, 0x75,
+	0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0d, 0x72, 0x65, 0x6d, 0x6f, 0x76, 0x61, 0x6c,
+	0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x22, 0xdc, 0x01, 0x0a, 0x0a, 0x49, 0x6e, 0x76, 0x69, 0x74,
+	0x65, 0x4c, 0x69, 0x6e, 0x6b, 0x12, 0x12, 0x0a, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x12, 0x1b, 0x0a, 0x09, 0x63, 0x68, 0x61,
+	0x74, 0x5f, 0x75, 0x75, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x63, 0x68,
+	0x61, 0x74, 0x55, 0x75, 0x69, 0x64, 0x12, 0x1d, 0x0a, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65,
+	0x64, 0x5f, 0x62, 0x79, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x63, 0x72, 0x65, 0x61,
+	0x74, 0x65, 0x64, 0x42, 0x79, 0x12, 0x1d, 0x0a, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64,
+	0x5f, 0x61, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74,
+	0x65, 0x64, 0x41, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x65, 0x78, 0x70, 0x69, 0x72, 0x65, 0x73, 0x5f,
+	0x61, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x65, 0x78, 0x70, 0x69, 0x72, 0x65,
+	0x73, 0x41, 0x74, 0x12, 0x1f, 0x0a, 0x0b, 0x75, 0x73, 0x61, 0x67, 0x65, 0x5f, 0x6c, 0x69, 0x6d,
+	0x69, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0a, 0x75, 0x73, 0x61, 0x67, 0x65, 0x4c,
+	0x69, 0x6d, 0x69, 0x74, 0x12, 0x1f, 0x0a, 0x0b, 0x75, 0x73, 0x61, 0x67, 0x65, 0x5f, 0x63, 0x6f,
+	0x75, 0x6e, 0x74, 0x18, 0x07, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0a, 0x75, 0x73, 0x61, 0x67, 0x65,
+	0x43, 0x6f, 0x75, 0x6e, 0x74, 0x22, 0x80, 0x01, 0x0a, 0x12, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65,
+	0x49, 0x6e, 0x76, 0x69, 0x74, 0x65, 0x4c, 0x69, 0x6e, 0x6b, 0x49, 0x6e, 0x12, 0x1b, 0x0a, 0x09,
 	0x63, 0x68, 0x61, 0x74, 0x5f, 0x75, 0x75, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x08, 0x63, 0x68, 0x61, 0x74, 0x55, 0x75, 0x69, 0x64, 0x22, 0x43, 0x0a, 0x1b, 0x47, 0x65, 0x74,
-	0x50, 0x72, 0x69, 0x76, 0x61, 0x74, 0x65, 0x52, 0x65, 0x63, 0x65, 0x6e, 0x74, 0x4d, 0x65, 0x73,
-	0x73, 0x61, 0x67, 0x65, 0x73, 0x4f, 0x75, 0x74, 0x12, 0x24, 0x0a, 0x08, 0x6d, 0x65, 0x73, 0x73,
-	0x61, 0x67, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x08, 0x2e, 0x4d, 0x65, 0x73,
-	0x73, 0x61, 0x67, 0x65, 0x52, 0x08, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x22, 0x6c,
-	0x0a, 0x16, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x50, 0x72, 0x69, 0x76, 0x61, 0x74, 0x65, 0x4d,
-	0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x49, 0x6e, 0x12, 0x1b, 0x0a, 0x09, 0x63, 0x68, 0x61, 0x74,
+	0x08, 0x63, 0x68, 0x61, 0x74, 0x55, 0x75, 0x69, 0x64, 0x12, 0x2c, 0x0a, 0x12, 0x65, 0x78, 0x70,
+	0x69, 0x72, 0x65, 0x73, 0x5f, 0x69, 0x6e, 0x5f, 0x73, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x10, 0x65, 0x78, 0x70, 0x69, 0x72, 0x65, 0x73, 0x49, 0x6e,
+	0x53, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73, 0x12, 0x1f, 0x0a, 0x0b, 0x75, 0x73, 0x61, 0x67, 0x65,
+	0x5f, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0a, 0x75, 0x73,
+	0x61, 0x67, 0x65, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x22, 0x36, 0x0a, 0x13, 0x43, 0x72, 0x65, 0x61,
+	0x74, 0x65, 0x49, 0x6e, 0x76, 0x69, 0x74, 0x65, 0x4c, 0x69, 0x6e, 0x6b, 0x4f, 0x75, 0x74, 0x12,
+	0x1f, 0x0a, 0x04, 0x6c, 0x69, 0x6e, 0x6b, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0b, 0x2e,
+	0x49, 0x6e, 0x76, 0x69, 0x74, 0x65, 0x4c, 0x69, 0x6e, 0x6b, 0x52, 0x04, 0x6c, 0x69, 0x6e, 0x6b,
+	0x22, 0x45, 0x0a, 0x12, 0x52, 0x65, 0x76, 0x6f, 0x6b, 0x65, 0x49, 0x6e, 0x76, 0x69, 0x74, 0x65,
+	0x4c, 0x69, 0x6e, 0x6b, 0x49, 0x6e, 0x12, 0x1b, 0x0a, 0x09, 0x63, 0x68, 0x61, 0x74, 0x5f, 0x75,
+	0x75, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x63, 0x68, 0x61, 0x74, 0x55,
+	0x75, 0x69, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x22, 0x3a, 0x0a, 0x13, 0x52, 0x65, 0x76, 0x6f, 0x6b,
+	0x65, 0x49, 0x6e, 0x76, 0x69, 0x74, 0x65, 0x4c, 0x69, 0x6e, 0x6b, 0x4f, 0x75, 0x74, 0x12, 0x23,
+	0x0a, 0x0d, 0x72, 0x65, 0x76, 0x6f, 0x6b, 0x65, 0x5f, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0c, 0x72, 0x65, 0x76, 0x6f, 0x6b, 0x65, 0x53, 0x74, 0x61,
+	0x74, 0x75, 0x73, 0x22, 0x30, 0x0a, 0x11, 0x4c, 0x69, 0x73, 0x74, 0x49, 0x6e, 0x76, 0x69, 0x74,
+	0x65, 0x4c, 0x69, 0x6e, 0x6b, 0x73, 0x49, 0x6e, 0x12, 0x1b, 0x0a, 0x09, 0x63, 0x68, 0x61, 0x74,
 	0x5f, 0x75, 0x75, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x63, 0x68, 0x61,
-	0x74, 0x55, 0x75, 0x69, 0x64, 0x12, 0x21, 0x0a, 0x0c, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65,
-	0x5f, 0x75, 0x75, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x6d, 0x65, 0x73,
-	0x73, 0x61, 0x67, 0x65, 0x55, 0x75, 0x69, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x6d, 0x6f, 0x64, 0x65,
-	0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6d, 0x6f, 0x64, 0x65, 0x22, 0x42, 0x0a, 0x17,
-	0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x50, 0x72, 0x69, 0x76, 0x61, 0x74, 0x65, 0x4d, 0x65, 0x73,
-	0x73, 0x61, 0x67, 0x65, 0x4f, 0x75, 0x74, 0x12, 0x27, 0x0a, 0x0f, 0x64, 0x65, 0x6c, 0x65, 0x74,
-	0x69, 0x6f, 0x6e, 0x5f, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08,
-	0x52, 0x0e, 0x64, 0x65, 0x6c, 0x65, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73,
-	0x22, 0x77, 0x0a, 0x14, 0x45, 0x64, 0x69, 0x74, 0x50, 0x72, 0x69, 0x76, 0x61, 0x74, 0x65, 0x4d,
-	0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x49, 0x6e, 0x12, 0x1b, 0x0a, 0x09, 0x63, 0x68, 0x61, 0x74,
+	0x74, 0x55, 0x75, 0x69, 0x64, 0x22, 0x37, 0x0a, 0x12, 0x4c, 0x69, 0x73, 0x74, 0x49, 0x6e, 0x76,
+	0x69, 0x74, 0x65, 0x4c, 0x69, 0x6e, 0x6b, 0x73, 0x4f, 0x75, 0x74, 0x12, 0x21, 0x0a, 0x05, 0x6c,
+	0x69, 0x6e, 0x6b, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0b, 0x2e, 0x49, 0x6e, 0x76,
+	0x69, 0x74, 0x65, 0x4c, 0x69, 0x6e, 0x6b, 0x52, 0x05, 0x6c, 0x69, 0x6e, 0x6b, 0x73, 0x22, 0x24,
+	0x0a, 0x0e, 0x4a, 0x6f, 0x69, 0x6e, 0x42, 0x79, 0x49, 0x6e, 0x76, 0x69, 0x74, 0x65, 0x49, 0x6e,
+	0x12, 0x12, 0x0a, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04,
+	0x63, 0x6f, 0x64, 0x65, 0x22, 0x46, 0x0a, 0x0f, 0x4a, 0x6f, 0x69, 0x6e, 0x42, 0x79, 0x49, 0x6e,
+	0x76, 0x69, 0x74, 0x65, 0x4f, 0x75, 0x74, 0x12, 0x1b, 0x0a, 0x09, 0x63, 0x68, 0x61, 0x74, 0x5f,
+	0x75, 0x75, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x63, 0x68, 0x61, 0x74,
+	0x55, 0x75, 0x69, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x6a, 0x6f, 0x69, 0x6e, 0x65, 0x64, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x6a, 0x6f, 0x69, 0x6e, 0x65, 0x64, 0x22, 0x44, 0x0a, 0x0f,
+	0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x49, 0x6e, 0x12,
+	0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e,
+	0x61, 0x6d, 0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x61, 0x76, 0x61, 0x74, 0x61, 0x72, 0x5f, 0x75, 0x72,
+	0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x61, 0x76, 0x61, 0x74, 0x61, 0x72, 0x55,
+	0x72, 0x6c, 0x22, 0x36, 0x0a, 0x10, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x43, 0x68, 0x61, 0x6e,
+	0x6e, 0x65, 0x6c, 0x4f, 0x75, 0x74, 0x12, 0x22, 0x0a, 0x0d, 0x6e, 0x65, 0x77, 0x5f, 0x63, 0x68,
+	0x61, 0x74, 0x5f, 0x75, 0x75, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x6e,
+	0x65, 0x77, 0x43, 0x68, 0x61, 0x74, 0x55, 0x75, 0x69, 0x64, 0x22, 0x31, 0x0a, 0x12, 0x53, 0x75,
+	0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x49, 0x6e,
+	0x12, 0x1b, 0x0a, 0x09, 0x63, 0x68, 0x61, 0x74, 0x5f, 0x75, 0x75, 0x69, 0x64, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x08, 0x63, 0x68, 0x61, 0x74, 0x55, 0x75, 0x69, 0x64, 0x22, 0x46, 0x0a,
+	0x13, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65,
+	0x6c, 0x4f, 0x75, 0x74, 0x12, 0x2f, 0x0a, 0x13, 0x73, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x70,
+	0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x08, 0x52, 0x12, 0x73, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x53,
+	0x74, 0x61, 0x74, 0x75, 0x73, 0x22, 0x33, 0x0a, 0x14, 0x55, 0x6e, 0x73, 0x75, 0x62, 0x73, 0x63,
+	0x72, 0x69, 0x62, 0x65, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x49, 0x6e, 0x12, 0x1b, 0x0a,
+	0x09, 0x63, 0x68, 0x61, 0x74, 0x5f, 0x75, 0x75, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x08, 0x63, 0x68, 0x61, 0x74, 0x55, 0x75, 0x69, 0x64, 0x22, 0x4c, 0x0a, 0x15, 0x55, 0x6e,
+	0x73, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c,
+	0x4f, 0x75, 0x74, 0x12, 0x33, 0x0a, 0x15, 0x75, 0x6e, 0x73, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69,
+	0x70, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x08, 0x52, 0x14, 0x75, 0x6e, 0x73, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69,
+	0x6f, 0x6e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x22, 0x4b, 0x0a, 0x12, 0x50, 0x75, 0x62, 0x6c,
+	0x69, 0x73, 0x68, 0x54, 0x6f, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x49, 0x6e, 0x12, 0x1b,
+	0x0a, 0x09, 0x63, 0x68, 0x61, 0x74, 0x5f, 0x75, 0x75, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x08, 0x63, 0x68, 0x61, 0x74, 0x55, 0x75, 0x69, 0x64, 0x12, 0x18, 0x0a, 0x07, 0x63,
+	0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x63, 0x6f,
+	0x6e, 0x74, 0x65, 0x6e, 0x74, 0x22, 0x39, 0x0a, 0x13, 0x50, 0x75, 0x62, 0x6c, 0x69, 0x73, 0x68,
+	0x54, 0x6f, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x4f, 0x75, 0x74, 0x12, 0x22, 0x0a, 0x07,
+	0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x08, 0x2e,
+	0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65,
+	0x22, 0x8e, 0x01, 0x0a, 0x0b, 0x42, 0x61, 0x6e, 0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x49, 0x6e,
+	0x12, 0x1b, 0x0a, 0x09, 0x63, 0x68, 0x61, 0x74, 0x5f, 0x75, 0x75, 0x69, 0x64, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x08, 0x63, 0x68, 0x61, 0x74, 0x55, 0x75, 0x69, 0x64, 0x12, 0x1f, 0x0a,
+	0x0b, 0x6d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x5f, 0x75, 0x75, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x0a, 0x6d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x55, 0x75, 0x69, 0x64, 0x12, 0x16,
+	0x0a, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06,
+	0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x12, 0x29, 0x0a, 0x10, 0x64, 0x75, 0x72, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x5f, 0x73, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03,
+	0x52, 0x0f, 0x64, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x65, 0x63, 0x6f, 0x6e, 0x64,
+	0x73, 0x22, 0x48, 0x0a, 0x0c, 0x42, 0x61, 0x6e, 0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x4f, 0x75,
+	0x74, 0x12, 0x1b, 0x0a, 0x09, 0x62, 0x61, 0x6e, 0x6e, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x62, 0x61, 0x6e, 0x6e, 0x65, 0x64, 0x41, 0x74, 0x12, 0x1b,
+	0x0a, 0x09, 0x62, 0x61, 0x6e, 0x5f, 0x75, 0x6e, 0x74, 0x69, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x08, 0x62, 0x61, 0x6e, 0x55, 0x6e, 0x74, 0x69, 0x6c, 0x22, 0x4d, 0x0a, 0x0d, 0x55,
+	0x6e, 0x62, 0x61, 0x6e, 0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x49, 0x6e, 0x12, 0x1b, 0x0a, 0x09,
+	0x63, 0x68, 0x61, 0x74, 0x5f, 0x75, 0x75, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x08, 0x63, 0x68, 0x61, 0x74, 0x55, 0x75, 0x69, 0x64, 0x12, 0x1f, 0x0a, 0x0b, 0x6d, 0x65, 0x6d,
+	0x62, 0x65, 0x72, 0x5f, 0x75, 0x75, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a,
+	0x6d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x55, 0x75, 0x69, 0x64, 0x22, 0x33, 0x0a, 0x0e, 0x55, 0x6e,
+	0x62, 0x61, 0x6e, 0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x4f, 0x75, 0x74, 0x12, 0x21, 0x0a, 0x0c,
+	0x75, 0x6e, 0x62, 0x61, 0x6e, 0x5f, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x08, 0x52, 0x0b, 0x75, 0x6e, 0x62, 0x61, 0x6e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x22,
+	0x4c, 0x0a, 0x0c, 0x4b, 0x69, 0x63, 0x6b, 0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x49, 0x6e, 0x12,
+	0x1b, 0x0a, 0x09, 0x63, 0x68, 0x61, 0x74, 0x5f, 0x75, 0x75, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x08, 0x63, 0x68, 0x61, 0x74, 0x55, 0x75, 0x69, 0x64, 0x12, 0x1f, 0x0a, 0x0b,
+	0x6d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x5f, 0x75, 0x75, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x0a, 0x6d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x55, 0x75, 0x69, 0x64, 0x22, 0x30, 0x0a,
+	0x0d, 0x4b, 0x69, 0x63, 0x6b, 0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x4f, 0x75, 0x74, 0x12, 0x1f,
+	0x0a, 0x0b, 0x6b, 0x69, 0x63, 0x6b, 0x5f, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x08, 0x52, 0x0a, 0x6b, 0x69, 0x63, 0x6b, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x22,
+	0x5a, 0x0a, 0x1a, 0x47, 0x65, 0x74, 0x4f, 0x72, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x43, 0x6f,
+	0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x49, 0x6e, 0x12, 0x1f, 0x0a,
+	0x0b, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x0a, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x54, 0x79, 0x70, 0x65, 0x12, 0x1b,
+	0x0a, 0x09, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x08, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x49, 0x64, 0x22, 0x54, 0x0a, 0x1b, 0x47,
+	0x65, 0x74, 0x4f, 0x72, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e,
+	0x74, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x4f, 0x75, 0x74, 0x12, 0x1b, 0x0a, 0x09, 0x63, 0x68,
+	0x61, 0x74, 0x5f, 0x75, 0x75, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x63,
+	0x68, 0x61, 0x74, 0x55, 0x75, 0x69, 0x64, 0x12, 0x18, 0x0a, 0x07, 0x63, 0x72, 0x65, 0x61, 0x74,
+	0x65, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65,
+	0x64, 0x22, 0x5a, 0x0a, 0x0d, 0x47, 0x65, 0x74, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x73,
+	0x49, 0x6e, 0x12, 0x1b, 0x0a, 0x09, 0x63, 0x68, 0x61, 0x74, 0x5f, 0x75, 0x75, 0x69, 0x64, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x63, 0x68, 0x61, 0x74, 0x55, 0x75, 0x69, 0x64, 0x12,
+	0x14, 0x0a, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05,
+	0x6c, 0x69, 0x6d, 0x69, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x63, 0x75, 0x72, 0x73, 0x6f, 0x72, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x63, 0x75, 0x72, 0x73, 0x6f, 0x72, 0x22, 0x57, 0x0a,
+	0x0e, 0x47, 0x65, 0x74, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x4f, 0x75, 0x74, 0x12,
+	0x24, 0x0a, 0x08, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x08, 0x2e, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x52, 0x08, 0x63, 0x6f, 0x6d,
+	0x6d, 0x65, 0x6e, 0x74, 0x73, 0x12, 0x1f, 0x0a, 0x0b, 0x6e, 0x65, 0x78, 0x74, 0x5f, 0x63, 0x75,
+	0x72, 0x73, 0x6f, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x6e, 0x65, 0x78, 0x74,
+	0x43, 0x75, 0x72, 0x73, 0x6f, 0x72, 0x22, 0x84, 0x01, 0x0a, 0x0d, 0x50, 0x6f, 0x73, 0x74, 0x43,
+	0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x49, 0x6e, 0x12, 0x1b, 0x0a, 0x09, 0x63, 0x68, 0x61, 0x74,
+	0x5f, 0x75, 0x75, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x63, 0x68, 0x61,
+	0x74, 0x55, 0x75, 0x69, 0x64, 0x12, 0x18, 0x0a, 0x07, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x12,
+	0x1b, 0x0a, 0x09, 0x72, 0x6f, 0x6f, 0x74, 0x5f, 0x75, 0x75, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x08, 0x72, 0x6f, 0x6f, 0x74, 0x55, 0x75, 0x69, 0x64, 0x12, 0x1f, 0x0a, 0x0b,
+	0x70, 0x61, 0x72, 0x65, 0x6e, 0x74, 0x5f, 0x75, 0x75, 0x69, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x0a, 0x70, 0x61, 0x72, 0x65, 0x6e, 0x74, 0x55, 0x75, 0x69, 0x64, 0x22, 0x34, 0x0a,
+	0x0e, 0x50, 0x6f, 0x73, 0x74, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x4f, 0x75, 0x74, 0x12,
+	0x22, 0x0a, 0x07, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x08, 0x2e, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x52, 0x07, 0x63, 0x6f, 0x6d, 0x6d,
+	0x65, 0x6e, 0x74, 0x22, 0x5e, 0x0a, 0x12, 0x4d, 0x61, 0x72, 0x6b, 0x4d, 0x65, 0x73, 0x73, 0x61,
+	0x67, 0x65, 0x73, 0x52, 0x65, 0x61, 0x64, 0x49, 0x6e, 0x12, 0x1b, 0x0a, 0x09, 0x63, 0x68, 0x61,
+	0x74, 0x5f, 0x75, 0x75, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x63, 0x68,
+	0x61, 0x74, 0x55, 0x75, 0x69, 0x64, 0x12, 0x2b, 0x0a, 0x12, 0x75, 0x70, 0x5f, 0x74, 0x6f, 0x5f,
+	0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x5f, 0x75, 0x75, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x0f, 0x75, 0x70, 0x54, 0x6f, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x55,
+	0x75, 0x69, 0x64, 0x22, 0x38, 0x0a, 0x13, 0x4d, 0x61, 0x72, 0x6b, 0x4d, 0x65, 0x73, 0x73, 0x61,
+	0x67, 0x65, 0x73, 0x52, 0x65, 0x61, 0x64, 0x4f, 0x75, 0x74, 0x12, 0x21, 0x0a, 0x0c, 0x6d, 0x61,
+	0x72, 0x6b, 0x65, 0x64, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03,
+	0x52, 0x0b, 0x6d, 0x61, 0x72, 0x6b, 0x65, 0x64, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x22, 0x55, 0x0a,
+	0x13, 0x47, 0x65, 0x74, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x52, 0x65, 0x61, 0x64, 0x65,
+	0x72, 0x73, 0x49, 0x6e, 0x12, 0x1b, 0x0a, 0x09, 0x63, 0x68, 0x61, 0x74, 0x5f, 0x75, 0x75, 0x69,
+	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x63, 0x68, 0x61, 0x74, 0x55, 0x75, 0x69,
+	0x64, 0x12, 0x21, 0x0a, 0x0c, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x5f, 0x75, 0x75, 0x69,
+	0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65,
+	0x55, 0x75, 0x69, 0x64, 0x22, 0x80, 0x01, 0x0a, 0x0d, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65,
+	0x52, 0x65, 0x61, 0x64, 0x65, 0x72, 0x12, 0x1b, 0x0a, 0x09, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x75,
+	0x75, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x75, 0x73, 0x65, 0x72, 0x55,
+	0x75, 0x69, 0x64, 0x12, 0x1a, 0x0a, 0x08, 0x6e, 0x69, 0x63, 0x6b, 0x6e, 0x61, 0x6d, 0x65, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x6e, 0x69, 0x63, 0x6b, 0x6e, 0x61, 0x6d, 0x65, 0x12,
+	0x1d, 0x0a, 0x0a, 0x61, 0x76, 0x61, 0x74, 0x61, 0x72, 0x5f, 0x75, 0x72, 0x6c, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x09, 0x61, 0x76, 0x61, 0x74, 0x61, 0x72, 0x55, 0x72, 0x6c, 0x12, 0x17,
+	0x0a, 0x07, 0x72, 0x65, 0x61, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x06, 0x72, 0x65, 0x61, 0x64, 0x41, 0x74, 0x22, 0x40, 0x0a, 0x14, 0x47, 0x65, 0x74, 0x4d, 0x65,
+	0x73, 0x73, 0x61, 0x67, 0x65, 0x52, 0x65, 0x61, 0x64, 0x65, 0x72, 0x73, 0x4f, 0x75, 0x74, 0x12,
+	0x28, 0x0a, 0x07, 0x72, 0x65, 0x61, 0x64, 0x65, 0x72, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x0e, 0x2e, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x52, 0x65, 0x61, 0x64, 0x65, 0x72,
+	0x52, 0x07, 0x72, 0x65, 0x61, 0x64, 0x65, 0x72, 0x73, 0x22, 0x65, 0x0a, 0x0d, 0x41, 0x64, 0x64,
+	0x52, 0x65, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x6e, 0x12, 0x1b, 0x0a, 0x09, 0x63, 0x68,
+	0x61, 0x74, 0x5f, 0x75, 0x75, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x63,
+	0x68, 0x61, 0x74, 0x55, 0x75, 0x69, 0x64, 0x12, 0x21, 0x0a, 0x0c, 0x6d, 0x65, 0x73, 0x73, 0x61,
+	0x67, 0x65, 0x5f, 0x75, 0x75, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x6d,
+	0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x55, 0x75, 0x69, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x6d,
+	0x6f, 0x6a, 0x69, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x65, 0x6d, 0x6f, 0x6a, 0x69,
+	0x22, 0x39, 0x0a, 0x0e, 0x41, 0x64, 0x64, 0x52, 0x65, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x4f,
+	0x75, 0x74, 0x12, 0x27, 0x0a, 0x09, 0x72, 0x65, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18,
+	0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x09, 0x2e, 0x52, 0x65, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e,
+	0x52, 0x09, 0x72, 0x65, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x22, 0x68, 0x0a, 0x10, 0x52,
+	0x65, 0x6d, 0x6f, 0x76, 0x65, 0x52, 0x65, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x6e, 0x12,
+	0x1b, 0x0a, 0x09, 0x63, 0x68, 0x61, 0x74, 0x5f, 0x75, 0x75, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x08, 0x63, 0x68, 0x61, 0x74, 0x55, 0x75, 0x69, 0x64, 0x12, 0x21, 0x0a, 0x0c,
+	0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x5f, 0x75, 0x75, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x0b, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x55, 0x75, 0x69, 0x64, 0x12,
+	0x14, 0x0a, 0x05, 0x65, 0x6d, 0x6f, 0x6a, 0x69, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05,
+	0x65, 0x6d, 0x6f, 0x6a, 0x69, 0x22, 0x3c, 0x0a, 0x11, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x52,
+	0x65, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x4f, 0x75, 0x74, 0x12, 0x27, 0x0a, 0x09, 0x72, 0x65,
+	0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x09, 0x2e,
+	0x52, 0x65, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x09, 0x72, 0x65, 0x61, 0x63, 0x74, 0x69,
+	0x6f, 0x6e, 0x73, 0x22, 0x4e, 0x0a, 0x0c, 0x50, 0x69, 0x6e, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67,
+	0x65, 0x49, 0x6e, 0x12, 0x1b, 0x0a, 0x09, 0x63, 0x68, 0x61, 0x74, 0x5f, 0x75, 0x75, 0x69, 0x64,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x63, 0x68, 0x61, 0x74, 0x55, 0x75, 0x69, 0x64,
+	0x12, 0x21, 0x0a, 0x0c, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x5f, 0x75, 0x75, 0x69, 0x64,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x55,
+	0x75, 0x69, 0x64, 0x22, 0x2e, 0x0a, 0x0d, 0x50, 0x69, 0x6e, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67,
+	0x65, 0x4f, 0x75, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x70, 0x69, 0x6e, 0x5f, 0x73, 0x74, 0x61, 0x74,
+	0x75, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x09, 0x70, 0x69, 0x6e, 0x53, 0x74, 0x61,
+	0x74, 0x75, 0x73, 0x22, 0x50, 0x0a, 0x0e, 0x55, 0x6e, 0x70, 0x69, 0x6e, 0x4d, 0x65, 0x73, 0x73,
+	0x61, 0x67, 0x65, 0x49, 0x6e, 0x12, 0x1b, 0x0a, 0x09, 0x63, 0x68, 0x61, 0x74, 0x5f, 0x75, 0x75,
+	0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x63, 0x68, 0x61, 0x74, 0x55, 0x75,
+	0x69, 0x64, 0x12, 0x21, 0x0a, 0x0c, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x5f, 0x75, 0x75,
+	0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67,
+	0x65, 0x55, 0x75, 0x69, 0x64, 0x22, 0x34, 0x0a, 0x0f, 0x55, 0x6e, 0x70, 0x69, 0x6e, 0x4d, 0x65,
+	0x73, 0x73, 0x61, 0x67, 0x65, 0x4f, 0x75, 0x74, 0x12, 0x21, 0x0a, 0x0c, 0x75, 0x6e, 0x70, 0x69,
+	0x6e, 0x5f, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0b,
+	0x75, 0x6e, 0x70, 0x69, 0x6e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x22, 0x33, 0x0a, 0x14, 0x4c,
+	0x69, 0x73, 0x74, 0x50, 0x69, 0x6e, 0x6e, 0x65, 0x64, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65,
+	0x73, 0x49, 0x6e, 0x12, 0x1b, 0x0a, 0x09, 0x63, 0x68, 0x61, 0x74, 0x5f, 0x75, 0x75, 0x69, 0x64,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x63, 0x68, 0x61, 0x74, 0x55, 0x75, 0x69, 0x64,
+	0x22, 0x6d, 0x0a, 0x0d, 0x50, 0x69, 0x6e, 0x6e, 0x65, 0x64, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67,
+	0x65, 0x12, 0x22, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x08, 0x2e, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x52, 0x07, 0x6d, 0x65,
+	0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x1b, 0x0a, 0x09, 0x70, 0x69, 0x6e, 0x6e, 0x65, 0x64, 0x5f,
+	0x62, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x70, 0x69, 0x6e, 0x6e, 0x65, 0x64,
+	0x42, 0x79, 0x12, 0x1b, 0x0a, 0x09, 0x70, 0x69, 0x6e, 0x6e, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x70, 0x69, 0x6e, 0x6e, 0x65, 0x64, 0x41, 0x74, 0x22,
+	0x50, 0x0a, 0x15, 0x4c, 0x69, 0x73, 0x74, 0x50, 0x69, 0x6e, 0x6e, 0x65, 0x64, 0x4d, 0x65, 0x73,
+	0x73, 0x61, 0x67, 0x65, 0x73, 0x4f, 0x75, 0x74, 0x12, 0x37, 0x0a, 0x0f, 0x70, 0x69, 0x6e, 0x6e,
+	0x65, 0x64, 0x5f, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x0e, 0x2e, 0x50, 0x69, 0x6e, 0x6e, 0x65, 0x64, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67,
+	0x65, 0x52, 0x0e, 0x70, 0x69, 0x6e, 0x6e, 0x65, 0x64, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65,
+	0x73, 0x22, 0x8c, 0x01, 0x0a, 0x11, 0x46, 0x6f, 0x72, 0x77, 0x61, 0x72, 0x64, 0x4d, 0x65, 0x73,
+	0x73, 0x61, 0x67, 0x65, 0x73, 0x49, 0x6e, 0x12, 0x28, 0x0a, 0x10, 0x73, 0x6f, 0x75, 0x72, 0x63,
+	0x65, 0x5f, 0x63, 0x68, 0x61, 0x74, 0x5f, 0x75, 0x75, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x0e, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x43, 0x68, 0x61, 0x74, 0x55, 0x75, 0x69,
+	0x64, 0x12, 0x23, 0x0a, 0x0d, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x5f, 0x75, 0x75, 0x69,
+	0x64, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0c, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67,
+	0x65, 0x55, 0x75, 0x69, 0x64, 0x73, 0x12, 0x28, 0x0a, 0x10, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74,
+	0x5f, 0x63, 0x68, 0x61, 0x74, 0x5f, 0x75, 0x75, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x0e, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x43, 0x68, 0x61, 0x74, 0x55, 0x75, 0x69, 0x64,
+	0x22, 0x3a, 0x0a, 0x12, 0x46, 0x6f, 0x72, 0x77, 0x61, 0x72, 0x64, 0x4d, 0x65, 0x73, 0x73, 0x61,
+	0x67, 0x65, 0x73, 0x4f, 0x75, 0x74, 0x12, 0x24, 0x0a, 0x08, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67,
+	0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x08, 0x2e, 0x4d, 0x65, 0x73, 0x73, 0x61,
+	0x67, 0x65, 0x52, 0x08, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x22, 0x73, 0x0a, 0x10,
+	0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x49, 0x6e,
+	0x12, 0x14, 0x0a, 0x05, 0x71, 0x75, 0x65, 0x72, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x05, 0x71, 0x75, 0x65, 0x72, 0x79, 0x12, 0x1b, 0x0a, 0x09, 0x63, 0x68, 0x61, 0x74, 0x5f, 0x75,
+	0x75, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x63, 0x68, 0x61, 0x74, 0x55,
+	0x75, 0x69, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x05, 0x52, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x63, 0x75, 0x72,
+	0x73, 0x6f, 0x72, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x63, 0x75, 0x72, 0x73, 0x6f,
+	0x72, 0x22, 0x69, 0x0a, 0x0c, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x52, 0x65, 0x73, 0x75, 0x6c,
+	0x74, 0x12, 0x22, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x08, 0x2e, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x52, 0x07, 0x6d, 0x65,
+	0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x1b, 0x0a, 0x09, 0x63, 0x68, 0x61, 0x74, 0x5f, 0x75, 0x75,
+	0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x63, 0x68, 0x61, 0x74, 0x55, 0x75,
+	0x69, 0x64, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x6e, 0x69, 0x70, 0x70, 0x65, 0x74, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x07, 0x73, 0x6e, 0x69, 0x70, 0x70, 0x65, 0x74, 0x22, 0x5d, 0x0a, 0x11,
+	0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x4f, 0x75,
+	0x74, 0x12, 0x27, 0x0a, 0x07, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x0d, 0x2e, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x52, 0x65, 0x73, 0x75, 0x6c,
+	0x74, 0x52, 0x07, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x73, 0x12, 0x1f, 0x0a, 0x0b, 0x6e, 0x65,
+	0x78, 0x74, 0x5f, 0x63, 0x75, 0x72, 0x73, 0x6f, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x0a, 0x6e, 0x65, 0x78, 0x74, 0x43, 0x75, 0x72, 0x73, 0x6f, 0x72, 0x22, 0x72, 0x0a, 0x0d, 0x4d,
+	0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x45, 0x64, 0x69, 0x74, 0x65, 0x64, 0x12, 0x21, 0x0a, 0x0c,
+	0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x5f, 0x75, 0x75, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x0b, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x55, 0x75, 0x69, 0x64, 0x12,
+	0x1f, 0x0a, 0x0b, 0x6e, 0x65, 0x77, 0x5f, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x6e, 0x65, 0x77, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74,
+	0x12, 0x1d, 0x0a, 0x0a, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x22,
+	0x47, 0x0a, 0x0e, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65,
+	0x64, 0x12, 0x21, 0x0a, 0x0c, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x5f, 0x75, 0x75, 0x69,
+	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65,
+	0x55, 0x75, 0x69, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x6d, 0x6f, 0x64, 0x65, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x04, 0x6d, 0x6f, 0x64, 0x65, 0x22, 0x57, 0x0a, 0x0b, 0x52, 0x65, 0x61, 0x64,
+	0x52, 0x65, 0x63, 0x65, 0x69, 0x70, 0x74, 0x12, 0x1b, 0x0a, 0x09, 0x75, 0x73, 0x65, 0x72, 0x5f,
+	0x75, 0x75, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x75, 0x73, 0x65, 0x72,
+	0x55, 0x75, 0x69, 0x64, 0x12, 0x2b, 0x0a, 0x12, 0x75, 0x70, 0x5f, 0x74, 0x6f, 0x5f, 0x6d, 0x65,
+	0x73, 0x73, 0x61, 0x67, 0x65, 0x5f, 0x75, 0x75, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x0f, 0x75, 0x70, 0x54, 0x6f, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x55, 0x75, 0x69,
+	0x64, 0x22, 0x48, 0x0a, 0x11, 0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x73, 0x68, 0x69, 0x70, 0x43,
+	0x68, 0x61, 0x6e, 0x67, 0x65, 0x64, 0x12, 0x1b, 0x0a, 0x09, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x75,
+	0x75, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x75, 0x73, 0x65, 0x72, 0x55,
+	0x75, 0x69, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x06, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x84, 0x03, 0x0a, 0x05,
+	0x45, 0x76, 0x65, 0x6e, 0x74, 0x12, 0x1b, 0x0a, 0x09, 0x63, 0x68, 0x61, 0x74, 0x5f, 0x75, 0x75,
+	0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x63, 0x68, 0x61, 0x74, 0x55, 0x75,
+	0x69, 0x64, 0x12, 0x1f, 0x0a, 0x0b, 0x6f, 0x63, 0x63, 0x75, 0x72, 0x72, 0x65, 0x64, 0x5f, 0x61,
+	0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x6f, 0x63, 0x63, 0x75, 0x72, 0x72, 0x65,
+	0x64, 0x41, 0x74, 0x12, 0x2d, 0x0a, 0x0c, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x5f, 0x73,
+	0x65, 0x6e, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x08, 0x2e, 0x4d, 0x65, 0x73, 0x73,
+	0x61, 0x67, 0x65, 0x48, 0x00, 0x52, 0x0b, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x53, 0x65,
+	0x6e, 0x74, 0x12, 0x37, 0x0a, 0x0e, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x5f, 0x65, 0x64,
+	0x69, 0x74, 0x65, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x4d, 0x65, 0x73,
+	0x73, 0x61, 0x67, 0x65, 0x45, 0x64, 0x69, 0x74, 0x65, 0x64, 0x48, 0x00, 0x52, 0x0d, 0x6d, 0x65,
+	0x73, 0x73, 0x61, 0x67, 0x65, 0x45, 0x64, 0x69, 0x74, 0x65, 0x64, 0x12, 0x3a, 0x0a, 0x0f, 0x6d,
+	0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x5f, 0x64, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x64, 0x18, 0x05,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x44, 0x65,
+	0x6c, 0x65, 0x74, 0x65, 0x64, 0x48, 0x00, 0x52, 0x0e, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65,
+	0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x64, 0x12, 0x31, 0x0a, 0x0c, 0x72, 0x65, 0x61, 0x64, 0x5f,
+	0x72, 0x65, 0x63, 0x65, 0x69, 0x70, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0c, 0x2e,
+	0x52, 0x65, 0x61, 0x64, 0x52, 0x65, 0x63, 0x65, 0x69, 0x70, 0x74, 0x48, 0x00, 0x52, 0x0b, 0x72,
+	0x65, 0x61, 0x64, 0x52, 0x65, 0x63, 0x65, 0x69, 0x70, 0x74, 0x12, 0x43, 0x0a, 0x12, 0x6d, 0x65,
+	0x6d, 0x62, 0x65, 0x72, 0x73, 0x68, 0x69, 0x70, 0x5f, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x64,
+	0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x73,
+	0x68, 0x69, 0x70, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x64, 0x48, 0x00, 0x52, 0x11, 0x6d, 0x65,
+	0x6d, 0x62, 0x65, 0x72, 0x73, 0x68, 0x69, 0x70, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x64, 0x12,
+	0x16, 0x0a, 0x06, 0x6e, 0x6f, 0x74, 0x69, 0x66, 0x79, 0x18, 0x08, 0x20, 0x01, 0x28, 0x08, 0x52,
+	0x06, 0x6e, 0x6f, 0x74, 0x69, 0x66, 0x79, 0x42, 0x09, 0x0a, 0x07, 0x70, 0x61, 0x79, 0x6c, 0x6f,
+	0x61, 0x64, 0x22, 0x6c, 0x0a, 0x0b, 0x43, 0x68, 0x61, 0x74, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65,
+	0x64, 0x12, 0x1b, 0x0a, 0x09, 0x63, 0x68, 0x61, 0x74, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x63, 0x68, 0x61, 0x74, 0x54, 0x79, 0x70, 0x65, 0x12, 0x1d,
+	0x0a, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x62, 0x79, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x42, 0x79, 0x12, 0x21, 0x0a,
+	0x0c, 0x6d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x5f, 0x75, 0x75, 0x69, 0x64, 0x73, 0x18, 0x03, 0x20,
+	0x03, 0x28, 0x09, 0x52, 0x0b, 0x6d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x55, 0x75, 0x69, 0x64, 0x73,
+	0x22, 0xc1, 0x02, 0x0a, 0x0f, 0x43, 0x68, 0x61, 0x74, 0x44, 0x6f, 0x6d, 0x61, 0x69, 0x6e, 0x45,
+	0x76, 0x65, 0x6e, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03,
+	0x52, 0x02, 0x69, 0x64, 0x12, 0x1b, 0x0a, 0x09, 0x63, 0x68, 0x61, 0x74, 0x5f, 0x75, 0x75, 0x69,
+	0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x63, 0x68, 0x61, 0x74, 0x55, 0x75, 0x69,
+	0x64, 0x12, 0x1f, 0x0a, 0x0b, 0x6f, 0x63, 0x63, 0x75, 0x72, 0x72, 0x65, 0x64, 0x5f, 0x61, 0x74,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x6f, 0x63, 0x63, 0x75, 0x72, 0x72, 0x65, 0x64,
+	0x41, 0x74, 0x12, 0x2d, 0x0a, 0x0c, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x5f, 0x73, 0x65,
+	0x6e, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x08, 0x2e, 0x4d, 0x65, 0x73, 0x73, 0x61,
+	0x67, 0x65, 0x48, 0x00, 0x52, 0x0b, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x53, 0x65, 0x6e,
+	0x74, 0x12, 0x37, 0x0a, 0x0e, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x5f, 0x65, 0x64, 0x69,
+	0x74, 0x65, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x4d, 0x65, 0x73, 0x73,
+	0x61, 0x67, 0x65, 0x45, 0x64, 0x69, 0x74, 0x65, 0x64, 0x48, 0x00, 0x52, 0x0d, 0x6d, 0x65, 0x73,
+	0x73, 0x61, 0x67, 0x65, 0x45, 0x64, 0x69, 0x74, 0x65, 0x64, 0x12, 0x3a, 0x0a, 0x0f, 0x6d, 0x65,
+	0x73, 0x73, 0x61, 0x67, 0x65, 0x5f, 0x64, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x64, 0x18, 0x06, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x44, 0x65, 0x6c,
+	0x65, 0x74, 0x65, 0x64, 0x48, 0x00, 0x52, 0x0e, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x44,
+	0x65, 0x6c, 0x65, 0x74, 0x65, 0x64, 0x12, 0x31, 0x0a, 0x0c, 0x63, 0x68, 0x61, 0x74, 0x5f, 0x63,
+	0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0c, 0x2e, 0x43,
+	0x68, 0x61, 0x74, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x48, 0x00, 0x52, 0x0b, 0x63, 0x68,
+	0x61, 0x74, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x42, 0x09, 0x0a, 0x07, 0x70, 0x61, 0x79,
+	0x6c, 0x6f, 0x61, 0x64, 0x22, 0xaa, 0x02, 0x0a, 0x15, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x63,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x65, 0x64, 0x12, 0x25,
+	0x0a, 0x0e, 0x72, 0x65, 0x63, 0x69, 0x70, 0x69, 0x65, 0x6e, 0x74, 0x5f, 0x75, 0x75, 0x69, 0x64,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x72, 0x65, 0x63, 0x69, 0x70, 0x69, 0x65, 0x6e,
+	0x74, 0x55, 0x75, 0x69, 0x64, 0x12, 0x1b, 0x0a, 0x09, 0x63, 0x68, 0x61, 0x74, 0x5f, 0x75, 0x75,
+	0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x63, 0x68, 0x61, 0x74, 0x55, 0x75,
+	0x69, 0x64, 0x12, 0x25, 0x0a, 0x0e, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x5f, 0x63,
+	0x6f, 0x75, 0x6e, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0d, 0x6d, 0x65, 0x73, 0x73,
+	0x61, 0x67, 0x65, 0x73, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x2a, 0x0a, 0x11, 0x6c, 0x61, 0x73,
+	0x74, 0x5f, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x5f, 0x75, 0x75, 0x69, 0x64, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x0f, 0x6c, 0x61, 0x73, 0x74, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67,
+	0x65, 0x55, 0x75, 0x69, 0x64, 0x12, 0x28, 0x0a, 0x10, 0x6c, 0x61, 0x73, 0x74, 0x5f, 0x73, 0x65,
+	0x6e, 0x64, 0x65, 0x72, 0x5f, 0x75, 0x75, 0x69, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x0e, 0x6c, 0x61, 0x73, 0x74, 0x53, 0x65, 0x6e, 0x64, 0x65, 0x72, 0x55, 0x75, 0x69, 0x64, 0x12,
+	0x28, 0x0a, 0x10, 0x66, 0x69, 0x72, 0x73, 0x74, 0x5f, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65,
+	0x5f, 0x61, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x66, 0x69, 0x72, 0x73, 0x74,
+	0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x41, 0x74, 0x12, 0x26, 0x0a, 0x0f, 0x6c, 0x61, 0x73,
+	0x74, 0x5f, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x5f, 0x61, 0x74, 0x18, 0x07, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x0d, 0x6c, 0x61, 0x73, 0x74, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x41,
+	0x74, 0x22, 0x42, 0x0a, 0x0b, 0x53, 0x65, 0x74, 0x54, 0x79, 0x70, 0x69, 0x6e, 0x67, 0x49, 0x6e,
+	0x12, 0x1b, 0x0a, 0x09, 0x63, 0x68, 0x61, 0x74, 0x5f, 0x75, 0x75, 0x69, 0x64, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x08, 0x63, 0x68, 0x61, 0x74, 0x55, 0x75, 0x69, 0x64, 0x12, 0x16, 0x0a,
+	0x06, 0x74, 0x79, 0x70, 0x69, 0x6e, 0x67, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x74,
+	0x79, 0x70, 0x69, 0x6e, 0x67, 0x22, 0x2a, 0x0a, 0x0c, 0x53, 0x65, 0x74, 0x54, 0x79, 0x70, 0x69,
+	0x6e, 0x67, 0x4f, 0x75, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x61, 0x63, 0x63, 0x65, 0x70, 0x74, 0x65,
+	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x61, 0x63, 0x63, 0x65, 0x70, 0x74, 0x65,
+	0x64, 0x22, 0x2c, 0x0a, 0x0d, 0x57, 0x61, 0x74, 0x63, 0x68, 0x54, 0x79, 0x70, 0x69, 0x6e, 0x67,
+	0x49, 0x6e, 0x12, 0x1b, 0x0a, 0x09, 0x63, 0x68, 0x61, 0x74, 0x5f, 0x75, 0x75, 0x69, 0x64, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x63, 0x68, 0x61, 0x74, 0x55, 0x75, 0x69, 0x64, 0x22,
+	0x60, 0x0a, 0x0c, 0x54, 0x79, 0x70, 0x69, 0x6e, 0x67, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x12,
+	0x1b, 0x0a, 0x09, 0x63, 0x68, 0x61, 0x74, 0x5f, 0x75, 0x75, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x08, 0x63, 0x68, 0x61, 0x74, 0x55, 0x75, 0x69, 0x64, 0x12, 0x1b, 0x0a, 0x09,
+	0x75, 0x73, 0x65, 0x72, 0x5f, 0x75, 0x75, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x08, 0x75, 0x73, 0x65, 0x72, 0x55, 0x75, 0x69, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x74, 0x79, 0x70,
+	0x69, 0x6e, 0x67, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x74, 0x79, 0x70, 0x69, 0x6e,
+	0x67, 0x22, 0x37, 0x0a, 0x0c, 0x48, 0x65, 0x61, 0x72, 0x74, 0x62, 0x65, 0x61, 0x74, 0x4f, 0x75,
+	0x74, 0x12, 0x27, 0x0a, 0x0f, 0x74, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x5f, 0x73, 0x65, 0x63,
+	0x6f, 0x6e, 0x64, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0e, 0x74, 0x69, 0x6d, 0x65,
+	0x6f, 0x75, 0x74, 0x53, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73, 0x22, 0x2e, 0x0a, 0x0d, 0x47, 0x65,
+	0x74, 0x50, 0x72, 0x65, 0x73, 0x65, 0x6e, 0x63, 0x65, 0x49, 0x6e, 0x12, 0x1d, 0x0a, 0x0a, 0x75,
+	0x73, 0x65, 0x72, 0x5f, 0x75, 0x75, 0x69, 0x64, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52,
+	0x09, 0x75, 0x73, 0x65, 0x72, 0x55, 0x75, 0x69, 0x64, 0x73, 0x22, 0x60, 0x0a, 0x08, 0x50, 0x72,
+	0x65, 0x73, 0x65, 0x6e, 0x63, 0x65, 0x12, 0x1b, 0x0a, 0x09, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x75,
+	0x75, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x75, 0x73, 0x65, 0x72, 0x55,
+	0x75, 0x69, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x6f, 0x6e, 0x6c, 0x69, 0x6e, 0x65, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x08, 0x52, 0x06, 0x6f, 0x6e, 0x6c, 0x69, 0x6e, 0x65, 0x12, 0x1f, 0x0a, 0x0b, 0x6c,
+	0x61, 0x73, 0x74, 0x5f, 0x6f, 0x6e, 0x6c, 0x69, 0x6e, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x0a, 0x6c, 0x61, 0x73, 0x74, 0x4f, 0x6e, 0x6c, 0x69, 0x6e, 0x65, 0x22, 0x39, 0x0a, 0x0e,
+	0x47, 0x65, 0x74, 0x50, 0x72, 0x65, 0x73, 0x65, 0x6e, 0x63, 0x65, 0x4f, 0x75, 0x74, 0x12, 0x27,
+	0x0a, 0x09, 0x70, 0x72, 0x65, 0x73, 0x65, 0x6e, 0x63, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x09, 0x2e, 0x50, 0x72, 0x65, 0x73, 0x65, 0x6e, 0x63, 0x65, 0x52, 0x09, 0x70, 0x72,
+	0x65, 0x73, 0x65, 0x6e, 0x63, 0x65, 0x73, 0x22, 0x91, 0x01, 0x0a, 0x16, 0x53, 0x65, 0x74, 0x43,
+	0x68, 0x61, 0x74, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73,
+	0x49, 0x6e, 0x12, 0x1b, 0x0a, 0x09, 0x63, 0x68, 0x61, 0x74, 0x5f, 0x75, 0x75, 0x69, 0x64, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x63, 0x68, 0x61, 0x74, 0x55, 0x75, 0x69, 0x64, 0x12,
+	0x14, 0x0a, 0x05, 0x6d, 0x75, 0x74, 0x65, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x05,
+	0x6d, 0x75, 0x74, 0x65, 0x64, 0x12, 0x1f, 0x0a, 0x0b, 0x6d, 0x75, 0x74, 0x65, 0x64, 0x5f, 0x75,
+	0x6e, 0x74, 0x69, 0x6c, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x6d, 0x75, 0x74, 0x65,
+	0x64, 0x55, 0x6e, 0x74, 0x69, 0x6c, 0x12, 0x23, 0x0a, 0x0d, 0x6d, 0x65, 0x6e, 0x74, 0x69, 0x6f,
+	0x6e, 0x73, 0x5f, 0x6f, 0x6e, 0x6c, 0x79, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0c, 0x6d,
+	0x65, 0x6e, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x4f, 0x6e, 0x6c, 0x79, 0x22, 0x75, 0x0a, 0x17, 0x53,
+	0x65, 0x74, 0x43, 0x68, 0x61, 0x74, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x73, 0x4f, 0x75, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x6d, 0x75, 0x74, 0x65, 0x64, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x05, 0x6d, 0x75, 0x74, 0x65, 0x64, 0x12, 0x1f, 0x0a, 0x0b,
+	0x6d, 0x75, 0x74, 0x65, 0x64, 0x5f, 0x75, 0x6e, 0x74, 0x69, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x0a, 0x6d, 0x75, 0x74, 0x65, 0x64, 0x55, 0x6e, 0x74, 0x69, 0x6c, 0x12, 0x23, 0x0a,
+	0x0d, 0x6d, 0x65, 0x6e, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x5f, 0x6f, 0x6e, 0x6c, 0x79, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x08, 0x52, 0x0c, 0x6d, 0x65, 0x6e, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x4f, 0x6e,
+	0x6c, 0x79, 0x22, 0x2a, 0x0a, 0x0b, 0x4c, 0x65, 0x61, 0x76, 0x65, 0x43, 0x68, 0x61, 0x74, 0x49,
+	0x6e, 0x12, 0x1b, 0x0a, 0x09, 0x63, 0x68, 0x61, 0x74, 0x5f, 0x75, 0x75, 0x69, 0x64, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x63, 0x68, 0x61, 0x74, 0x55, 0x75, 0x69, 0x64, 0x22, 0x31,
+	0x0a, 0x0c, 0x4c, 0x65, 0x61, 0x76, 0x65, 0x43, 0x68, 0x61, 0x74, 0x4f, 0x75, 0x74, 0x12, 0x21,
+	0x0a, 0x0c, 0x6c, 0x65, 0x61, 0x76, 0x65, 0x5f, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x08, 0x52, 0x0b, 0x6c, 0x65, 0x61, 0x76, 0x65, 0x53, 0x74, 0x61, 0x74, 0x75,
+	0x73, 0x22, 0xb1, 0x04, 0x0a, 0x04, 0x43, 0x68, 0x61, 0x74, 0x12, 0x21, 0x0a, 0x0c, 0x6c, 0x61,
+	0x73, 0x74, 0x5f, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x0b, 0x6c, 0x61, 0x73, 0x74, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x1b, 0x0a,
+	0x09, 0x63, 0x68, 0x61, 0x74, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x08, 0x63, 0x68, 0x61, 0x74, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x61, 0x76,
+	0x61, 0x74, 0x61, 0x72, 0x5f, 0x75, 0x72, 0x6c, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09,
+	0x61, 0x76, 0x61, 0x74, 0x61, 0x72, 0x55, 0x72, 0x6c, 0x12, 0x34, 0x0a, 0x16, 0x6c, 0x61, 0x73,
+	0x74, 0x5f, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74,
+	0x61, 0x6d, 0x70, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x14, 0x6c, 0x61, 0x73, 0x74, 0x4d,
+	0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x12,
+	0x1b, 0x0a, 0x09, 0x63, 0x68, 0x61, 0x74, 0x5f, 0x75, 0x75, 0x69, 0x64, 0x18, 0x05, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x08, 0x63, 0x68, 0x61, 0x74, 0x55, 0x75, 0x69, 0x64, 0x12, 0x1b, 0x0a, 0x09,
+	0x63, 0x68, 0x61, 0x74, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x08, 0x63, 0x68, 0x61, 0x74, 0x54, 0x79, 0x70, 0x65, 0x12, 0x21, 0x0a, 0x0c, 0x75, 0x6e, 0x72,
+	0x65, 0x61, 0x64, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x07, 0x20, 0x01, 0x28, 0x03, 0x52,
+	0x0b, 0x75, 0x6e, 0x72, 0x65, 0x61, 0x64, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x25, 0x0a, 0x0e,
+	0x70, 0x69, 0x6e, 0x6e, 0x65, 0x64, 0x5f, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x08,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x70, 0x69, 0x6e, 0x6e, 0x65, 0x64, 0x4d, 0x65, 0x73, 0x73,
+	0x61, 0x67, 0x65, 0x12, 0x2e, 0x0a, 0x13, 0x70, 0x69, 0x6e, 0x6e, 0x65, 0x64, 0x5f, 0x6d, 0x65,
+	0x73, 0x73, 0x61, 0x67, 0x65, 0x5f, 0x75, 0x75, 0x69, 0x64, 0x18, 0x09, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x11, 0x70, 0x69, 0x6e, 0x6e, 0x65, 0x64, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x55,
+	0x75, 0x69, 0x64, 0x12, 0x25, 0x0a, 0x0e, 0x63, 0x6f, 0x6d, 0x70, 0x61, 0x6e, 0x69, 0x6f, 0x6e,
+	0x5f, 0x75, 0x75, 0x69, 0x64, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x63, 0x6f, 0x6d,
+	0x70, 0x61, 0x6e, 0x69, 0x6f, 0x6e, 0x55, 0x75, 0x69, 0x64, 0x12, 0x29, 0x0a, 0x10, 0x63, 0x6f,
+	0x6d, 0x70, 0x61, 0x6e, 0x69, 0x6f, 0x6e, 0x5f, 0x6f, 0x6e, 0x6c, 0x69, 0x6e, 0x65, 0x18, 0x0b,
+	0x20, 0x01, 0x28, 0x08, 0x52, 0x0f, 0x63, 0x6f, 0x6d, 0x70, 0x61, 0x6e, 0x69, 0x6f, 0x6e, 0x4f,
+	0x6e, 0x6c, 0x69, 0x6e, 0x65, 0x12, 0x32, 0x0a, 0x15, 0x63, 0x6f, 0x6d, 0x70, 0x61, 0x6e, 0x69,
+	0x6f, 0x6e, 0x5f, 0x6c, 0x61, 0x73, 0x74, 0x5f, 0x6f, 0x6e, 0x6c, 0x69, 0x6e, 0x65, 0x18, 0x0c,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x13, 0x63, 0x6f, 0x6d, 0x70, 0x61, 0x6e, 0x69, 0x6f, 0x6e, 0x4c,
+	0x61, 0x73, 0x74, 0x4f, 0x6e, 0x6c, 0x69, 0x6e, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x6d, 0x75, 0x74,
+	0x65, 0x64, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x08, 0x52, 0x05, 0x6d, 0x75, 0x74, 0x65, 0x64, 0x12,
+	0x1f, 0x0a, 0x0b, 0x6d, 0x75, 0x74, 0x65, 0x64, 0x5f, 0x75, 0x6e, 0x74, 0x69, 0x6c, 0x18, 0x0e,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x6d, 0x75, 0x74, 0x65, 0x64, 0x55, 0x6e, 0x74, 0x69, 0x6c,
+	0x12, 0x23, 0x0a, 0x0d, 0x6d, 0x65, 0x6e, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x5f, 0x6f, 0x6e, 0x6c,
+	0x79, 0x18, 0x0f, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0c, 0x6d, 0x65, 0x6e, 0x74, 0x69, 0x6f, 0x6e,
+	0x73, 0x4f, 0x6e, 0x6c, 0x79, 0x22, 0x2a, 0x0a, 0x0b, 0x47, 0x65, 0x74, 0x43, 0x68, 0x61, 0x74,
+	0x73, 0x4f, 0x75, 0x74, 0x12, 0x1b, 0x0a, 0x05, 0x63, 0x68, 0x61, 0x74, 0x73, 0x18, 0x01, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x05, 0x2e, 0x43, 0x68, 0x61, 0x74, 0x52, 0x05, 0x63, 0x68, 0x61, 0x74,
+	0x73, 0x22, 0x89, 0x03, 0x0a, 0x07, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x12, 0x0a,
+	0x04, 0x75, 0x75, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x75, 0x75, 0x69,
+	0x64, 0x12, 0x18, 0x0a, 0x07, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x07, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x12, 0x17, 0x0a, 0x07, 0x73,
+	0x65, 0x6e, 0x74, 0x5f, 0x61, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x65,
+	0x6e, 0x74, 0x41, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x5f,
+	0x61, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65,
+	0x64, 0x41, 0x74, 0x12, 0x1b, 0x0a, 0x09, 0x72, 0x6f, 0x6f, 0x74, 0x5f, 0x75, 0x75, 0x69, 0x64,
+	0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x72, 0x6f, 0x6f, 0x74, 0x55, 0x75, 0x69, 0x64,
+	0x12, 0x1f, 0x0a, 0x0b, 0x70, 0x61, 0x72, 0x65, 0x6e, 0x74, 0x5f, 0x75, 0x75, 0x69, 0x64, 0x18,
+	0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x70, 0x61, 0x72, 0x65, 0x6e, 0x74, 0x55, 0x75, 0x69,
+	0x64, 0x12, 0x21, 0x0a, 0x0c, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x5f, 0x75, 0x75, 0x69,
+	0x64, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65,
+	0x55, 0x75, 0x69, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x08, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x2b, 0x0a, 0x0a, 0x61, 0x74, 0x74, 0x61,
+	0x63, 0x68, 0x6d, 0x65, 0x6e, 0x74, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0b, 0x2e, 0x41,
+	0x74, 0x74, 0x61, 0x63, 0x68, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x0a, 0x61, 0x74, 0x74, 0x61, 0x63,
+	0x68, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x65, 0x64, 0x69, 0x74, 0x65, 0x64, 0x18,
+	0x0a, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x65, 0x64, 0x69, 0x74, 0x65, 0x64, 0x12, 0x27, 0x0a,
+	0x09, 0x72, 0x65, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x0b, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x09, 0x2e, 0x52, 0x65, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x09, 0x72, 0x65, 0x61,
+	0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x35, 0x0a, 0x0e, 0x66, 0x6f, 0x72, 0x77, 0x61, 0x72,
+	0x64, 0x65, 0x64, 0x5f, 0x66, 0x72, 0x6f, 0x6d, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0e,
+	0x2e, 0x46, 0x6f, 0x72, 0x77, 0x61, 0x72, 0x64, 0x65, 0x64, 0x46, 0x72, 0x6f, 0x6d, 0x52, 0x0d,
+	0x66, 0x6f, 0x72, 0x77, 0x61, 0x72, 0x64, 0x65, 0x64, 0x46, 0x72, 0x6f, 0x6d, 0x22, 0x70, 0x0a,
+	0x0d, 0x46, 0x6f, 0x72, 0x77, 0x61, 0x72, 0x64, 0x65, 0x64, 0x46, 0x72, 0x6f, 0x6d, 0x12, 0x1f,
+	0x0a, 0x0b, 0x73, 0x65, 0x6e, 0x64, 0x65, 0x72, 0x5f, 0x75, 0x75, 0x69, 0x64, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x0a, 0x73, 0x65, 0x6e, 0x64, 0x65, 0x72, 0x55, 0x75, 0x69, 0x64, 0x12,
+	0x1b, 0x0a, 0x09, 0x63, 0x68, 0x61, 0x74, 0x5f, 0x75, 0x75, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x08, 0x63, 0x68, 0x61, 0x74, 0x55, 0x75, 0x69, 0x64, 0x12, 0x21, 0x0a, 0x0c,
+	0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x5f, 0x75, 0x75, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x0b, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x55, 0x75, 0x69, 0x64, 0x22,
+	0x5a, 0x0a, 0x08, 0x52, 0x65, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x14, 0x0a, 0x05, 0x65,
+	0x6d, 0x6f, 0x6a, 0x69, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x65, 0x6d, 0x6f, 0x6a,
+	0x69, 0x12, 0x14, 0x0a, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03,
+	0x52, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x22, 0x0a, 0x0d, 0x72, 0x65, 0x61, 0x63, 0x74,
+	0x65, 0x64, 0x5f, 0x62, 0x79, 0x5f, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0b,
+	0x72, 0x65, 0x61, 0x63, 0x74, 0x65, 0x64, 0x42, 0x79, 0x4d, 0x65, 0x22, 0x7a, 0x0a, 0x09, 0x4d,
+	0x65, 0x64, 0x69, 0x61, 0x46, 0x69, 0x6c, 0x65, 0x12, 0x1b, 0x0a, 0x09, 0x66, 0x69, 0x6c, 0x65,
+	0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x66, 0x69, 0x6c,
+	0x65, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x1b, 0x0a, 0x09, 0x6d, 0x69, 0x6d, 0x65, 0x5f, 0x74, 0x79,
+	0x70, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x6d, 0x69, 0x6d, 0x65, 0x54, 0x79,
+	0x70, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03,
+	0x52, 0x04, 0x73, 0x69, 0x7a, 0x65, 0x12, 0x1f, 0x0a, 0x0b, 0x73, 0x74, 0x6f, 0x72, 0x61, 0x67,
+	0x65, 0x5f, 0x6b, 0x65, 0x79, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x73, 0x74, 0x6f,
+	0x72, 0x61, 0x67, 0x65, 0x4b, 0x65, 0x79, 0x22, 0x5f, 0x0a, 0x0f, 0x49, 0x6d, 0x61, 0x67, 0x65,
+	0x41, 0x74, 0x74, 0x61, 0x63, 0x68, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x1e, 0x0a, 0x04, 0x66, 0x69,
+	0x6c, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0a, 0x2e, 0x4d, 0x65, 0x64, 0x69, 0x61,
+	0x46, 0x69, 0x6c, 0x65, 0x52, 0x04, 0x66, 0x69, 0x6c, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x77, 0x69,
+	0x64, 0x74, 0x68, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x77, 0x69, 0x64, 0x74, 0x68,
+	0x12, 0x16, 0x0a, 0x06, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05,
+	0x52, 0x06, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x22, 0x80, 0x01, 0x0a, 0x0f, 0x56, 0x69, 0x64,
+	0x65, 0x6f, 0x41, 0x74, 0x74, 0x61, 0x63, 0x68, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x1e, 0x0a, 0x04,
+	0x66, 0x69, 0x6c, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0a, 0x2e, 0x4d, 0x65, 0x64,
+	0x69, 0x61, 0x46, 0x69, 0x6c, 0x65, 0x52, 0x04, 0x66, 0x69, 0x6c, 0x65, 0x12, 0x14, 0x0a, 0x05,
+	0x77, 0x69, 0x64, 0x74, 0x68, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x77, 0x69, 0x64,
+	0x74, 0x68, 0x12, 0x16, 0x0a, 0x06, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x05, 0x52, 0x06, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x12, 0x1f, 0x0a, 0x0b, 0x64, 0x75,
+	0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x6d, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x52,
+	0x0a, 0x64, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x4d, 0x73, 0x22, 0x30, 0x0a, 0x0e, 0x46,
+	0x69, 0x6c, 0x65, 0x41, 0x74, 0x74, 0x61, 0x63, 0x68, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x1e, 0x0a,
+	0x04, 0x66, 0x69, 0x6c, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0a, 0x2e, 0x4d, 0x65,
+	0x64, 0x69, 0x61, 0x46, 0x69, 0x6c, 0x65, 0x52, 0x04, 0x66, 0x69, 0x6c, 0x65, 0x22, 0x53, 0x0a,
+	0x10, 0x53, 0x70, 0x65, 0x65, 0x63, 0x68, 0x41, 0x74, 0x74, 0x61, 0x63, 0x68, 0x6d, 0x65, 0x6e,
+	0x74, 0x12, 0x1e, 0x0a, 0x04, 0x66, 0x69, 0x6c, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x0a, 0x2e, 0x4d, 0x65, 0x64, 0x69, 0x61, 0x46, 0x69, 0x6c, 0x65, 0x52, 0x04, 0x66, 0x69, 0x6c,
+	0x65, 0x12, 0x1f, 0x0a, 0x0b, 0x64, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x6d, 0x73,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0a, 0x64, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x4d, 0x73, 0x22, 0x6f, 0x0a, 0x10, 0x43, 0x69, 0x72, 0x63, 0x6c, 0x65, 0x41, 0x74, 0x74, 0x61,
+	0x63, 0x68, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x1e, 0x0a, 0x04, 0x66, 0x69, 0x6c, 0x65, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x0a, 0x2e, 0x4d, 0x65, 0x64, 0x69, 0x61, 0x46, 0x69, 0x6c, 0x65,
+	0x52, 0x04, 0x66, 0x69, 0x6c, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x64, 0x69, 0x61, 0x6d, 0x65, 0x74,
+	0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x64, 0x69, 0x61, 0x6d, 0x65, 0x74,
+	0x65, 0x72, 0x12, 0x1f, 0x0a, 0x0b, 0x64, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x6d,
+	0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0a, 0x64, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x4d, 0x73, 0x22, 0xec, 0x01, 0x0a, 0x0a, 0x41, 0x74, 0x74, 0x61, 0x63, 0x68, 0x6d, 0x65,
+	0x6e, 0x74, 0x12, 0x28, 0x0a, 0x05, 0x69, 0x6d, 0x61, 0x67, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x10, 0x2e, 0x49, 0x6d, 0x61, 0x67, 0x65, 0x41, 0x74, 0x74, 0x61, 0x63, 0x68, 0x6d,
+	0x65, 0x6e, 0x74, 0x48, 0x00, 0x52, 0x05, 0x69, 0x6d, 0x61, 0x67, 0x65, 0x12, 0x28, 0x0a, 0x05,
+	0x76, 0x69, 0x64, 0x65, 0x6f, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x56, 0x69,
+	0x64, 0x65, 0x6f, 0x41, 0x74, 0x74, 0x61, 0x63, 0x68, 0x6d, 0x65, 0x6e, 0x74, 0x48, 0x00, 0x52,
+	0x05, 0x76, 0x69, 0x64, 0x65, 0x6f, 0x12, 0x25, 0x0a, 0x04, 0x66, 0x69, 0x6c, 0x65, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x46, 0x69, 0x6c, 0x65, 0x41, 0x74, 0x74, 0x61, 0x63,
+	0x68, 0x6d, 0x65, 0x6e, 0x74, 0x48, 0x00, 0x52, 0x04, 0x66, 0x69, 0x6c, 0x65, 0x12, 0x2b, 0x0a,
+	0x06, 0x73, 0x70, 0x65, 0x65, 0x63, 0x68, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e,
+	0x53, 0x70, 0x65, 0x65, 0x63, 0x68, 0x41, 0x74, 0x74, 0x61, 0x63, 0x68, 0x6d, 0x65, 0x6e, 0x74,
+	0x48, 0x00, 0x52, 0x06, 0x73, 0x70, 0x65, 0x65, 0x63, 0x68, 0x12, 0x2b, 0x0a, 0x06, 0x63, 0x69,
+	0x72, 0x63, 0x6c, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x43, 0x69, 0x72,
+	0x63, 0x6c, 0x65, 0x41, 0x74, 0x74, 0x61, 0x63, 0x68, 0x6d, 0x65, 0x6e, 0x74, 0x48, 0x00, 0x52,
+	0x06, 0x63, 0x69, 0x72, 0x63, 0x6c, 0x65, 0x42, 0x09, 0x0a, 0x07, 0x70, 0x61, 0x79, 0x6c, 0x6f,
+	0x61, 0x64, 0x22, 0x95, 0x01, 0x0a, 0x1a, 0x47, 0x65, 0x74, 0x50, 0x72, 0x69, 0x76, 0x61, 0x74,
+	0x65, 0x52, 0x65, 0x63, 0x65, 0x6e, 0x74, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x49,
+	0x6e, 0x12, 0x1b, 0x0a, 0x09, 0x63, 0x68, 0x61, 0x74, 0x5f, 0x75, 0x75, 0x69, 0x64, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x63, 0x68, 0x61, 0x74, 0x55, 0x75, 0x69, 0x64, 0x12, 0x14,
+	0x0a, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x6c,
+	0x69, 0x6d, 0x69, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x63, 0x75, 0x72, 0x73, 0x6f, 0x72, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x63, 0x75, 0x72, 0x73, 0x6f, 0x72, 0x12, 0x2c, 0x0a, 0x12,
+	0x61, 0x66, 0x74, 0x65, 0x72, 0x5f, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x5f, 0x75, 0x75,
+	0x69, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x10, 0x61, 0x66, 0x74, 0x65, 0x72, 0x4d,
+	0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x55, 0x75, 0x69, 0x64, 0x22, 0x64, 0x0a, 0x1b, 0x47, 0x65,
+	0x74, 0x50, 0x72, 0x69, 0x76, 0x61, 0x74, 0x65, 0x52, 0x65, 0x63, 0x65, 0x6e, 0x74, 0x4d, 0x65,
+	0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x4f, 0x75, 0x74, 0x12, 0x24, 0x0a, 0x08, 0x6d, 0x65, 0x73,
+	0x73, 0x61, 0x67, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x08, 0x2e, 0x4d, 0x65,
+	0x73, 0x73, 0x61, 0x67, 0x65, 0x52, 0x08, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x12,
+	0x1f, 0x0a, 0x0b, 0x6e, 0x65, 0x78, 0x74, 0x5f, 0x63, 0x75, 0x72, 0x73, 0x6f, 0x72, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x6e, 0x65, 0x78, 0x74, 0x43, 0x75, 0x72, 0x73, 0x6f, 0x72,
+	0x22, 0xb8, 0x01, 0x0a, 0x14, 0x53, 0x65, 0x6e, 0x64, 0x50, 0x72, 0x69, 0x76, 0x61, 0x74, 0x65,
+	0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x49, 0x6e, 0x12, 0x1b, 0x0a, 0x09, 0x63, 0x68, 0x61,
+	0x74, 0x5f, 0x75, 0x75, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x63, 0x68,
+	0x61, 0x74, 0x55, 0x75, 0x69, 0x64, 0x12, 0x18, 0x0a, 0x07, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e,
+	0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74,
+	0x12, 0x1b, 0x0a, 0x09, 0x72, 0x6f, 0x6f, 0x74, 0x5f, 0x75, 0x75, 0x69, 0x64, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x08, 0x72, 0x6f, 0x6f, 0x74, 0x55, 0x75, 0x69, 0x64, 0x12, 0x1f, 0x0a,
+	0x0b, 0x70, 0x61, 0x72, 0x65, 0x6e, 0x74, 0x5f, 0x75, 0x75, 0x69, 0x64, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x0a, 0x70, 0x61, 0x72, 0x65, 0x6e, 0x74, 0x55, 0x75, 0x69, 0x64, 0x12, 0x2b,
+	0x0a, 0x0a, 0x61, 0x74, 0x74, 0x61, 0x63, 0x68, 0x6d, 0x65, 0x6e, 0x74, 0x18, 0x05, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x0b, 0x2e, 0x41, 0x74, 0x74, 0x61, 0x63, 0x68, 0x6d, 0x65, 0x6e, 0x74, 0x52,
+	0x0a, 0x61, 0x74, 0x74, 0x61, 0x63, 0x68, 0x6d, 0x65, 0x6e, 0x74, 0x22, 0x3b, 0x0a, 0x15, 0x53,
+	0x65, 0x6e, 0x64, 0x50, 0x72, 0x69, 0x76, 0x61, 0x74, 0x65, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67,
+	0x65, 0x4f, 0x75, 0x74, 0x12, 0x22, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x08, 0x2e, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x52,
+	0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x22, 0x6c, 0x0a, 0x16, 0x44, 0x65, 0x6c, 0x65,
+	0x74, 0x65, 0x50, 0x72, 0x69, 0x76, 0x61, 0x74, 0x65, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65,
+	0x49, 0x6e, 0x12, 0x1b, 0x0a, 0x09, 0x63, 0x68, 0x61, 0x74, 0x5f, 0x75, 0x75, 0x69, 0x64, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x63, 0x68, 0x61, 0x74, 0x55, 0x75, 0x69, 0x64, 0x12,
+	0x21, 0x0a, 0x0c, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x5f, 0x75, 0x75, 0x69, 0x64, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x55, 0x75,
+	0x69, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x6d, 0x6f, 0x64, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x04, 0x6d, 0x6f, 0x64, 0x65, 0x22, 0x42, 0x0a, 0x17, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65,
+	0x50, 0x72, 0x69, 0x76, 0x61, 0x74, 0x65, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x4f, 0x75,
+	0x74, 0x12, 0x27, 0x0a, 0x0f, 0x64, 0x65, 0x6c, 0x65, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x73, 0x74,
+	0x61, 0x74, 0x75, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0e, 0x64, 0x65, 0x6c, 0x65,
+	0x74, 0x69, 0x6f, 0x6e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x22, 0x77, 0x0a, 0x14, 0x45, 0x64,
+	0x69, 0x74, 0x50, 0x72, 0x69, 0x76, 0x61, 0x74, 0x65, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65,
+	0x49, 0x6e, 0x12, 0x1b, 0x0a, 0x09, 0x63, 0x68, 0x61, 0x74, 0x5f, 0x75, 0x75, 0x69, 0x64, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x63, 0x68, 0x61, 0x74, 0x55, 0x75, 0x69, 0x64, 0x12,
+	0x21, 0x0a, 0x0c, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x5f, 0x75, 0x75, 0x69, 0x64, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x55, 0x75,
+	0x69, 0x64, 0x12, 0x1f, 0x0a, 0x0b, 0x6e, 0x65, 0x77, 0x5f, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e,
+	0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x6e, 0x65, 0x77, 0x43, 0x6f, 0x6e, 0x74,
+	0x65, 0x6e, 0x74, 0x22, 0x7a, 0x0a, 0x15, 0x45, 0x64, 0x69, 0x74, 0x50, 0x72, 0x69, 0x76, 0x61,
+	0x74, 0x65, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x4f, 0x75, 0x74, 0x12, 0x21, 0x0a, 0x0c,
+	0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x5f, 0x75, 0x75, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x0b, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x55, 0x75, 0x69, 0x64, 0x12,
+	0x1f, 0x0a, 0x0b, 0x6e, 0x65, 0x77, 0x5f, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x6e, 0x65, 0x77, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74,
+	0x12, 0x1d, 0x0a, 0x0a, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x22,
+	0x57, 0x0a, 0x15, 0x47, 0x65, 0x74, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x52, 0x65, 0x76,
+	0x69, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x49, 0x6e, 0x12, 0x1b, 0x0a, 0x09, 0x63, 0x68, 0x61, 0x74,
 	0x5f, 0x75, 0x75, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x63, 0x68, 0x61,
 	0x74, 0x55, 0x75, 0x69, 0x64, 0x12, 0x21, 0x0a, 0x0c, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65,
 	0x5f, 0x75, 0x75, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x6d, 0x65, 0x73,
-	0x73, 0x61, 0x67, 0x65, 0x55, 0x75, 0x69, 0x64, 0x12, 0x1f, 0x0a, 0x0b, 0x6e, 0x65, 0x77, 0x5f,
-	0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x6e,
-	0x65, 0x77, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x22, 0x7a, 0x0a, 0x15, 0x45, 0x64, 0x69,
-	0x74, 0x50, 0x72, 0x69, 0x76, 0x61, 0x74, 0x65, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x4f,
-	0x75, 0x74, 0x12, 0x21, 0x0a, 0x0c, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x5f, 0x75, 0x75,
-	0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67,
-	0x65, 0x55, 0x75, 0x69, 0x64, 0x12, 0x1f, 0x0a, 0x0b, 0x6e, 0x65, 0x77, 0x5f, 0x63, 0x6f, 0x6e,
-	0x74, 0x65, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x6e, 0x65, 0x77, 0x43,
-	0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65,
-	0x64, 0x5f, 0x61, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x75, 0x70, 0x64, 0x61,
-	0x74, 0x65, 0x64, 0x41, 0x74, 0x32, 0xf2, 0x02, 0x0a, 0x0b, 0x43, 0x68, 0x61, 0x74, 0x53, 0x65,
-	0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x42, 0x0a, 0x11, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x50,
-	0x72, 0x69, 0x76, 0x61, 0x74, 0x65, 0x43, 0x68, 0x61, 0x74, 0x12, 0x14, 0x2e, 0x43, 0x72, 0x65,
-	0x61, 0x74, 0x65, 0x50, 0x72, 0x69, 0x76, 0x61, 0x74, 0x65, 0x43, 0x68, 0x61, 0x74, 0x49, 0x6e,
-	0x1a, 0x15, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x50, 0x72, 0x69, 0x76, 0x61, 0x74, 0x65,
-	0x43, 0x68, 0x61, 0x74, 0x4f, 0x75, 0x74, 0x22, 0x00, 0x12, 0x32, 0x0a, 0x08, 0x47, 0x65, 0x74,
-	0x43, 0x68, 0x61, 0x74, 0x73, 0x12, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70,
-	0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x1a, 0x0c, 0x2e,
-	0x47, 0x65, 0x74, 0x43, 0x68, 0x61, 0x74, 0x73, 0x4f, 0x75, 0x74, 0x22, 0x00, 0x12, 0x57, 0x0a,
-	0x18, 0x47, 0x65, 0x74, 0x50, 0x72, 0x69, 0x76, 0x61, 0x74, 0x65, 0x52, 0x65, 0x63, 0x65, 0x6e,
-	0x74, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x12, 0x1b, 0x2e, 0x47, 0x65, 0x74, 0x50,
-	0x72, 0x69, 0x76, 0x61, 0x74, 0x65, 0x52, 0x65, 0x63, 0x65, 0x6e, 0x74, 0x4d, 0x65, 0x73, 0x73,
-	0x61, 0x67, 0x65, 0x73, 0x49, 0x6e, 0x1a, 0x1c, 0x2e, 0x47, 0x65, 0x74, 0x50, 0x72, 0x69, 0x76,
-	0x61, 0x74, 0x65, 0x52, 0x65, 0x63, 0x65, 0x6e, 0x74, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65,
-	0x73, 0x4f, 0x75, 0x74, 0x22, 0x00, 0x12, 0x4b, 0x0a, 0x14, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65,
-	0x50, 0x72, 0x69, 0x76, 0x61, 0x74, 0x65, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x17,
-	0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x50, 0x72, 0x69, 0x76, 0x61, 0x74, 0x65, 0x4d, 0x65,
-	0x73, 0x73, 0x61, 0x67, 0x65, 0x49, 0x6e, 0x1a, 0x18, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65,
+	0x73, 0x61, 0x67, 0x65, 0x55, 0x75, 0x69, 0x64, 0x22, 0x6b, 0x0a, 0x0f, 0x4d, 0x65, 0x73, 0x73,
+	0x61, 0x67, 0x65, 0x52, 0x65, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x18, 0x0a, 0x07, 0x63,
+	0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x63, 0x6f,
+	0x6e, 0x74, 0x65, 0x6e, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x77, 0x72, 0x69, 0x74, 0x74, 0x65, 0x6e,
+	0x5f, 0x61, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x77, 0x72, 0x69, 0x74, 0x74,
+	0x65, 0x6e, 0x41, 0x74, 0x12, 0x1f, 0x0a, 0x0b, 0x72, 0x65, 0x70, 0x6c, 0x61, 0x63, 0x65, 0x64,
+	0x5f, 0x61, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x72, 0x65, 0x70, 0x6c, 0x61,
+	0x63, 0x65, 0x64, 0x41, 0x74, 0x22, 0x48, 0x0a, 0x16, 0x47, 0x65, 0x74, 0x4d, 0x65, 0x73, 0x73,
+	0x61, 0x67, 0x65, 0x52, 0x65, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x4f, 0x75, 0x74, 0x12,
+	0x2e, 0x0a, 0x09, 0x72, 0x65, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x01, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x10, 0x2e, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x52, 0x65, 0x76, 0x69,
+	0x73, 0x69, 0x6f, 0x6e, 0x52, 0x09, 0x72, 0x65, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x32,
+	0x8f, 0x13, 0x0a, 0x0b, 0x43, 0x68, 0x61, 0x74, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12,
+	0x42, 0x0a, 0x11, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x50, 0x72, 0x69, 0x76, 0x61, 0x74, 0x65,
+	0x43, 0x68, 0x61, 0x74, 0x12, 0x14, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x50, 0x72, 0x69,
+	0x76, 0x61, 0x74, 0x65, 0x43, 0x68, 0x61, 0x74, 0x49, 0x6e, 0x1a, 0x15, 0x2e, 0x43, 0x72, 0x65,
+	0x61, 0x74, 0x65, 0x50, 0x72, 0x69, 0x76, 0x61, 0x74, 0x65, 0x43, 0x68, 0x61, 0x74, 0x4f, 0x75,
+	0x74, 0x22, 0x00, 0x12, 0x32, 0x0a, 0x08, 0x47, 0x65, 0x74, 0x43, 0x68, 0x61, 0x74, 0x73, 0x12,
+	0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75,
+	0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x1a, 0x0c, 0x2e, 0x47, 0x65, 0x74, 0x43, 0x68, 0x61,
+	0x74, 0x73, 0x4f, 0x75, 0x74, 0x22, 0x00, 0x12, 0x35, 0x0a, 0x0f, 0x53, 0x75, 0x62, 0x73, 0x63,
+	0x72, 0x69, 0x62, 0x65, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x12, 0x16, 0x2e, 0x67, 0x6f, 0x6f,
+	0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70,
+	0x74, 0x79, 0x1a, 0x06, 0x2e, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x22, 0x00, 0x30, 0x01, 0x12, 0x2a,
+	0x0a, 0x09, 0x53, 0x65, 0x74, 0x54, 0x79, 0x70, 0x69, 0x6e, 0x67, 0x12, 0x0c, 0x2e, 0x53, 0x65,
+	0x74, 0x54, 0x79, 0x70, 0x69, 0x6e, 0x67, 0x49, 0x6e, 0x1a, 0x0d, 0x2e, 0x53, 0x65, 0x74, 0x54,
+	0x79, 0x70, 0x69, 0x6e, 0x67, 0x4f, 0x75, 0x74, 0x22, 0x00, 0x12, 0x30, 0x0a, 0x0b, 0x57, 0x61,
+	0x74, 0x63, 0x68, 0x54, 0x79, 0x70, 0x69, 0x6e, 0x67, 0x12, 0x0e, 0x2e, 0x57, 0x61, 0x74, 0x63,
+	0x68, 0x54, 0x79, 0x70, 0x69, 0x6e, 0x67, 0x49, 0x6e, 0x1a, 0x0d, 0x2e, 0x54, 0x79, 0x70, 0x69,
+	0x6e, 0x67, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x22, 0x00, 0x30, 0x01, 0x12, 0x34, 0x0a, 0x09,
+	0x48, 0x65, 0x61, 0x72, 0x74, 0x62, 0x65, 0x61, 0x74, 0x12, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67,
+	0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74,
+	0x79, 0x1a, 0x0d, 0x2e, 0x48, 0x65, 0x61, 0x72, 0x74, 0x62, 0x65, 0x61, 0x74, 0x4f, 0x75, 0x74,
+	0x22, 0x00, 0x12, 0x30, 0x0a, 0x0b, 0x47, 0x65, 0x74, 0x50, 0x72, 0x65, 0x73, 0x65, 0x6e, 0x63,
+	0x65, 0x12, 0x0e, 0x2e, 0x47, 0x65, 0x74, 0x50, 0x72, 0x65, 0x73, 0x65, 0x6e, 0x63, 0x65, 0x49,
+	0x6e, 0x1a, 0x0f, 0x2e, 0x47, 0x65, 0x74, 0x50, 0x72, 0x65, 0x73, 0x65, 0x6e, 0x63, 0x65, 0x4f,
+	0x75, 0x74, 0x22, 0x00, 0x12, 0x4b, 0x0a, 0x14, 0x53, 0x65, 0x74, 0x43, 0x68, 0x61, 0x74, 0x4e,
+	0x6f, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x17, 0x2e, 0x53,
+	0x65, 0x74, 0x43, 0x68, 0x61, 0x74, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x73, 0x49, 0x6e, 0x1a, 0x18, 0x2e, 0x53, 0x65, 0x74, 0x43, 0x68, 0x61, 0x74, 0x4e,
+	0x6f, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x4f, 0x75, 0x74, 0x22,
+	0x00, 0x12, 0x2a, 0x0a, 0x09, 0x4c, 0x65, 0x61, 0x76, 0x65, 0x43, 0x68, 0x61, 0x74, 0x12, 0x0c,
+	0x2e, 0x4c, 0x65, 0x61, 0x76, 0x65, 0x43, 0x68, 0x61, 0x74, 0x49, 0x6e, 0x1a, 0x0d, 0x2e, 0x4c,
+	0x65, 0x61, 0x76, 0x65, 0x43, 0x68, 0x61, 0x74, 0x4f, 0x75, 0x74, 0x22, 0x00, 0x12, 0x3c, 0x0a,
+	0x0f, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x43, 0x68, 0x61, 0x74,
+	0x12, 0x12, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x43, 0x68,
+	0x61, 0x74, 0x49, 0x6e, 0x1a, 0x13, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x47, 0x72, 0x6f,
+	0x75, 0x70, 0x43, 0x68, 0x61, 0x74, 0x4f, 0x75, 0x74, 0x22, 0x00, 0x12, 0x3c, 0x0a, 0x0f, 0x41,
+	0x64, 0x64, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x73, 0x12, 0x12,
+	0x2e, 0x41, 0x64, 0x64, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x73,
+	0x49, 0x6e, 0x1a, 0x13, 0x2e, 0x41, 0x64, 0x64, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x4d, 0x65, 0x6d,
+	0x62, 0x65, 0x72, 0x73, 0x4f, 0x75, 0x74, 0x22, 0x00, 0x12, 0x42, 0x0a, 0x11, 0x52, 0x65, 0x6d,
+	0x6f, 0x76, 0x65, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x12, 0x14,
+	0x2e, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x4d, 0x65, 0x6d, 0x62,
+	0x65, 0x72, 0x49, 0x6e, 0x1a, 0x15, 0x2e, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x47, 0x72, 0x6f,
+	0x75, 0x70, 0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x4f, 0x75, 0x74, 0x22, 0x00, 0x12, 0x3f, 0x0a,
+	0x10, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x49, 0x6e, 0x76, 0x69, 0x74, 0x65, 0x4c, 0x69, 0x6e,
+	0x6b, 0x12, 0x13, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x49, 0x6e, 0x76, 0x69, 0x74, 0x65,
+	0x4c, 0x69, 0x6e, 0x6b, 0x49, 0x6e, 0x1a, 0x14, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x49,
+	0x6e, 0x76, 0x69, 0x74, 0x65, 0x4c, 0x69, 0x6e, 0x6b, 0x4f, 0x75, 0x74, 0x22, 0x00, 0x12, 0x3f,
+	0x0a, 0x10, 0x52, 0x65, 0x76, 0x6f, 0x6b, 0x65, 0x49, 0x6e, 0x76, 0x69, 0x74, 0x65, 0x4c, 0x69,
+	0x6e, 0x6b, 0x12, 0x13, 0x2e, 0x52, 0x65, 0x76, 0x6f, 0x6b, 0x65, 0x49, 0x6e, 0x76, 0x69, 0x74,
+	0x65, 0x4c, 0x69, 0x6e, 0x6b, 0x49, 0x6e, 0x1a, 0x14, 0x2e, 0x52, 0x65, 0x76, 0x6f, 0x6b, 0x65,
+	0x49, 0x6e, 0x76, 0x69, 0x74, 0x65, 0x4c, 0x69, 0x6e, 0x6b, 0x4f, 0x75, 0x74, 0x22, 0x00, 0x12,
+	0x3c, 0x0a, 0x0f, 0x4c, 0x69, 0x73, 0x74, 0x49, 0x6e, 0x76, 0x69, 0x74, 0x65, 0x4c, 0x69, 0x6e,
+	0x6b, 0x73, 0x12, 0x12, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x49, 0x6e, 0x76, 0x69, 0x74, 0x65, 0x4c,
+	0x69, 0x6e, 0x6b, 0x73, 0x49, 0x6e, 0x1a, 0x13, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x49, 0x6e, 0x76,
+	0x69, 0x74, 0x65, 0x4c, 0x69, 0x6e, 0x6b, 0x73, 0x4f, 0x75, 0x74, 0x22, 0x00, 0x12, 0x33, 0x0a,
+	0x0c, 0x4a, 0x6f, 0x69, 0x6e, 0x42, 0x79, 0x49, 0x6e, 0x76, 0x69, 0x74, 0x65, 0x12, 0x0f, 0x2e,
+	0x4a, 0x6f, 0x69, 0x6e, 0x42, 0x79, 0x49, 0x6e, 0x76, 0x69, 0x74, 0x65, 0x49, 0x6e, 0x1a, 0x10,
+	0x2e, 0x4a, 0x6f, 0x69, 0x6e, 0x42, 0x79, 0x49, 0x6e, 0x76, 0x69, 0x74, 0x65, 0x4f, 0x75, 0x74,
+	0x22, 0x00, 0x12, 0x36, 0x0a, 0x0d, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x43, 0x68, 0x61, 0x6e,
+	0x6e, 0x65, 0x6c, 0x12, 0x10, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x43, 0x68, 0x61, 0x6e,
+	0x6e, 0x65, 0x6c, 0x49, 0x6e, 0x1a, 0x11, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x43, 0x68,
+	0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x4f, 0x75, 0x74, 0x22, 0x00, 0x12, 0x3f, 0x0a, 0x10, 0x53, 0x75,
+	0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x12, 0x13,
+	0x2e, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65,
+	0x6c, 0x49, 0x6e, 0x1a, 0x14, 0x2e, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x43,
+	0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x4f, 0x75, 0x74, 0x22, 0x00, 0x12, 0x45, 0x0a, 0x12, 0x55,
+	0x6e, 0x73, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65,
+	0x6c, 0x12, 0x15, 0x2e, 0x55, 0x6e, 0x73, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x43,
+	0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x49, 0x6e, 0x1a, 0x16, 0x2e, 0x55, 0x6e, 0x73, 0x75, 0x62,
+	0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x4f, 0x75, 0x74,
+	0x22, 0x00, 0x12, 0x3f, 0x0a, 0x10, 0x50, 0x75, 0x62, 0x6c, 0x69, 0x73, 0x68, 0x54, 0x6f, 0x43,
+	0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x12, 0x13, 0x2e, 0x50, 0x75, 0x62, 0x6c, 0x69, 0x73, 0x68,
+	0x54, 0x6f, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x49, 0x6e, 0x1a, 0x14, 0x2e, 0x50, 0x75,
+	0x62, 0x6c, 0x69, 0x73, 0x68, 0x54, 0x6f, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x4f, 0x75,
+	0x74, 0x22, 0x00, 0x12, 0x2a, 0x0a, 0x09, 0x42, 0x61, 0x6e, 0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72,
+	0x12, 0x0c, 0x2e, 0x42, 0x61, 0x6e, 0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x49, 0x6e, 0x1a, 0x0d,
+	0x2e, 0x42, 0x61, 0x6e, 0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x4f, 0x75, 0x74, 0x22, 0x00, 0x12,
+	0x30, 0x0a, 0x0b, 0x55, 0x6e, 0x62, 0x61, 0x6e, 0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x12, 0x0e,
+	0x2e, 0x55, 0x6e, 0x62, 0x61, 0x6e, 0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x49, 0x6e, 0x1a, 0x0f,
+	0x2e, 0x55, 0x6e, 0x62, 0x61, 0x6e, 0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x4f, 0x75, 0x74, 0x22,
+	0x00, 0x12, 0x2d, 0x0a, 0x0a, 0x4b, 0x69, 0x63, 0x6b, 0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x12,
+	0x0d, 0x2e, 0x4b, 0x69, 0x63, 0x6b, 0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x49, 0x6e, 0x1a, 0x0e,
+	0x2e, 0x4b, 0x69, 0x63, 0x6b, 0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x4f, 0x75, 0x74, 0x22, 0x00,
+	0x12, 0x57, 0x0a, 0x18, 0x47, 0x65, 0x74, 0x4f, 0x72, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x43,
+	0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x12, 0x1b, 0x2e, 0x47,
+	0x65, 0x74, 0x4f, 0x72, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e,
+	0x74, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x49, 0x6e, 0x1a, 0x1c, 0x2e, 0x47, 0x65, 0x74, 0x4f,
+	0x72, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x53, 0x74,
+	0x72, 0x65, 0x61, 0x6d, 0x4f, 0x75, 0x74, 0x22, 0x00, 0x12, 0x30, 0x0a, 0x0b, 0x47, 0x65, 0x74,
+	0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x12, 0x0e, 0x2e, 0x47, 0x65, 0x74, 0x43, 0x6f,
+	0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x49, 0x6e, 0x1a, 0x0f, 0x2e, 0x47, 0x65, 0x74, 0x43, 0x6f,
+	0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x4f, 0x75, 0x74, 0x22, 0x00, 0x12, 0x30, 0x0a, 0x0b, 0x50,
+	0x6f, 0x73, 0x74, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x0e, 0x2e, 0x50, 0x6f, 0x73,
+	0x74, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x49, 0x6e, 0x1a, 0x0f, 0x2e, 0x50, 0x6f, 0x73,
+	0x74, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x4f, 0x75, 0x74, 0x22, 0x00, 0x12, 0x3f, 0x0a,
+	0x10, 0x4d, 0x61, 0x72, 0x6b, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x52, 0x65, 0x61,
+	0x64, 0x12, 0x13, 0x2e, 0x4d, 0x61, 0x72, 0x6b, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73,
+	0x52, 0x65, 0x61, 0x64, 0x49, 0x6e, 0x1a, 0x14, 0x2e, 0x4d, 0x61, 0x72, 0x6b, 0x4d, 0x65, 0x73,
+	0x73, 0x61, 0x67, 0x65, 0x73, 0x52, 0x65, 0x61, 0x64, 0x4f, 0x75, 0x74, 0x22, 0x00, 0x12, 0x42,
+	0x0a, 0x11, 0x47, 0x65, 0x74, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x52, 0x65, 0x61, 0x64,
+	0x65, 0x72, 0x73, 0x12, 0x14, 0x2e, 0x47, 0x65, 0x74, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65,
+	0x52, 0x65, 0x61, 0x64, 0x65, 0x72, 0x73, 0x49, 0x6e, 0x1a, 0x15, 0x2e, 0x47, 0x65, 0x74, 0x4d,
+	0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x52, 0x65, 0x61, 0x64, 0x65, 0x72, 0x73, 0x4f, 0x75, 0x74,
+	0x22, 0x00, 0x12, 0x30, 0x0a, 0x0b, 0x41, 0x64, 0x64, 0x52, 0x65, 0x61, 0x63, 0x74, 0x69, 0x6f,
+	0x6e, 0x12, 0x0e, 0x2e, 0x41, 0x64, 0x64, 0x52, 0x65, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x49,
+	0x6e, 0x1a, 0x0f, 0x2e, 0x41, 0x64, 0x64, 0x52, 0x65, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x4f,
+	0x75, 0x74, 0x22, 0x00, 0x12, 0x39, 0x0a, 0x0e, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x52, 0x65,
+	0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x11, 0x2e, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x52,
+	0x65, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x6e, 0x1a, 0x12, 0x2e, 0x52, 0x65, 0x6d, 0x6f,
+	0x76, 0x65, 0x52, 0x65, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x4f, 0x75, 0x74, 0x22, 0x00, 0x12,
+	0x2d, 0x0a, 0x0a, 0x50, 0x69, 0x6e, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x0d, 0x2e,
+	0x50, 0x69, 0x6e, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x49, 0x6e, 0x1a, 0x0e, 0x2e, 0x50,
+	0x69, 0x6e, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x4f, 0x75, 0x74, 0x22, 0x00, 0x12, 0x33,
+	0x0a, 0x0c, 0x55, 0x6e, 0x70, 0x69, 0x6e, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x0f,
+	0x2e, 0x55, 0x6e, 0x70, 0x69, 0x6e, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x49, 0x6e, 0x1a,
+	0x10, 0x2e, 0x55, 0x6e, 0x70, 0x69, 0x6e, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x4f, 0x75,
+	0x74, 0x22, 0x00, 0x12, 0x45, 0x0a, 0x12, 0x4c, 0x69, 0x73, 0x74, 0x50, 0x69, 0x6e, 0x6e, 0x65,
+	0x64, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x12, 0x15, 0x2e, 0x4c, 0x69, 0x73, 0x74,
+	0x50, 0x69, 0x6e, 0x6e, 0x65, 0x64, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x49, 0x6e,
+	0x1a, 0x16, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x50, 0x69, 0x6e, 0x6e, 0x65, 0x64, 0x4d, 0x65, 0x73,
+	0x73, 0x61, 0x67, 0x65, 0x73, 0x4f, 0x75, 0x74, 0x22, 0x00, 0x12, 0x3c, 0x0a, 0x0f, 0x46, 0x6f,
+	0x72, 0x77, 0x61, 0x72, 0x64, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x12, 0x12, 0x2e,
+	0x46, 0x6f, 0x72, 0x77, 0x61, 0x72, 0x64, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x49,
+	0x6e, 0x1a, 0x13, 0x2e, 0x46, 0x6f, 0x72, 0x77, 0x61, 0x72, 0x64, 0x4d, 0x65, 0x73, 0x73, 0x61,
+	0x67, 0x65, 0x73, 0x4f, 0x75, 0x74, 0x22, 0x00, 0x12, 0x39, 0x0a, 0x0e, 0x53, 0x65, 0x61, 0x72,
+	0x63, 0x68, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x12, 0x11, 0x2e, 0x53, 0x65, 0x61,
+	0x72, 0x63, 0x68, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x49, 0x6e, 0x1a, 0x12, 0x2e,
+	0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x4f, 0x75,
+	0x74, 0x22, 0x00, 0x12, 0x57, 0x0a, 0x18, 0x47, 0x65, 0x74, 0x50, 0x72, 0x69, 0x76, 0x61, 0x74,
+	0x65, 0x52, 0x65, 0x63, 0x65, 0x6e, 0x74, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x12,
+	0x1b, 0x2e, 0x47, 0x65, 0x74, 0x50, 0x72, 0x69, 0x76, 0x61, 0x74, 0x65, 0x52, 0x65, 0x63, 0x65,
+	0x6e, 0x74, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x49, 0x6e, 0x1a, 0x1c, 0x2e, 0x47,
+	0x65, 0x74, 0x50, 0x72, 0x69, 0x76, 0x61, 0x74, 0x65, 0x52, 0x65, 0x63, 0x65, 0x6e, 0x74, 0x4d,
+	0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x4f, 0x75, 0x74, 0x22, 0x00, 0x12, 0x45, 0x0a, 0x12,
+	0x53, 0x65, 0x6e, 0x64, 0x50, 0x72, 0x69, 0x76, 0x61, 0x74, 0x65, 0x4d, 0x65, 0x73, 0x73, 0x61,
+	0x67, 0x65, 0x12, 0x15, 0x2e, 0x53, 0x65, 0x6e, 0x64, 0x50, 0x72, 0x69, 0x76, 0x61, 0x74, 0x65,
+	0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x49, 0x6e, 0x1a, 0x16, 0x2e, 0x53, 0x65, 0x6e, 0x64,
 	0x50, 0x72, 0x69, 0x76, 0x61, 0x74, 0x65, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x4f, 0x75,
-	0x74, 0x22, 0x00, 0x12, 0x45, 0x0a, 0x12, 0x45, 0x64, 0x69, 0x74, 0x50, 0x72, 0x69, 0x76, 0x61,
-	0x74, 0x65, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x15, 0x2e, 0x45, 0x64, 0x69, 0x74,
-	0x50, 0x72, 0x69, 0x76, 0x61, 0x74, 0x65, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x49, 0x6e,
-	0x1a, 0x16, 0x2e, 0x45, 0x64, 0x69, 0x74, 0x50, 0x72, 0x69, 0x76, 0x61, 0x74, 0x65, 0x4d, 0x65,
-	0x73, 0x73, 0x61, 0x67, 0x65, 0x4f, 0x75, 0x74, 0x22, 0x00, 0x42, 0x0a, 0x5a, 0x08, 0x70, 0x6b,
-	0x67, 0x2f, 0x63, 0x68, 0x61, 0x74, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x74, 0x22, 0x00, 0x12, 0x4b, 0x0a, 0x14, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x50, 0x72, 0x69,
+	0x76, 0x61, 0x74, 0x65, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x17, 0x2e, 0x44, 0x65,
+	0x6c, 0x65, 0x74, 0x65, 0x50, 0x72, 0x69, 0x76, 0x61, 0x74, 0x65, 0x4d, 0x65, 0x73, 0x73, 0x61,
+	0x67, 0x65, 0x49, 0x6e, 0x1a, 0x18, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x50, 0x72, 0x69,
+	0x76, 0x61, 0x74, 0x65, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x4f, 0x75, 0x74, 0x22, 0x00,
+	0x12, 0x45, 0x0a, 0x12, 0x45, 0x64, 0x69, 0x74, 0x50, 0x72, 0x69, 0x76, 0x61, 0x74, 0x65, 0x4d,
+	0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x15, 0x2e, 0x45, 0x64, 0x69, 0x74, 0x50, 0x72, 0x69,
+	0x76, 0x61, 0x74, 0x65, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x49, 0x6e, 0x1a, 0x16, 0x2e,
+	0x45, 0x64, 0x69, 0x74, 0x50, 0x72, 0x69, 0x76, 0x61, 0x74, 0x65, 0x4d, 0x65, 0x73, 0x73, 0x61,
+	0x67, 0x65, 0x4f, 0x75, 0x74, 0x22, 0x00, 0x12, 0x48, 0x0a, 0x13, 0x47, 0x65, 0x74, 0x4d, 0x65,
+	0x73, 0x73, 0x61, 0x67, 0x65, 0x52, 0x65, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x16,
+	0x2e, 0x47, 0x65, 0x74, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x52, 0x65, 0x76, 0x69, 0x73,
+	0x69, 0x6f, 0x6e, 0x73, 0x49, 0x6e, 0x1a, 0x17, 0x2e, 0x47, 0x65, 0x74, 0x4d, 0x65, 0x73, 0x73,
+	0x61, 0x67, 0x65, 0x52, 0x65, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x4f, 0x75, 0x74, 0x22,
+	0x00, 0x42, 0x0a, 0x5a, 0x08, 0x70, 0x6b, 0x67, 0x2f, 0x63, 0x68, 0x61, 0x74, 0x62, 0x06, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -748,39 +6703,238 @@ func file_api_chat_proto_rawDescGZIP() []byte {
 	return file_api_chat_proto_rawDescData
 }
 
-var file_api_chat_proto_msgTypes = make([]protoimpl.MessageInfo, 11)
+var file_api_chat_proto_msgTypes = make([]protoimpl.MessageInfo, 101)
 var file_api_chat_proto_goTypes = []any{
 	(*CreatePrivateChatIn)(nil),         // 0: CreatePrivateChatIn
 	(*CreatePrivateChatOut)(nil),        // 1: CreatePrivateChatOut
-	(*Chat)(nil),                        // 2: Chat
-	(*GetChatsOut)(nil),                 // 3: GetChatsOut
-	(*Message)(nil),                     // 4: Message
-	(*GetPrivateRecentMessagesIn)(nil),  // 5: GetPrivateRecentMessagesIn
-	(*GetPrivateRecentMessagesOut)(nil), // 6: GetPrivateRecentMessagesOut
-	(*DeletePrivateMessageIn)(nil),      // 7: DeletePrivateMessageIn
-	(*DeletePrivateMessageOut)(nil),     // 8: DeletePrivateMessageOut
-	(*EditPrivateMessageIn)(nil),        // 9: EditPrivateMessageIn
-	(*EditPrivateMessageOut)(nil),       // 10: EditPrivateMessageOut
-	(*emptypb.Empty)(nil),               // 11: google.protobuf.Empty
+	(*CreateGroupChatIn)(nil),           // 2: CreateGroupChatIn
+	(*CreateGroupChatOut)(nil),          // 3: CreateGroupChatOut
+	(*AddGroupMembersIn)(nil),           // 4: AddGroupMembersIn
+	(*AddGroupMembersOut)(nil),          // 5: AddGroupMembersOut
+	(*RemoveGroupMemberIn)(nil),         // 6: RemoveGroupMemberIn
+	(*RemoveGroupMemberOut)(nil),        // 7: RemoveGroupMemberOut
+	(*InviteLink)(nil),                  // 8: InviteLink
+	(*CreateInviteLinkIn)(nil),          // 9: CreateInviteLinkIn
+	(*CreateInviteLinkOut)(nil),         // 10: CreateInviteLinkOut
+	(*RevokeInviteLinkIn)(nil),          // 11: RevokeInviteLinkIn
+	(*RevokeInviteLinkOut)(nil),         // 12: RevokeInviteLinkOut
+	(*ListInviteLinksIn)(nil),           // 13: ListInviteLinksIn
+	(*ListInviteLinksOut)(nil),          // 14: ListInviteLinksOut
+	(*JoinByInviteIn)(nil),              // 15: JoinByInviteIn
+	(*JoinByInviteOut)(nil),             // 16: JoinByInviteOut
+	(*CreateChannelIn)(nil),             // 17: CreateChannelIn
+	(*CreateChannelOut)(nil),            // 18: CreateChannelOut
+	(*SubscribeChannelIn)(nil),          // 19: SubscribeChannelIn
+	(*SubscribeChannelOut)(nil),         // 20: SubscribeChannelOut
+	(*UnsubscribeChannelIn)(nil),        // 21: UnsubscribeChannelIn
+	(*UnsubscribeChannelOut)(nil),       // 22: UnsubscribeChannelOut
+	(*PublishToChannelIn)(nil),          // 23: PublishToChannelIn
+	(*PublishToChannelOut)(nil),         // 24: PublishToChannelOut
+	(*BanMemberIn)(nil),                 // 25: BanMemberIn
+	(*BanMemberOut)(nil),                // 26: BanMemberOut
+	(*UnbanMemberIn)(nil),               // 27: UnbanMemberIn
+	(*UnbanMemberOut)(nil),              // 28: UnbanMemberOut
+	(*KickMemberIn)(nil),                // 29: KickMemberIn
+	(*KickMemberOut)(nil),               // 30: KickMemberOut
+	(*GetOrCreateCommentStreamIn)(nil),  // 31: GetOrCreateCommentStreamIn
+	(*GetOrCreateCommentStreamOut)(nil), // 32: GetOrCreateCommentStreamOut
+	(*GetCommentsIn)(nil),               // 33: GetCommentsIn
+	(*GetCommentsOut)(nil),              // 34: GetCommentsOut
+	(*PostCommentIn)(nil),               // 35: PostCommentIn
+	(*PostCommentOut)(nil),              // 36: PostCommentOut
+	(*MarkMessagesReadIn)(nil),          // 37: MarkMessagesReadIn
+	(*MarkMessagesReadOut)(nil),         // 38: MarkMessagesReadOut
+	(*GetMessageReadersIn)(nil),         // 39: GetMessageReadersIn
+	(*MessageReader)(nil),               // 40: MessageReader
+	(*GetMessageReadersOut)(nil),        // 41: GetMessageReadersOut
+	(*AddReactionIn)(nil),               // 42: AddReactionIn
+	(*AddReactionOut)(nil),              // 43: AddReactionOut
+	(*RemoveReactionIn)(nil),            // 44: RemoveReactionIn
+	(*RemoveReactionOut)(nil),           // 45: RemoveReactionOut
+	(*PinMessageIn)(nil),                // 46: PinMessageIn
+	(*PinMessageOut)(nil),               // 47: PinMessageOut
+	(*UnpinMessageIn)(nil),              // 48: UnpinMessageIn
+	(*UnpinMessageOut)(nil),             // 49: UnpinMessageOut
+	(*ListPinnedMessagesIn)(nil),        // 50: ListPinnedMessagesIn
+	(*PinnedMessage)(nil),               // 51: PinnedMessage
+	(*ListPinnedMessagesOut)(nil),       // 52: ListPinnedMessagesOut
+	(*ForwardMessagesIn)(nil),           // 53: ForwardMessagesIn
+	(*ForwardMessagesOut)(nil),          // 54: ForwardMessagesOut
+	(*SearchMessagesIn)(nil),            // 55: SearchMessagesIn
+	(*SearchResult)(nil),                // 56: SearchResult
+	(*SearchMessagesOut)(nil),           // 57: SearchMessagesOut
+	(*MessageEdited)(nil),               // 58: MessageEdited
+	(*MessageDeleted)(nil),              // 59: MessageDeleted
+	(*ReadReceipt)(nil),                 // 60: ReadReceipt
+	(*MembershipChanged)(nil),           // 61: MembershipChanged
+	(*Event)(nil),                       // 62: Event
+	(*ChatCreated)(nil),                 // 63: ChatCreated
+	(*ChatDomainEvent)(nil),             // 64: ChatDomainEvent
+	(*NotificationRequested)(nil),       // 65: NotificationRequested
+	(*SetTypingIn)(nil),                 // 66: SetTypingIn
+	(*SetTypingOut)(nil),                // 67: SetTypingOut
+	(*WatchTypingIn)(nil),               // 68: WatchTypingIn
+	(*TypingUpdate)(nil),                // 69: TypingUpdate
+	(*HeartbeatOut)(nil),                // 70: HeartbeatOut
+	(*GetPresenceIn)(nil),               // 71: GetPresenceIn
+	(*Presence)(nil),                    // 72: Presence
+	(*GetPresenceOut)(nil),              // 73: GetPresenceOut
+	(*SetChatNotificationsIn)(nil),      // 74: SetChatNotificationsIn
+	(*SetChatNotificationsOut)(nil),     // 75: SetChatNotificationsOut
+	(*LeaveChatIn)(nil),                 // 76: LeaveChatIn
+	(*LeaveChatOut)(nil),                // 77: LeaveChatOut
+	(*Chat)(nil),                        // 78: Chat
+	(*GetChatsOut)(nil),                 // 79: GetChatsOut
+	(*Message)(nil),                     // 80: Message
+	(*ForwardedFrom)(nil),               // 81: ForwardedFrom
+	(*Reaction)(nil),                    // 82: Reaction
+	(*MediaFile)(nil),                   // 83: MediaFile
+	(*ImageAttachment)(nil),             // 84: ImageAttachment
+	(*VideoAttachment)(nil),             // 85: VideoAttachment
+	(*FileAttachment)(nil),              // 86: FileAttachment
+	(*SpeechAttachment)(nil),            // 87: SpeechAttachment
+	(*CircleAttachment)(nil),            // 88: CircleAttachment
+	(*Attachment)(nil),                  // 89: Attachment
+	(*GetPrivateRecentMessagesIn)(nil),  // 90: GetPrivateRecentMessagesIn
+	(*GetPrivateRecentMessagesOut)(nil), // 91: GetPrivateRecentMessagesOut
+	(*SendPrivateMessageIn)(nil),        // 92: SendPrivateMessageIn
+	(*SendPrivateMessageOut)(nil),       // 93: SendPrivateMessageOut
+	(*DeletePrivateMessageIn)(nil),      // 94: DeletePrivateMessageIn
+	(*DeletePrivateMessageOut)(nil),     // 95: DeletePrivateMessageOut
+	(*EditPrivateMessageIn)(nil),        // 96: EditPrivateMessageIn
+	(*EditPrivateMessageOut)(nil),       // 97: EditPrivateMessageOut
+	(*GetMessageRevisionsIn)(nil),       // 98: GetMessageRevisionsIn
+	(*MessageRevision)(nil),             // 99: MessageRevision
+	(*GetMessageRevisionsOut)(nil),      // 100: GetMessageRevisionsOut
+	(*emptypb.Empty)(nil),               // 101: google.protobuf.Empty
 }
 var file_api_chat_proto_depIdxs = []int32{
-	2,  // 0: GetChatsOut.chats:type_name -> Chat
-	4,  // 1: GetPrivateRecentMessagesOut.messages:type_name -> Message
-	0,  // 2: ChatService.CreatePrivateChat:input_type -> CreatePrivateChatIn
-	11, // 3: ChatService.GetChats:input_type -> google.protobuf.Empty
-	5,  // 4: ChatService.GetPrivateRecentMessages:input_type -> GetPrivateRecentMessagesIn
-	7,  // 5: ChatService.DeletePrivateMessage:input_type -> DeletePrivateMessageIn
-	9,  // 6: ChatService.EditPrivateMessage:input_type -> EditPrivateMessageIn
-	1,  // 7: ChatService.CreatePrivateChat:output_type -> CreatePrivateChatOut
-	3,  // 8: ChatService.GetChats:output_type -> GetChatsOut
-	6,  // 9: ChatService.GetPrivateRecentMessages:output_type -> GetPrivateRecentMessagesOut
-	8,  // 10: ChatService.DeletePrivateMessage:output_type -> DeletePrivateMessageOut
-	10, // 11: ChatService.EditPrivateMessage:output_type -> EditPrivateMessageOut
-	7,  // [7:12] is the sub-list for method output_type
-	2,  // [2:7] is the sub-list for method input_type
-	2,  // [2:2] is the sub-list for extension type_name
-	2,  // [2:2] is the sub-list for extension extendee
-	0,  // [0:2] is the sub-list for field type_name
+	8,   // 0: CreateInviteLinkOut.link:type_name -> InviteLink
+	8,   // 1: ListInviteLinksOut.links:type_name -> InviteLink
+	80,  // 2: PublishToChannelOut.message:type_name -> Message
+	80,  // 3: GetCommentsOut.comments:type_name -> Message
+	80,  // 4: PostCommentOut.comment:type_name -> Message
+	40,  // 5: GetMessageReadersOut.readers:type_name -> MessageReader
+	82,  // 6: AddReactionOut.reactions:type_name -> Reaction
+	82,  // 7: RemoveReactionOut.reactions:type_name -> Reaction
+	80,  // 8: PinnedMessage.message:type_name -> Message
+	51,  // 9: ListPinnedMessagesOut.pinned_messages:type_name -> PinnedMessage
+	80,  // 10: ForwardMessagesOut.messages:type_name -> Message
+	80,  // 11: SearchResult.message:type_name -> Message
+	56,  // 12: SearchMessagesOut.results:type_name -> SearchResult
+	80,  // 13: Event.message_sent:type_name -> Message
+	58,  // 14: Event.message_edited:type_name -> MessageEdited
+	59,  // 15: Event.message_deleted:type_name -> MessageDeleted
+	60,  // 16: Event.read_receipt:type_name -> ReadReceipt
+	61,  // 17: Event.membership_changed:type_name -> MembershipChanged
+	80,  // 18: ChatDomainEvent.message_sent:type_name -> Message
+	58,  // 19: ChatDomainEvent.message_edited:type_name -> MessageEdited
+	59,  // 20: ChatDomainEvent.message_deleted:type_name -> MessageDeleted
+	63,  // 21: ChatDomainEvent.chat_created:type_name -> ChatCreated
+	72,  // 22: GetPresenceOut.presences:type_name -> Presence
+	78,  // 23: GetChatsOut.chats:type_name -> Chat
+	89,  // 24: Message.attachment:type_name -> Attachment
+	82,  // 25: Message.reactions:type_name -> Reaction
+	81,  // 26: Message.forwarded_from:type_name -> ForwardedFrom
+	83,  // 27: ImageAttachment.file:type_name -> MediaFile
+	83,  // 28: VideoAttachment.file:type_name -> MediaFile
+	83,  // 29: FileAttachment.file:type_name -> MediaFile
+	83,  // 30: SpeechAttachment.file:type_name -> MediaFile
+	83,  // 31: CircleAttachment.file:type_name -> MediaFile
+	84,  // 32: Attachment.image:type_name -> ImageAttachment
+	85,  // 33: Attachment.video:type_name -> VideoAttachment
+	86,  // 34: Attachment.file:type_name -> FileAttachment
+	87,  // 35: Attachment.speech:type_name -> SpeechAttachment
+	88,  // 36: Attachment.circle:type_name -> CircleAttachment
+	80,  // 37: GetPrivateRecentMessagesOut.messages:type_name -> Message
+	89,  // 38: SendPrivateMessageIn.attachment:type_name -> Attachment
+	80,  // 39: SendPrivateMessageOut.message:type_name -> Message
+	99,  // 40: GetMessageRevisionsOut.revisions:type_name -> MessageRevision
+	0,   // 41: ChatService.CreatePrivateChat:input_type -> CreatePrivateChatIn
+	101, // 42: ChatService.GetChats:input_type -> google.protobuf.Empty
+	101, // 43: ChatService.SubscribeEvents:input_type -> google.protobuf.Empty
+	66,  // 44: ChatService.SetTyping:input_type -> SetTypingIn
+	68,  // 45: ChatService.WatchTyping:input_type -> WatchTypingIn
+	101, // 46: ChatService.Heartbeat:input_type -> google.protobuf.Empty
+	71,  // 47: ChatService.GetPresence:input_type -> GetPresenceIn
+	74,  // 48: ChatService.SetChatNotifications:input_type -> SetChatNotificationsIn
+	76,  // 49: ChatService.LeaveChat:input_type -> LeaveChatIn
+	2,   // 50: ChatService.CreateGroupChat:input_type -> CreateGroupChatIn
+	4,   // 51: ChatService.AddGroupMembers:input_type -> AddGroupMembersIn
+	6,   // 52: ChatService.RemoveGroupMember:input_type -> RemoveGroupMemberIn
+	9,   // 53: ChatService.CreateInviteLink:input_type -> CreateInviteLinkIn
+	11,  // 54: ChatService.RevokeInviteLink:input_type -> RevokeInviteLinkIn
+	13,  // 55: ChatService.ListInviteLinks:input_type -> ListInviteLinksIn
+	15,  // 56: ChatService.JoinByInvite:input_type -> JoinByInviteIn
+	17,  // 57: ChatService.CreateChannel:input_type -> CreateChannelIn
+	19,  // 58: ChatService.SubscribeChannel:input_type -> SubscribeChannelIn
+	21,  // 59: ChatService.UnsubscribeChannel:input_type -> UnsubscribeChannelIn
+	23,  // 60: ChatService.PublishToChannel:input_type -> PublishToChannelIn
+	25,  // 61: ChatService.BanMember:input_type -> BanMemberIn
+	27,  // 62: ChatService.UnbanMember:input_type -> UnbanMemberIn
+	29,  // 63: ChatService.KickMember:input_type -> KickMemberIn
+	31,  // 64: ChatService.GetOrCreateCommentStream:input_type -> GetOrCreateCommentStreamIn
+	33,  // 65: ChatService.GetComments:input_type -> GetCommentsIn
+	35,  // 66: ChatService.PostComment:input_type -> PostCommentIn
+	37,  // 67: ChatService.MarkMessagesRead:input_type -> MarkMessagesReadIn
+	39,  // 68: ChatService.GetMessageReaders:input_type -> GetMessageReadersIn
+	42,  // 69: ChatService.AddReaction:input_type -> AddReactionIn
+	44,  // 70: ChatService.RemoveReaction:input_type -> RemoveReactionIn
+	46,  // 71: ChatService.PinMessage:input_type -> PinMessageIn
+	48,  // 72: ChatService.UnpinMessage:input_type -> UnpinMessageIn
+	50,  // 73: ChatService.ListPinnedMessages:input_type -> ListPinnedMessagesIn
+	53,  // 74: ChatService.ForwardMessages:input_type -> ForwardMessagesIn
+	55,  // 75: ChatService.SearchMessages:input_type -> SearchMessagesIn
+	90,  // 76: ChatService.GetPrivateRecentMessages:input_type -> GetPrivateRecentMessagesIn
+	92,  // 77: ChatService.SendPrivateMessage:input_type -> SendPrivateMessageIn
+	94,  // 78: ChatService.DeletePrivateMessage:input_type -> DeletePrivateMessageIn
+	96,  // 79: ChatService.EditPrivateMessage:input_type -> EditPrivateMessageIn
+	98,  // 80: ChatService.GetMessageRevisions:input_type -> GetMessageRevisionsIn
+	1,   // 81: ChatService.CreatePrivateChat:output_type -> CreatePrivateChatOut
+	79,  // 82: ChatService.GetChats:output_type -> GetChatsOut
+	62,  // 83: ChatService.SubscribeEvents:output_type -> Event
+	67,  // 84: ChatService.SetTyping:output_type -> SetTypingOut
+	69,  // 85: ChatService.WatchTyping:output_type -> TypingUpdate
+	70,  // 86: ChatService.Heartbeat:output_type -> HeartbeatOut
+	73,  // 87: ChatService.GetPresence:output_type -> GetPresenceOut
+	75,  // 88: ChatService.SetChatNotifications:output_type -> SetChatNotificationsOut
+	77,  // 89: ChatService.LeaveChat:output_type -> LeaveChatOut
+	3,   // 90: ChatService.CreateGroupChat:output_type -> CreateGroupChatOut
+	5,   // 91: ChatService.AddGroupMembers:output_type -> AddGroupMembersOut
+	7,   // 92: ChatService.RemoveGroupMember:output_type -> RemoveGroupMemberOut
+	10,  // 93: ChatService.CreateInviteLink:output_type -> CreateInviteLinkOut
+	12,  // 94: ChatService.RevokeInviteLink:output_type -> RevokeInviteLinkOut
+	14,  // 95: ChatService.ListInviteLinks:output_type -> ListInviteLinksOut
+	16,  // 96: ChatService.JoinByInvite:output_type -> JoinByInviteOut
+	18,  // 97: ChatService.CreateChannel:output_type -> CreateChannelOut
+	20,  // 98: ChatService.SubscribeChannel:output_type -> SubscribeChannelOut
+	22,  // 99: ChatService.UnsubscribeChannel:output_type -> UnsubscribeChannelOut
+	24,  // 100: ChatService.PublishToChannel:output_type -> PublishToChannelOut
+	26,  // 101: ChatService.BanMember:output_type -> BanMemberOut
+	28,  // 102: ChatService.UnbanMember:output_type -> UnbanMemberOut
+	30,  // 103: ChatService.KickMember:output_type -> KickMemberOut
+	32,  // 104: ChatService.GetOrCreateCommentStream:output_type -> GetOrCreateCommentStreamOut
+	34,  // 105: ChatService.GetComments:output_type -> GetCommentsOut
+	36,  // 106: ChatService.PostComment:output_type -> PostCommentOut
+	38,  // 107: ChatService.MarkMessagesRead:output_type -> MarkMessagesReadOut
+	41,  // 108: ChatService.GetMessageReaders:output_type -> GetMessageReadersOut
+	43,  // 109: ChatService.AddReaction:output_type -> AddReactionOut
+	45,  // 110: ChatService.RemoveReaction:output_type -> RemoveReactionOut
+	47,  // 111: ChatService.PinMessage:output_type -> PinMessageOut
+	49,  // 112: ChatService.UnpinMessage:output_type -> UnpinMessageOut
+	52,  // 113: ChatService.ListPinnedMessages:output_type -> ListPinnedMessagesOut
+	54,  // 114: ChatService.ForwardMessages:output_type -> ForwardMessagesOut
+	57,  // 115: ChatService.SearchMessages:output_type -> SearchMessagesOut
+	91,  // 116: ChatService.GetPrivateRecentMessages:output_type -> GetPrivateRecentMessagesOut
+	93,  // 117: ChatService.SendPrivateMessage:output_type -> SendPrivateMessageOut
+	95,  // 118: ChatService.DeletePrivateMessage:output_type -> DeletePrivateMessageOut
+	97,  // 119: ChatService.EditPrivateMessage:output_type -> EditPrivateMessageOut
+	100, // 120: ChatService.GetMessageRevisions:output_type -> GetMessageRevisionsOut
+	81,  // [81:121] is the sub-list for method output_type
+	41,  // [41:81] is the sub-list for method input_type
+	41,  // [41:41] is the sub-list for extension type_name
+	41,  // [41:41] is the sub-list for extension extendee
+	0,   // [0:41] is the sub-list for field type_name
 }
 
 func init() { file_api_chat_proto_init() }
@@ -788,13 +6942,33 @@ func file_api_chat_proto_init() {
 	if File_api_chat_proto != nil {
 		return
 	}
+	file_api_chat_proto_msgTypes[62].OneofWrappers = []any{
+		(*Event_MessageSent)(nil),
+		(*Event_MessageEdited)(nil),
+		(*Event_MessageDeleted)(nil),
+		(*Event_ReadReceipt)(nil),
+		(*Event_MembershipChanged)(nil),
+	}
+	file_api_chat_proto_msgTypes[64].OneofWrappers = []any{
+		(*ChatDomainEvent_MessageSent)(nil),
+		(*ChatDomainEvent_MessageEdited)(nil),
+		(*ChatDomainEvent_MessageDeleted)(nil),
+		(*ChatDomainEvent_ChatCreated)(nil),
+	}
+	file_api_chat_proto_msgTypes[89].OneofWrappers = []any{
+		(*Attachment_Image)(nil),
+		(*Attachment_Video)(nil),
+		(*Attachment_File)(nil),
+		(*Attachment_Speech)(nil),
+		(*Attachment_Circle)(nil),
+	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_api_chat_proto_rawDesc,
 			NumEnums:      0,
-			NumMessages:   11,
+			NumMessages:   101,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
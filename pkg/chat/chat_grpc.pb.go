@@ -22,9 +22,44 @@ const _ = grpc.SupportPackageIsVersion9
 const (
 	ChatService_CreatePrivateChat_FullMethodName        = "/ChatService/CreatePrivateChat"
 	ChatService_GetChats_FullMethodName                 = "/ChatService/GetChats"
+	ChatService_SubscribeEvents_FullMethodName          = "/ChatService/SubscribeEvents"
+	ChatService_SetTyping_FullMethodName                = "/ChatService/SetTyping"
+	ChatService_WatchTyping_FullMethodName              = "/ChatService/WatchTyping"
+	ChatService_Heartbeat_FullMethodName                = "/ChatService/Heartbeat"
+	ChatService_GetPresence_FullMethodName              = "/ChatService/GetPresence"
+	ChatService_SetChatNotifications_FullMethodName     = "/ChatService/SetChatNotifications"
+	ChatService_LeaveChat_FullMethodName                = "/ChatService/LeaveChat"
+	ChatService_CreateGroupChat_FullMethodName          = "/ChatService/CreateGroupChat"
+	ChatService_AddGroupMembers_FullMethodName          = "/ChatService/AddGroupMembers"
+	ChatService_RemoveGroupMember_FullMethodName        = "/ChatService/RemoveGroupMember"
+	ChatService_CreateInviteLink_FullMethodName         = "/ChatService/CreateInviteLink"
+	ChatService_RevokeInviteLink_FullMethodName         = "/ChatService/RevokeInviteLink"
+	ChatService_ListInviteLinks_FullMethodName          = "/ChatService/ListInviteLinks"
+	ChatService_JoinByInvite_FullMethodName             = "/ChatService/JoinByInvite"
+	ChatService_CreateChannel_FullMethodName            = "/ChatService/CreateChannel"
+	ChatService_SubscribeChannel_FullMethodName         = "/ChatService/SubscribeChannel"
+	ChatService_UnsubscribeChannel_FullMethodName       = "/ChatService/UnsubscribeChannel"
+	ChatService_PublishToChannel_FullMethodName         = "/ChatService/PublishToChannel"
+	ChatService_BanMember_FullMethodName                = "/ChatService/BanMember"
+	ChatService_UnbanMember_FullMethodName              = "/ChatService/UnbanMember"
+	ChatService_KickMember_FullMethodName               = "/ChatService/KickMember"
+	ChatService_GetOrCreateCommentStream_FullMethodName = "/ChatService/GetOrCreateCommentStream"
+	ChatService_GetComments_FullMethodName              = "/ChatService/GetComments"
+	ChatService_PostComment_FullMethodName              = "/ChatService/PostComment"
+	ChatService_MarkMessagesRead_FullMethodName         = "/ChatService/MarkMessagesRead"
+	ChatService_GetMessageReaders_FullMethodName        = "/ChatService/GetMessageReaders"
+	ChatService_AddReaction_FullMethodName              = "/ChatService/AddReaction"
+	ChatService_RemoveReaction_FullMethodName           = "/ChatService/RemoveReaction"
+	ChatService_PinMessage_FullMethodName               = "/ChatService/PinMessage"
+	ChatService_UnpinMessage_FullMethodName             = "/ChatService/UnpinMessage"
+	ChatService_ListPinnedMessages_FullMethodName       = "/ChatService/ListPinnedMessages"
+	ChatService_ForwardMessages_FullMethodName          = "/ChatService/ForwardMessages"
+	ChatService_SearchMessages_FullMethodName           = "/ChatService/SearchMessages"
 	ChatService_GetPrivateRecentMessages_FullMethodName = "/ChatService/GetPrivateRecentMessages"
+	ChatService_SendPrivateMessage_FullMethodName       = "/ChatService/SendPrivateMessage"
 	ChatService_DeletePrivateMessage_FullMethodName     = "/ChatService/DeletePrivateMessage"
 	ChatService_EditPrivateMessage_FullMethodName       = "/ChatService/EditPrivateMessage"
+	ChatService_GetMessageRevisions_FullMethodName      = "/ChatService/GetMessageRevisions"
 )
 
 // ChatServiceClient is the client API for ChatService service.
@@ -33,9 +68,44 @@ const (
 type ChatServiceClient interface {
 	CreatePrivateChat(ctx context.Context, in *CreatePrivateChatIn, opts ...grpc.CallOption) (*CreatePrivateChatOut, error)
 	GetChats(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*GetChatsOut, error)
+	SubscribeEvents(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (grpc.ServerStreamingClient[Event], error)
+	SetTyping(ctx context.Context, in *SetTypingIn, opts ...grpc.CallOption) (*SetTypingOut, error)
+	WatchTyping(ctx context.Context, in *WatchTypingIn, opts ...grpc.CallOption) (grpc.ServerStreamingClient[TypingUpdate], error)
+	Heartbeat(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*HeartbeatOut, error)
+	GetPresence(ctx context.Context, in *GetPresenceIn, opts ...grpc.CallOption) (*GetPresenceOut, error)
+	SetChatNotifications(ctx context.Context, in *SetChatNotificationsIn, opts ...grpc.CallOption) (*SetChatNotificationsOut, error)
+	LeaveChat(ctx context.Context, in *LeaveChatIn, opts ...grpc.CallOption) (*LeaveChatOut, error)
+	CreateGroupChat(ctx context.Context, in *CreateGroupChatIn, opts ...grpc.CallOption) (*CreateGroupChatOut, error)
+	AddGroupMembers(ctx context.Context, in *AddGroupMembersIn, opts ...grpc.CallOption) (*AddGroupMembersOut, error)
+	RemoveGroupMember(ctx context.Context, in *RemoveGroupMemberIn, opts ...grpc.CallOption) (*RemoveGroupMemberOut, error)
+	CreateInviteLink(ctx context.Context, in *CreateInviteLinkIn, opts ...grpc.CallOption) (*CreateInviteLinkOut, error)
+	RevokeInviteLink(ctx context.Context, in *RevokeInviteLinkIn, opts ...grpc.CallOption) (*RevokeInviteLinkOut, error)
+	ListInviteLinks(ctx context.Context, in *ListInviteLinksIn, opts ...grpc.CallOption) (*ListInviteLinksOut, error)
+	JoinByInvite(ctx context.Context, in *JoinByInviteIn, opts ...grpc.CallOption) (*JoinByInviteOut, error)
+	CreateChannel(ctx context.Context, in *CreateChannelIn, opts ...grpc.CallOption) (*CreateChannelOut, error)
+	SubscribeChannel(ctx context.Context, in *SubscribeChannelIn, opts ...grpc.CallOption) (*SubscribeChannelOut, error)
+	UnsubscribeChannel(ctx context.Context, in *UnsubscribeChannelIn, opts ...grpc.CallOption) (*UnsubscribeChannelOut, error)
+	PublishToChannel(ctx context.Context, in *PublishToChannelIn, opts ...grpc.CallOption) (*PublishToChannelOut, error)
+	BanMember(ctx context.Context, in *BanMemberIn, opts ...grpc.CallOption) (*BanMemberOut, error)
+	UnbanMember(ctx context.Context, in *UnbanMemberIn, opts ...grpc.CallOption) (*UnbanMemberOut, error)
+	KickMember(ctx context.Context, in *KickMemberIn, opts ...grpc.CallOption) (*KickMemberOut, error)
+	GetOrCreateCommentStream(ctx context.Context, in *GetOrCreateCommentStreamIn, opts ...grpc.CallOption) (*GetOrCreateCommentStreamOut, error)
+	GetComments(ctx context.Context, in *GetCommentsIn, opts ...grpc.CallOption) (*GetCommentsOut, error)
+	PostComment(ctx context.Context, in *PostCommentIn, opts ...grpc.CallOption) (*PostCommentOut, error)
+	MarkMessagesRead(ctx context.Context, in *MarkMessagesReadIn, opts ...grpc.CallOption) (*MarkMessagesReadOut, error)
+	GetMessageReaders(ctx context.Context, in *GetMessageReadersIn, opts ...grpc.CallOption) (*GetMessageReadersOut, error)
+	AddReaction(ctx context.Context, in *AddReactionIn, opts ...grpc.CallOption) (*AddReactionOut, error)
+	RemoveReaction(ctx context.Context, in *RemoveReactionIn, opts ...grpc.CallOption) (*RemoveReactionOut, error)
+	PinMessage(ctx context.Context, in *PinMessageIn, opts ...grpc.CallOption) (*PinMessageOut, error)
+	UnpinMessage(ctx context.Context, in *UnpinMessageIn, opts ...grpc.CallOption) (*UnpinMessageOut, error)
+	ListPinnedMessages(ctx context.Context, in *ListPinnedMessagesIn, opts ...grpc.CallOption) (*ListPinnedMessagesOut, error)
+	ForwardMessages(ctx context.Context, in *ForwardMessagesIn, opts ...grpc.CallOption) (*ForwardMessagesOut, error)
+	SearchMessages(ctx context.Context, in *SearchMessagesIn, opts ...grpc.CallOption) (*SearchMessagesOut, error)
 	GetPrivateRecentMessages(ctx context.Context, in *GetPrivateRecentMessagesIn, opts ...grpc.CallOption) (*GetPrivateRecentMessagesOut, error)
+	SendPrivateMessage(ctx context.Context, in *SendPrivateMessageIn, opts ...grpc.CallOption) (*SendPrivateMessageOut, error)
 	DeletePrivateMessage(ctx context.Context, in *DeletePrivateMessageIn, opts ...grpc.CallOption) (*DeletePrivateMessageOut, error)
 	EditPrivateMessage(ctx context.Context, in *EditPrivateMessageIn, opts ...grpc.CallOption) (*EditPrivateMessageOut, error)
+	GetMessageRevisions(ctx context.Context, in *GetMessageRevisionsIn, opts ...grpc.CallOption) (*GetMessageRevisionsOut, error)
 }
 
 type chatServiceClient struct {
@@ -66,6 +136,354 @@ func (c *chatServiceClient) GetChats(ctx context.Context, in *emptypb.Empty, opt
 	return out, nil
 }
 
+func (c *chatServiceClient) SubscribeEvents(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (grpc.ServerStreamingClient[Event], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &ChatService_ServiceDesc.Streams[0], ChatService_SubscribeEvents_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[emptypb.Empty, Event]{ClientStream: stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type ChatService_SubscribeEventsClient = grpc.ServerStreamingClient[Event]
+
+func (c *chatServiceClient) SetTyping(ctx context.Context, in *SetTypingIn, opts ...grpc.CallOption) (*SetTypingOut, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SetTypingOut)
+	err := c.cc.Invoke(ctx, ChatService_SetTyping_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *chatServiceClient) WatchTyping(ctx context.Context, in *WatchTypingIn, opts ...grpc.CallOption) (grpc.ServerStreamingClient[TypingUpdate], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &ChatService_ServiceDesc.Streams[1], ChatService_WatchTyping_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[WatchTypingIn, TypingUpdate]{ClientStream: stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type ChatService_WatchTypingClient = grpc.ServerStreamingClient[TypingUpdate]
+
+func (c *chatServiceClient) Heartbeat(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*HeartbeatOut, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(HeartbeatOut)
+	err := c.cc.Invoke(ctx, ChatService_Heartbeat_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *chatServiceClient) GetPresence(ctx context.Context, in *GetPresenceIn, opts ...grpc.CallOption) (*GetPresenceOut, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetPresenceOut)
+	err := c.cc.Invoke(ctx, ChatService_GetPresence_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *chatServiceClient) SetChatNotifications(ctx context.Context, in *SetChatNotificationsIn, opts ...grpc.CallOption) (*SetChatNotificationsOut, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SetChatNotificationsOut)
+	err := c.cc.Invoke(ctx, ChatService_SetChatNotifications_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *chatServiceClient) LeaveChat(ctx context.Context, in *LeaveChatIn, opts ...grpc.CallOption) (*LeaveChatOut, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(LeaveChatOut)
+	err := c.cc.Invoke(ctx, ChatService_LeaveChat_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *chatServiceClient) CreateGroupChat(ctx context.Context, in *CreateGroupChatIn, opts ...grpc.CallOption) (*CreateGroupChatOut, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CreateGroupChatOut)
+	err := c.cc.Invoke(ctx, ChatService_CreateGroupChat_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *chatServiceClient) AddGroupMembers(ctx context.Context, in *AddGroupMembersIn, opts ...grpc.CallOption) (*AddGroupMembersOut, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(AddGroupMembersOut)
+	err := c.cc.Invoke(ctx, ChatService_AddGroupMembers_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *chatServiceClient) RemoveGroupMember(ctx context.Context, in *RemoveGroupMemberIn, opts ...grpc.CallOption) (*RemoveGroupMemberOut, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RemoveGroupMemberOut)
+	err := c.cc.Invoke(ctx, ChatService_RemoveGroupMember_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *chatServiceClient) CreateInviteLink(ctx context.Context, in *CreateInviteLinkIn, opts ...grpc.CallOption) (*CreateInviteLinkOut, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CreateInviteLinkOut)
+	err := c.cc.Invoke(ctx, ChatService_CreateInviteLink_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *chatServiceClient) RevokeInviteLink(ctx context.Context, in *RevokeInviteLinkIn, opts ...grpc.CallOption) (*RevokeInviteLinkOut, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RevokeInviteLinkOut)
+	err := c.cc.Invoke(ctx, ChatService_RevokeInviteLink_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *chatServiceClient) ListInviteLinks(ctx context.Context, in *ListInviteLinksIn, opts ...grpc.CallOption) (*ListInviteLinksOut, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListInviteLinksOut)
+	err := c.cc.Invoke(ctx, ChatService_ListInviteLinks_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *chatServiceClient) JoinByInvite(ctx context.Context, in *JoinByInviteIn, opts ...grpc.CallOption) (*JoinByInviteOut, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(JoinByInviteOut)
+	err := c.cc.Invoke(ctx, ChatService_JoinByInvite_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *chatServiceClient) CreateChannel(ctx context.Context, in *CreateChannelIn, opts ...grpc.CallOption) (*CreateChannelOut, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CreateChannelOut)
+	err := c.cc.Invoke(ctx, ChatService_CreateChannel_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *chatServiceClient) SubscribeChannel(ctx context.Context, in *SubscribeChannelIn, opts ...grpc.CallOption) (*SubscribeChannelOut, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SubscribeChannelOut)
+	err := c.cc.Invoke(ctx, ChatService_SubscribeChannel_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *chatServiceClient) UnsubscribeChannel(ctx context.Context, in *UnsubscribeChannelIn, opts ...grpc.CallOption) (*UnsubscribeChannelOut, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UnsubscribeChannelOut)
+	err := c.cc.Invoke(ctx, ChatService_UnsubscribeChannel_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *chatServiceClient) PublishToChannel(ctx context.Context, in *PublishToChannelIn, opts ...grpc.CallOption) (*PublishToChannelOut, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(PublishToChannelOut)
+	err := c.cc.Invoke(ctx, ChatService_PublishToChannel_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *chatServiceClient) BanMember(ctx context.Context, in *BanMemberIn, opts ...grpc.CallOption) (*BanMemberOut, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(BanMemberOut)
+	err := c.cc.Invoke(ctx, ChatService_BanMember_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *chatServiceClient) UnbanMember(ctx context.Context, in *UnbanMemberIn, opts ...grpc.CallOption) (*UnbanMemberOut, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UnbanMemberOut)
+	err := c.cc.Invoke(ctx, ChatService_UnbanMember_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *chatServiceClient) KickMember(ctx context.Context, in *KickMemberIn, opts ...grpc.CallOption) (*KickMemberOut, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(KickMemberOut)
+	err := c.cc.Invoke(ctx, ChatService_KickMember_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *chatServiceClient) GetOrCreateCommentStream(ctx context.Context, in *GetOrCreateCommentStreamIn, opts ...grpc.CallOption) (*GetOrCreateCommentStreamOut, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetOrCreateCommentStreamOut)
+	err := c.cc.Invoke(ctx, ChatService_GetOrCreateCommentStream_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *chatServiceClient) GetComments(ctx context.Context, in *GetCommentsIn, opts ...grpc.CallOption) (*GetCommentsOut, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetCommentsOut)
+	err := c.cc.Invoke(ctx, ChatService_GetComments_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *chatServiceClient) PostComment(ctx context.Context, in *PostCommentIn, opts ...grpc.CallOption) (*PostCommentOut, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(PostCommentOut)
+	err := c.cc.Invoke(ctx, ChatService_PostComment_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *chatServiceClient) MarkMessagesRead(ctx context.Context, in *MarkMessagesReadIn, opts ...grpc.CallOption) (*MarkMessagesReadOut, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(MarkMessagesReadOut)
+	err := c.cc.Invoke(ctx, ChatService_MarkMessagesRead_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *chatServiceClient) GetMessageReaders(ctx context.Context, in *GetMessageReadersIn, opts ...grpc.CallOption) (*GetMessageReadersOut, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetMessageReadersOut)
+	err := c.cc.Invoke(ctx, ChatService_GetMessageReaders_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *chatServiceClient) AddReaction(ctx context.Context, in *AddReactionIn, opts ...grpc.CallOption) (*AddReactionOut, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(AddReactionOut)
+	err := c.cc.Invoke(ctx, ChatService_AddReaction_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *chatServiceClient) RemoveReaction(ctx context.Context, in *RemoveReactionIn, opts ...grpc.CallOption) (*RemoveReactionOut, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RemoveReactionOut)
+	err := c.cc.Invoke(ctx, ChatService_RemoveReaction_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *chatServiceClient) PinMessage(ctx context.Context, in *PinMessageIn, opts ...grpc.CallOption) (*PinMessageOut, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(PinMessageOut)
+	err := c.cc.Invoke(ctx, ChatService_PinMessage_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *chatServiceClient) UnpinMessage(ctx context.Context, in *UnpinMessageIn, opts ...grpc.CallOption) (*UnpinMessageOut, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UnpinMessageOut)
+	err := c.cc.Invoke(ctx, ChatService_UnpinMessage_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *chatServiceClient) ListPinnedMessages(ctx context.Context, in *ListPinnedMessagesIn, opts ...grpc.CallOption) (*ListPinnedMessagesOut, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListPinnedMessagesOut)
+	err := c.cc.Invoke(ctx, ChatService_ListPinnedMessages_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *chatServiceClient) ForwardMessages(ctx context.Context, in *ForwardMessagesIn, opts ...grpc.CallOption) (*ForwardMessagesOut, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ForwardMessagesOut)
+	err := c.cc.Invoke(ctx, ChatService_ForwardMessages_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *chatServiceClient) SearchMessages(ctx context.Context, in *SearchMessagesIn, opts ...grpc.CallOption) (*SearchMessagesOut, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SearchMessagesOut)
+	err := c.cc.Invoke(ctx, ChatService_SearchMessages_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *chatServiceClient) GetPrivateRecentMessages(ctx context.Context, in *GetPrivateRecentMessagesIn, opts ...grpc.CallOption) (*GetPrivateRecentMessagesOut, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(GetPrivateRecentMessagesOut)
@@ -73,116 +491,856 @@ func (c *chatServiceClient) GetPrivateRecentMessages(ctx context.Context, in *Ge
 	if err != nil {
 		return nil, err
 	}
-	return out, nil
+	return out, nil
+}
+
+func (c *chatServiceClient) SendPrivateMessage(ctx context.Context, in *SendPrivateMessageIn, opts ...grpc.CallOption) (*SendPrivateMessageOut, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SendPrivateMessageOut)
+	err := c.cc.Invoke(ctx, ChatService_SendPrivateMessage_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *chatServiceClient) DeletePrivateMessage(ctx context.Context, in *DeletePrivateMessageIn, opts ...grpc.CallOption) (*DeletePrivateMessageOut, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DeletePrivateMessageOut)
+	err := c.cc.Invoke(ctx, ChatService_DeletePrivateMessage_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *chatServiceClient) EditPrivateMessage(ctx context.Context, in *EditPrivateMessageIn, opts ...grpc.CallOption) (*EditPrivateMessageOut, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(EditPrivateMessageOut)
+	err := c.cc.Invoke(ctx, ChatService_EditPrivateMessage_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *chatServiceClient) GetMessageRevisions(ctx context.Context, in *GetMessageRevisionsIn, opts ...grpc.CallOption) (*GetMessageRevisionsOut, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetMessageRevisionsOut)
+	err := c.cc.Invoke(ctx, ChatService_GetMessageRevisions_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// ChatServiceServer is the server API for ChatService service.
+// All implementations must embed UnimplementedChatServiceServer
+// for forward compatibility.
+type ChatServiceServer interface {
+	CreatePrivateChat(context.Context, *CreatePrivateChatIn) (*CreatePrivateChatOut, error)
+	GetChats(context.Context, *emptypb.Empty) (*GetChatsOut, error)
+	SubscribeEvents(*emptypb.Empty, grpc.ServerStreamingServer[Event]) error
+	SetTyping(context.Context, *SetTypingIn) (*SetTypingOut, error)
+	WatchTyping(*WatchTypingIn, grpc.ServerStreamingServer[TypingUpdate]) error
+	Heartbeat(context.Context, *emptypb.Empty) (*HeartbeatOut, error)
+	GetPresence(context.Context, *GetPresenceIn) (*GetPresenceOut, error)
+	SetChatNotifications(context.Context, *SetChatNotificationsIn) (*SetChatNotificationsOut, error)
+	LeaveChat(context.Context, *LeaveChatIn) (*LeaveChatOut, error)
+	CreateGroupChat(context.Context, *CreateGroupChatIn) (*CreateGroupChatOut, error)
+	AddGroupMembers(context.Context, *AddGroupMembersIn) (*AddGroupMembersOut, error)
+	RemoveGroupMember(context.Context, *RemoveGroupMemberIn) (*RemoveGroupMemberOut, error)
+	CreateInviteLink(context.Context, *CreateInviteLinkIn) (*CreateInviteLinkOut, error)
+	RevokeInviteLink(context.Context, *RevokeInviteLinkIn) (*RevokeInviteLinkOut, error)
+	ListInviteLinks(context.Context, *ListInviteLinksIn) (*ListInviteLinksOut, error)
+	JoinByInvite(context.Context, *JoinByInviteIn) (*JoinByInviteOut, error)
+	CreateChannel(context.Context, *CreateChannelIn) (*CreateChannelOut, error)
+	SubscribeChannel(context.Context, *SubscribeChannelIn) (*SubscribeChannelOut, error)
+	UnsubscribeChannel(context.Context, *UnsubscribeChannelIn) (*UnsubscribeChannelOut, error)
+	PublishToChannel(context.Context, *PublishToChannelIn) (*PublishToChannelOut, error)
+	BanMember(context.Context, *BanMemberIn) (*BanMemberOut, error)
+	UnbanMember(context.Context, *UnbanMemberIn) (*UnbanMemberOut, error)
+	KickMember(context.Context, *KickMemberIn) (*KickMemberOut, error)
+	GetOrCreateCommentStream(context.Context, *GetOrCreateCommentStreamIn) (*GetOrCreateCommentStreamOut, error)
+	GetComments(context.Context, *GetCommentsIn) (*GetCommentsOut, error)
+	PostComment(context.Context, *PostCommentIn) (*PostCommentOut, error)
+	MarkMessagesRead(context.Context, *MarkMessagesReadIn) (*MarkMessagesReadOut, error)
+	GetMessageReaders(context.Context, *GetMessageReadersIn) (*GetMessageReadersOut, error)
+	AddReaction(context.Context, *AddReactionIn) (*AddReactionOut, error)
+	RemoveReaction(context.Context, *RemoveReactionIn) (*RemoveReactionOut, error)
+	PinMessage(context.Context, *PinMessageIn) (*PinMessageOut, error)
+	UnpinMessage(context.Context, *UnpinMessageIn) (*UnpinMessageOut, error)
+	ListPinnedMessages(context.Context, *ListPinnedMessagesIn) (*ListPinnedMessagesOut, error)
+	ForwardMessages(context.Context, *ForwardMessagesIn) (*ForwardMessagesOut, error)
+	SearchMessages(context.Context, *SearchMessagesIn) (*SearchMessagesOut, error)
+	GetPrivateRecentMessages(context.Context, *GetPrivateRecentMessagesIn) (*GetPrivateRecentMessagesOut, error)
+	SendPrivateMessage(context.Context, *SendPrivateMessageIn) (*SendPrivateMessageOut, error)
+	DeletePrivateMessage(context.Context, *DeletePrivateMessageIn) (*DeletePrivateMessageOut, error)
+	EditPrivateMessage(context.Context, *EditPrivateMessageIn) (*EditPrivateMessageOut, error)
+	GetMessageRevisions(context.Context, *GetMessageRevisionsIn) (*GetMessageRevisionsOut, error)
+	mustEmbedUnimplementedChatServiceServer()
+}
+
+// UnimplementedChatServiceServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedChatServiceServer struct{}
+
+func (UnimplementedChatServiceServer) CreatePrivateChat(context.Context, *CreatePrivateChatIn) (*CreatePrivateChatOut, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreatePrivateChat not implemented")
+}
+func (UnimplementedChatServiceServer) GetChats(context.Context, *emptypb.Empty) (*GetChatsOut, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetChats not implemented")
+}
+func (UnimplementedChatServiceServer) SubscribeEvents(*emptypb.Empty, grpc.ServerStreamingServer[Event]) error {
+	return status.Errorf(codes.Unimplemented, "method SubscribeEvents not implemented")
+}
+func (UnimplementedChatServiceServer) SetTyping(context.Context, *SetTypingIn) (*SetTypingOut, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetTyping not implemented")
+}
+func (UnimplementedChatServiceServer) WatchTyping(*WatchTypingIn, grpc.ServerStreamingServer[TypingUpdate]) error {
+	return status.Errorf(codes.Unimplemented, "method WatchTyping not implemented")
+}
+func (UnimplementedChatServiceServer) Heartbeat(context.Context, *emptypb.Empty) (*HeartbeatOut, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Heartbeat not implemented")
+}
+func (UnimplementedChatServiceServer) GetPresence(context.Context, *GetPresenceIn) (*GetPresenceOut, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetPresence not implemented")
+}
+func (UnimplementedChatServiceServer) SetChatNotifications(context.Context, *SetChatNotificationsIn) (*SetChatNotificationsOut, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetChatNotifications not implemented")
+}
+func (UnimplementedChatServiceServer) LeaveChat(context.Context, *LeaveChatIn) (*LeaveChatOut, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method LeaveChat not implemented")
+}
+func (UnimplementedChatServiceServer) CreateGroupChat(context.Context, *CreateGroupChatIn) (*CreateGroupChatOut, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateGroupChat not implemented")
+}
+func (UnimplementedChatServiceServer) AddGroupMembers(context.Context, *AddGroupMembersIn) (*AddGroupMembersOut, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddGroupMembers not implemented")
+}
+func (UnimplementedChatServiceServer) RemoveGroupMember(context.Context, *RemoveGroupMemberIn) (*RemoveGroupMemberOut, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RemoveGroupMember not implemented")
+}
+func (UnimplementedChatServiceServer) CreateInviteLink(context.Context, *CreateInviteLinkIn) (*CreateInviteLinkOut, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateInviteLink not implemented")
+}
+func (UnimplementedChatServiceServer) RevokeInviteLink(context.Context, *RevokeInviteLinkIn) (*RevokeInviteLinkOut, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RevokeInviteLink not implemented")
+}
+func (UnimplementedChatServiceServer) ListInviteLinks(context.Context, *ListInviteLinksIn) (*ListInviteLinksOut, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListInviteLinks not implemented")
+}
+func (UnimplementedChatServiceServer) JoinByInvite(context.Context, *JoinByInviteIn) (*JoinByInviteOut, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method JoinByInvite not implemented")
+}
+func (UnimplementedChatServiceServer) CreateChannel(context.Context, *CreateChannelIn) (*CreateChannelOut, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateChannel not implemented")
+}
+func (UnimplementedChatServiceServer) SubscribeChannel(context.Context, *SubscribeChannelIn) (*SubscribeChannelOut, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SubscribeChannel not implemented")
+}
+func (UnimplementedChatServiceServer) UnsubscribeChannel(context.Context, *UnsubscribeChannelIn) (*UnsubscribeChannelOut, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UnsubscribeChannel not implemented")
+}
+func (UnimplementedChatServiceServer) PublishToChannel(context.Context, *PublishToChannelIn) (*PublishToChannelOut, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PublishToChannel not implemented")
+}
+func (UnimplementedChatServiceServer) BanMember(context.Context, *BanMemberIn) (*BanMemberOut, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BanMember not implemented")
+}
+func (UnimplementedChatServiceServer) UnbanMember(context.Context, *UnbanMemberIn) (*UnbanMemberOut, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UnbanMember not implemented")
+}
+func (UnimplementedChatServiceServer) KickMember(context.Context, *KickMemberIn) (*KickMemberOut, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method KickMember not implemented")
+}
+func (UnimplementedChatServiceServer) GetOrCreateCommentStream(context.Context, *GetOrCreateCommentStreamIn) (*GetOrCreateCommentStreamOut, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetOrCreateCommentStream not implemented")
+}
+func (UnimplementedChatServiceServer) GetComments(context.Context, *GetCommentsIn) (*GetCommentsOut, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetComments not implemented")
+}
+func (UnimplementedChatServiceServer) PostComment(context.Context, *PostCommentIn) (*PostCommentOut, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PostComment not implemented")
+}
+func (UnimplementedChatServiceServer) MarkMessagesRead(context.Context, *MarkMessagesReadIn) (*MarkMessagesReadOut, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MarkMessagesRead not implemented")
+}
+func (UnimplementedChatServiceServer) GetMessageReaders(context.Context, *GetMessageReadersIn) (*GetMessageReadersOut, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetMessageReaders not implemented")
+}
+func (UnimplementedChatServiceServer) AddReaction(context.Context, *AddReactionIn) (*AddReactionOut, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddReaction not implemented")
+}
+func (UnimplementedChatServiceServer) RemoveReaction(context.Context, *RemoveReactionIn) (*RemoveReactionOut, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RemoveReaction not implemented")
+}
+func (UnimplementedChatServiceServer) PinMessage(context.Context, *PinMessageIn) (*PinMessageOut, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PinMessage not implemented")
+}
+func (UnimplementedChatServiceServer) UnpinMessage(context.Context, *UnpinMessageIn) (*UnpinMessageOut, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UnpinMessage not implemented")
+}
+func (UnimplementedChatServiceServer) ListPinnedMessages(context.Context, *ListPinnedMessagesIn) (*ListPinnedMessagesOut, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListPinnedMessages not implemented")
+}
+func (UnimplementedChatServiceServer) ForwardMessages(context.Context, *ForwardMessagesIn) (*ForwardMessagesOut, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ForwardMessages not implemented")
+}
+func (UnimplementedChatServiceServer) SearchMessages(context.Context, *SearchMessagesIn) (*SearchMessagesOut, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SearchMessages not implemented")
+}
+func (UnimplementedChatServiceServer) GetPrivateRecentMessages(context.Context, *GetPrivateRecentMessagesIn) (*GetPrivateRecentMessagesOut, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetPrivateRecentMessages not implemented")
+}
+func (UnimplementedChatServiceServer) SendPrivateMessage(context.Context, *SendPrivateMessageIn) (*SendPrivateMessageOut, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SendPrivateMessage not implemented")
+}
+func (UnimplementedChatServiceServer) DeletePrivateMessage(context.Context, *DeletePrivateMessageIn) (*DeletePrivateMessageOut, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeletePrivateMessage not implemented")
+}
+func (UnimplementedChatServiceServer) EditPrivateMessage(context.Context, *EditPrivateMessageIn) (*EditPrivateMessageOut, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method EditPrivateMessage not implemented")
+}
+func (UnimplementedChatServiceServer) GetMessageRevisions(context.Context, *GetMessageRevisionsIn) (*GetMessageRevisionsOut, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetMessageRevisions not implemented")
+}
+func (UnimplementedChatServiceServer) mustEmbedUnimplementedChatServiceServer() {}
+func (UnimplementedChatServiceServer) testEmbeddedByValue()                     {}
+
+// UnsafeChatServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to ChatServiceServer will
+// result in compilation errors.
+type UnsafeChatServiceServer interface {
+	mustEmbedUnimplementedChatServiceServer()
+}
+
+func RegisterChatServiceServer(s grpc.ServiceRegistrar, srv ChatServiceServer) {
+	// If the following call pancis, it indicates UnimplementedChatServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&ChatService_ServiceDesc, srv)
+}
+
+func _ChatService_CreatePrivateChat_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreatePrivateChatIn)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ChatServiceServer).CreatePrivateChat(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ChatService_CreatePrivateChat_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ChatServiceServer).CreatePrivateChat(ctx, req.(*CreatePrivateChatIn))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ChatService_GetChats_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(emptypb.Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ChatServiceServer).GetChats(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ChatService_GetChats_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ChatServiceServer).GetChats(ctx, req.(*emptypb.Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ChatService_SubscribeEvents_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(emptypb.Empty)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(ChatServiceServer).SubscribeEvents(m, &grpc.GenericServerStream[emptypb.Empty, Event]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type ChatService_SubscribeEventsServer = grpc.ServerStreamingServer[Event]
+
+func _ChatService_SetTyping_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetTypingIn)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ChatServiceServer).SetTyping(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ChatService_SetTyping_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ChatServiceServer).SetTyping(ctx, req.(*SetTypingIn))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ChatService_WatchTyping_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(WatchTypingIn)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(ChatServiceServer).WatchTyping(m, &grpc.GenericServerStream[WatchTypingIn, TypingUpdate]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type ChatService_WatchTypingServer = grpc.ServerStreamingServer[TypingUpdate]
+
+func _ChatService_Heartbeat_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(emptypb.Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ChatServiceServer).Heartbeat(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ChatService_Heartbeat_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ChatServiceServer).Heartbeat(ctx, req.(*emptypb.Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ChatService_GetPresence_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetPresenceIn)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ChatServiceServer).GetPresence(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ChatService_GetPresence_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ChatServiceServer).GetPresence(ctx, req.(*GetPresenceIn))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ChatService_SetChatNotifications_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetChatNotificationsIn)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ChatServiceServer).SetChatNotifications(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ChatService_SetChatNotifications_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ChatServiceServer).SetChatNotifications(ctx, req.(*SetChatNotificationsIn))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ChatService_LeaveChat_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(LeaveChatIn)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ChatServiceServer).LeaveChat(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ChatService_LeaveChat_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ChatServiceServer).LeaveChat(ctx, req.(*LeaveChatIn))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ChatService_CreateGroupChat_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateGroupChatIn)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ChatServiceServer).CreateGroupChat(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ChatService_CreateGroupChat_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ChatServiceServer).CreateGroupChat(ctx, req.(*CreateGroupChatIn))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ChatService_AddGroupMembers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AddGroupMembersIn)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ChatServiceServer).AddGroupMembers(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ChatService_AddGroupMembers_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ChatServiceServer).AddGroupMembers(ctx, req.(*AddGroupMembersIn))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ChatService_RemoveGroupMember_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RemoveGroupMemberIn)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ChatServiceServer).RemoveGroupMember(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ChatService_RemoveGroupMember_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ChatServiceServer).RemoveGroupMember(ctx, req.(*RemoveGroupMemberIn))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ChatService_CreateInviteLink_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateInviteLinkIn)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ChatServiceServer).CreateInviteLink(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ChatService_CreateInviteLink_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ChatServiceServer).CreateInviteLink(ctx, req.(*CreateInviteLinkIn))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ChatService_RevokeInviteLink_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RevokeInviteLinkIn)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ChatServiceServer).RevokeInviteLink(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ChatService_RevokeInviteLink_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ChatServiceServer).RevokeInviteLink(ctx, req.(*RevokeInviteLinkIn))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ChatService_ListInviteLinks_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListInviteLinksIn)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ChatServiceServer).ListInviteLinks(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ChatService_ListInviteLinks_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ChatServiceServer).ListInviteLinks(ctx, req.(*ListInviteLinksIn))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ChatService_JoinByInvite_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(JoinByInviteIn)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ChatServiceServer).JoinByInvite(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ChatService_JoinByInvite_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ChatServiceServer).JoinByInvite(ctx, req.(*JoinByInviteIn))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ChatService_CreateChannel_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateChannelIn)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ChatServiceServer).CreateChannel(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ChatService_CreateChannel_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ChatServiceServer).CreateChannel(ctx, req.(*CreateChannelIn))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ChatService_SubscribeChannel_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SubscribeChannelIn)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ChatServiceServer).SubscribeChannel(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ChatService_SubscribeChannel_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ChatServiceServer).SubscribeChannel(ctx, req.(*SubscribeChannelIn))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ChatService_UnsubscribeChannel_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UnsubscribeChannelIn)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ChatServiceServer).UnsubscribeChannel(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ChatService_UnsubscribeChannel_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ChatServiceServer).UnsubscribeChannel(ctx, req.(*UnsubscribeChannelIn))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ChatService_PublishToChannel_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PublishToChannelIn)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ChatServiceServer).PublishToChannel(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ChatService_PublishToChannel_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ChatServiceServer).PublishToChannel(ctx, req.(*PublishToChannelIn))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ChatService_BanMember_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BanMemberIn)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ChatServiceServer).BanMember(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ChatService_BanMember_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ChatServiceServer).BanMember(ctx, req.(*BanMemberIn))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ChatService_UnbanMember_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UnbanMemberIn)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ChatServiceServer).UnbanMember(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ChatService_UnbanMember_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ChatServiceServer).UnbanMember(ctx, req.(*UnbanMemberIn))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ChatService_KickMember_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(KickMemberIn)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ChatServiceServer).KickMember(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ChatService_KickMember_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ChatServiceServer).KickMember(ctx, req.(*KickMemberIn))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ChatService_GetOrCreateCommentStream_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetOrCreateCommentStreamIn)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ChatServiceServer).GetOrCreateCommentStream(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ChatService_GetOrCreateCommentStream_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ChatServiceServer).GetOrCreateCommentStream(ctx, req.(*GetOrCreateCommentStreamIn))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ChatService_GetComments_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetCommentsIn)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ChatServiceServer).GetComments(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ChatService_GetComments_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ChatServiceServer).GetComments(ctx, req.(*GetCommentsIn))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (c *chatServiceClient) DeletePrivateMessage(ctx context.Context, in *DeletePrivateMessageIn, opts ...grpc.CallOption) (*DeletePrivateMessageOut, error) {
-	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
-	out := new(DeletePrivateMessageOut)
-	err := c.cc.Invoke(ctx, ChatService_DeletePrivateMessage_FullMethodName, in, out, cOpts...)
-	if err != nil {
+func _ChatService_PostComment_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PostCommentIn)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return out, nil
+	if interceptor == nil {
+		return srv.(ChatServiceServer).PostComment(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ChatService_PostComment_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ChatServiceServer).PostComment(ctx, req.(*PostCommentIn))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (c *chatServiceClient) EditPrivateMessage(ctx context.Context, in *EditPrivateMessageIn, opts ...grpc.CallOption) (*EditPrivateMessageOut, error) {
-	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
-	out := new(EditPrivateMessageOut)
-	err := c.cc.Invoke(ctx, ChatService_EditPrivateMessage_FullMethodName, in, out, cOpts...)
-	if err != nil {
+func _ChatService_MarkMessagesRead_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MarkMessagesReadIn)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return out, nil
+	if interceptor == nil {
+		return srv.(ChatServiceServer).MarkMessagesRead(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ChatService_MarkMessagesRead_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ChatServiceServer).MarkMessagesRead(ctx, req.(*MarkMessagesReadIn))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-// ChatServiceServer is the server API for ChatService service.
-// All implementations must embed UnimplementedChatServiceServer
-// for forward compatibility.
-type ChatServiceServer interface {
-	CreatePrivateChat(context.Context, *CreatePrivateChatIn) (*CreatePrivateChatOut, error)
-	GetChats(context.Context, *emptypb.Empty) (*GetChatsOut, error)
-	GetPrivateRecentMessages(context.Context, *GetPrivateRecentMessagesIn) (*GetPrivateRecentMessagesOut, error)
-	DeletePrivateMessage(context.Context, *DeletePrivateMessageIn) (*DeletePrivateMessageOut, error)
-	EditPrivateMessage(context.Context, *EditPrivateMessageIn) (*EditPrivateMessageOut, error)
-	mustEmbedUnimplementedChatServiceServer()
+func _ChatService_GetMessageReaders_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetMessageReadersIn)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ChatServiceServer).GetMessageReaders(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ChatService_GetMessageReaders_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ChatServiceServer).GetMessageReaders(ctx, req.(*GetMessageReadersIn))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-// UnimplementedChatServiceServer must be embedded to have
-// forward compatible implementations.
-//
-// NOTE: this should be embedded by value instead of pointer to avoid a nil
-// pointer dereference when methods are called.
-type UnimplementedChatServiceServer struct{}
-
-func (UnimplementedChatServiceServer) CreatePrivateChat(context.Context, *CreatePrivateChatIn) (*CreatePrivateChatOut, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method CreatePrivateChat not implemented")
-}
-func (UnimplementedChatServiceServer) GetChats(context.Context, *emptypb.Empty) (*GetChatsOut, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetChats not implemented")
-}
-func (UnimplementedChatServiceServer) GetPrivateRecentMessages(context.Context, *GetPrivateRecentMessagesIn) (*GetPrivateRecentMessagesOut, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetPrivateRecentMessages not implemented")
+func _ChatService_AddReaction_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AddReactionIn)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ChatServiceServer).AddReaction(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ChatService_AddReaction_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ChatServiceServer).AddReaction(ctx, req.(*AddReactionIn))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (UnimplementedChatServiceServer) DeletePrivateMessage(context.Context, *DeletePrivateMessageIn) (*DeletePrivateMessageOut, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method DeletePrivateMessage not implemented")
+
+func _ChatService_RemoveReaction_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RemoveReactionIn)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ChatServiceServer).RemoveReaction(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ChatService_RemoveReaction_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ChatServiceServer).RemoveReaction(ctx, req.(*RemoveReactionIn))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (UnimplementedChatServiceServer) EditPrivateMessage(context.Context, *EditPrivateMessageIn) (*EditPrivateMessageOut, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method EditPrivateMessage not implemented")
+
+func _ChatService_PinMessage_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PinMessageIn)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ChatServiceServer).PinMessage(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ChatService_PinMessage_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ChatServiceServer).PinMessage(ctx, req.(*PinMessageIn))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (UnimplementedChatServiceServer) mustEmbedUnimplementedChatServiceServer() {}
-func (UnimplementedChatServiceServer) testEmbeddedByValue()                     {}
 
-// UnsafeChatServiceServer may be embedded to opt out of forward compatibility for this service.
-// Use of this interface is not recommended, as added methods to ChatServiceServer will
-// result in compilation errors.
-type UnsafeChatServiceServer interface {
-	mustEmbedUnimplementedChatServiceServer()
+func _ChatService_UnpinMessage_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UnpinMessageIn)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ChatServiceServer).UnpinMessage(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ChatService_UnpinMessage_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ChatServiceServer).UnpinMessage(ctx, req.(*UnpinMessageIn))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func RegisterChatServiceServer(s grpc.ServiceRegistrar, srv ChatServiceServer) {
-	// If the following call pancis, it indicates UnimplementedChatServiceServer was
-	// embedded by pointer and is nil.  This will cause panics if an
-	// unimplemented method is ever invoked, so we test this at initialization
-	// time to prevent it from happening at runtime later due to I/O.
-	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
-		t.testEmbeddedByValue()
+func _ChatService_ListPinnedMessages_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListPinnedMessagesIn)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	s.RegisterService(&ChatService_ServiceDesc, srv)
+	if interceptor == nil {
+		return srv.(ChatServiceServer).ListPinnedMessages(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ChatService_ListPinnedMessages_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ChatServiceServer).ListPinnedMessages(ctx, req.(*ListPinnedMessagesIn))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func _ChatService_CreatePrivateChat_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(CreatePrivateChatIn)
+func _ChatService_ForwardMessages_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ForwardMessagesIn)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ChatServiceServer).CreatePrivateChat(ctx, in)
+		return srv.(ChatServiceServer).ForwardMessages(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: ChatService_CreatePrivateChat_FullMethodName,
+		FullMethod: ChatService_ForwardMessages_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ChatServiceServer).CreatePrivateChat(ctx, req.(*CreatePrivateChatIn))
+		return srv.(ChatServiceServer).ForwardMessages(ctx, req.(*ForwardMessagesIn))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _ChatService_GetChats_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(emptypb.Empty)
+func _ChatService_SearchMessages_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SearchMessagesIn)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ChatServiceServer).GetChats(ctx, in)
+		return srv.(ChatServiceServer).SearchMessages(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: ChatService_GetChats_FullMethodName,
+		FullMethod: ChatService_SearchMessages_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ChatServiceServer).GetChats(ctx, req.(*emptypb.Empty))
+		return srv.(ChatServiceServer).SearchMessages(ctx, req.(*SearchMessagesIn))
 	}
 	return interceptor(ctx, in, info, handler)
 }
@@ -205,6 +1363,24 @@ func _ChatService_GetPrivateRecentMessages_Handler(srv interface{}, ctx context.
 	return interceptor(ctx, in, info, handler)
 }
 
+func _ChatService_SendPrivateMessage_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SendPrivateMessageIn)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ChatServiceServer).SendPrivateMessage(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ChatService_SendPrivateMessage_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ChatServiceServer).SendPrivateMessage(ctx, req.(*SendPrivateMessageIn))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _ChatService_DeletePrivateMessage_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(DeletePrivateMessageIn)
 	if err := dec(in); err != nil {
@@ -241,6 +1417,24 @@ func _ChatService_EditPrivateMessage_Handler(srv interface{}, ctx context.Contex
 	return interceptor(ctx, in, info, handler)
 }
 
+func _ChatService_GetMessageRevisions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetMessageRevisionsIn)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ChatServiceServer).GetMessageRevisions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ChatService_GetMessageRevisions_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ChatServiceServer).GetMessageRevisions(ctx, req.(*GetMessageRevisionsIn))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // ChatService_ServiceDesc is the grpc.ServiceDesc for ChatService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -256,10 +1450,138 @@ var ChatService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetChats",
 			Handler:    _ChatService_GetChats_Handler,
 		},
+		{
+			MethodName: "SetTyping",
+			Handler:    _ChatService_SetTyping_Handler,
+		},
+		{
+			MethodName: "Heartbeat",
+			Handler:    _ChatService_Heartbeat_Handler,
+		},
+		{
+			MethodName: "GetPresence",
+			Handler:    _ChatService_GetPresence_Handler,
+		},
+		{
+			MethodName: "SetChatNotifications",
+			Handler:    _ChatService_SetChatNotifications_Handler,
+		},
+		{
+			MethodName: "LeaveChat",
+			Handler:    _ChatService_LeaveChat_Handler,
+		},
+		{
+			MethodName: "CreateGroupChat",
+			Handler:    _ChatService_CreateGroupChat_Handler,
+		},
+		{
+			MethodName: "AddGroupMembers",
+			Handler:    _ChatService_AddGroupMembers_Handler,
+		},
+		{
+			MethodName: "RemoveGroupMember",
+			Handler:    _ChatService_RemoveGroupMember_Handler,
+		},
+		{
+			MethodName: "CreateInviteLink",
+			Handler:    _ChatService_CreateInviteLink_Handler,
+		},
+		{
+			MethodName: "RevokeInviteLink",
+			Handler:    _ChatService_RevokeInviteLink_Handler,
+		},
+		{
+			MethodName: "ListInviteLinks",
+			Handler:    _ChatService_ListInviteLinks_Handler,
+		},
+		{
+			MethodName: "JoinByInvite",
+			Handler:    _ChatService_JoinByInvite_Handler,
+		},
+		{
+			MethodName: "CreateChannel",
+			Handler:    _ChatService_CreateChannel_Handler,
+		},
+		{
+			MethodName: "SubscribeChannel",
+			Handler:    _ChatService_SubscribeChannel_Handler,
+		},
+		{
+			MethodName: "UnsubscribeChannel",
+			Handler:    _ChatService_UnsubscribeChannel_Handler,
+		},
+		{
+			MethodName: "PublishToChannel",
+			Handler:    _ChatService_PublishToChannel_Handler,
+		},
+		{
+			MethodName: "BanMember",
+			Handler:    _ChatService_BanMember_Handler,
+		},
+		{
+			MethodName: "UnbanMember",
+			Handler:    _ChatService_UnbanMember_Handler,
+		},
+		{
+			MethodName: "KickMember",
+			Handler:    _ChatService_KickMember_Handler,
+		},
+		{
+			MethodName: "GetOrCreateCommentStream",
+			Handler:    _ChatService_GetOrCreateCommentStream_Handler,
+		},
+		{
+			MethodName: "GetComments",
+			Handler:    _ChatService_GetComments_Handler,
+		},
+		{
+			MethodName: "PostComment",
+			Handler:    _ChatService_PostComment_Handler,
+		},
+		{
+			MethodName: "MarkMessagesRead",
+			Handler:    _ChatService_MarkMessagesRead_Handler,
+		},
+		{
+			MethodName: "GetMessageReaders",
+			Handler:    _ChatService_GetMessageReaders_Handler,
+		},
+		{
+			MethodName: "AddReaction",
+			Handler:    _ChatService_AddReaction_Handler,
+		},
+		{
+			MethodName: "RemoveReaction",
+			Handler:    _ChatService_RemoveReaction_Handler,
+		},
+		{
+			MethodName: "PinMessage",
+			Handler:    _ChatService_PinMessage_Handler,
+		},
+		{
+			MethodName: "UnpinMessage",
+			Handler:    _ChatService_UnpinMessage_Handler,
+		},
+		{
+			MethodName: "ListPinnedMessages",
+			Handler:    _ChatService_ListPinnedMessages_Handler,
+		},
+		{
+			MethodName: "ForwardMessages",
+			Handler:    _ChatService_ForwardMessages_Handler,
+		},
+		{
+			MethodName: "SearchMessages",
+			Handler:    _ChatService_SearchMessages_Handler,
+		},
 		{
 			MethodName: "GetPrivateRecentMessages",
 			Handler:    _ChatService_GetPrivateRecentMessages_Handler,
 		},
+		{
+			MethodName: "SendPrivateMessage",
+			Handler:    _ChatService_SendPrivateMessage_Handler,
+		},
 		{
 			MethodName: "DeletePrivateMessage",
 			Handler:    _ChatService_DeletePrivateMessage_Handler,
@@ -268,7 +1590,22 @@ var ChatService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "EditPrivateMessage",
 			Handler:    _ChatService_EditPrivateMessage_Handler,
 		},
+		{
+			MethodName: "GetMessageRevisions",
+			Handler:    _ChatService_GetMessageRevisions_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "SubscribeEvents",
+			Handler:       _ChatService_SubscribeEvents_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "WatchTyping",
+			Handler:       _ChatService_WatchTyping_Handler,
+			ServerStreams: true,
+		},
 	},
-	Streams:  []grpc.StreamDesc{},
 	Metadata: "api/chat.proto",
 }